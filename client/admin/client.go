@@ -1,6 +1,6 @@
 // Package admin provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.2-0.20250102212541-8bbe226927c9 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
 package admin
 
 import (
@@ -30,6 +30,68 @@ const (
 	Pwned      ErrorSchemaWeakPasswordReasons = "pwned"
 )
 
+// Defines values for MFAPolicyParamsSchemaMinimumAal.
+const (
+	MFAPolicyParamsSchemaMinimumAalAal1 MFAPolicyParamsSchemaMinimumAal = "aal1"
+	MFAPolicyParamsSchemaMinimumAalAal2 MFAPolicyParamsSchemaMinimumAal = "aal2"
+)
+
+// Defines values for MFAPolicyParamsSchemaRequiredFactorTypes.
+const (
+	MFAPolicyParamsSchemaRequiredFactorTypesPhone    MFAPolicyParamsSchemaRequiredFactorTypes = "phone"
+	MFAPolicyParamsSchemaRequiredFactorTypesTotp     MFAPolicyParamsSchemaRequiredFactorTypes = "totp"
+	MFAPolicyParamsSchemaRequiredFactorTypesWebauthn MFAPolicyParamsSchemaRequiredFactorTypes = "webauthn"
+)
+
+// Defines values for OAuthClientSchemaClientType.
+const (
+	OAuthClientSchemaClientTypeConfidential OAuthClientSchemaClientType = "confidential"
+	OAuthClientSchemaClientTypePublic       OAuthClientSchemaClientType = "public"
+)
+
+// Defines values for OAuthClientSchemaGrantTypes.
+const (
+	OAuthClientSchemaGrantTypesAuthorizationCode OAuthClientSchemaGrantTypes = "authorization_code"
+	OAuthClientSchemaGrantTypesRefreshToken      OAuthClientSchemaGrantTypes = "refresh_token"
+)
+
+// Defines values for OAuthClientSchemaRegistrationType.
+const (
+	Dynamic OAuthClientSchemaRegistrationType = "dynamic"
+	Manual  OAuthClientSchemaRegistrationType = "manual"
+)
+
+// Defines values for OAuthClientSchemaResponseTypes.
+const (
+	OAuthClientSchemaResponseTypesCode OAuthClientSchemaResponseTypes = "code"
+)
+
+// Defines values for OAuthClientSchemaTokenEndpointAuthMethod.
+const (
+	OAuthClientSchemaTokenEndpointAuthMethodClientSecretBasic OAuthClientSchemaTokenEndpointAuthMethod = "client_secret_basic"
+	OAuthClientSchemaTokenEndpointAuthMethodClientSecretPost  OAuthClientSchemaTokenEndpointAuthMethod = "client_secret_post"
+	OAuthClientSchemaTokenEndpointAuthMethodNone              OAuthClientSchemaTokenEndpointAuthMethod = "none"
+)
+
+// Defines values for OrganizationMFAPolicySchemaMinimumAal.
+const (
+	OrganizationMFAPolicySchemaMinimumAalAal1 OrganizationMFAPolicySchemaMinimumAal = "aal1"
+	OrganizationMFAPolicySchemaMinimumAalAal2 OrganizationMFAPolicySchemaMinimumAal = "aal2"
+)
+
+// Defines values for OrganizationMFAPolicySchemaRequiredFactorTypes.
+const (
+	OrganizationMFAPolicySchemaRequiredFactorTypesPhone    OrganizationMFAPolicySchemaRequiredFactorTypes = "phone"
+	OrganizationMFAPolicySchemaRequiredFactorTypesTotp     OrganizationMFAPolicySchemaRequiredFactorTypes = "totp"
+	OrganizationMFAPolicySchemaRequiredFactorTypesWebauthn OrganizationMFAPolicySchemaRequiredFactorTypes = "webauthn"
+)
+
+// Defines values for SessionSchemaAal.
+const (
+	Aal1 SessionSchemaAal = "aal1"
+	Aal2 SessionSchemaAal = "aal2"
+)
+
 // Defines values for PostAdminGenerateLinkJSONBodyType.
 const (
 	EmailChangeCurrent PostAdminGenerateLinkJSONBodyType = "email_change_current"
@@ -39,11 +101,146 @@ const (
 	Signup             PostAdminGenerateLinkJSONBodyType = "signup"
 )
 
+// Defines values for PostAdminInvitationsJSONBodyRole.
+const (
+	PostAdminInvitationsJSONBodyRoleAdmin  PostAdminInvitationsJSONBodyRole = "admin"
+	PostAdminInvitationsJSONBodyRoleClient PostAdminInvitationsJSONBodyRole = "client"
+)
+
+// Defines values for PostAdminOauthClientsJSONBodyClientType.
+const (
+	PostAdminOauthClientsJSONBodyClientTypeConfidential PostAdminOauthClientsJSONBodyClientType = "confidential"
+	PostAdminOauthClientsJSONBodyClientTypePublic       PostAdminOauthClientsJSONBodyClientType = "public"
+)
+
+// Defines values for PostAdminOauthClientsJSONBodyGrantTypes.
+const (
+	PostAdminOauthClientsJSONBodyGrantTypesAuthorizationCode PostAdminOauthClientsJSONBodyGrantTypes = "authorization_code"
+	PostAdminOauthClientsJSONBodyGrantTypesRefreshToken      PostAdminOauthClientsJSONBodyGrantTypes = "refresh_token"
+)
+
+// Defines values for PostAdminOauthClientsJSONBodyResponseTypes.
+const (
+	PostAdminOauthClientsJSONBodyResponseTypesCode PostAdminOauthClientsJSONBodyResponseTypes = "code"
+)
+
+// Defines values for PostAdminOauthClientsJSONBodyTokenEndpointAuthMethod.
+const (
+	PostAdminOauthClientsJSONBodyTokenEndpointAuthMethodClientSecretBasic PostAdminOauthClientsJSONBodyTokenEndpointAuthMethod = "client_secret_basic"
+	PostAdminOauthClientsJSONBodyTokenEndpointAuthMethodClientSecretPost  PostAdminOauthClientsJSONBodyTokenEndpointAuthMethod = "client_secret_post"
+	PostAdminOauthClientsJSONBodyTokenEndpointAuthMethodNone              PostAdminOauthClientsJSONBodyTokenEndpointAuthMethod = "none"
+)
+
+// Defines values for PutAdminOauthClientsClientIdJSONBodyGrantTypes.
+const (
+	AuthorizationCode PutAdminOauthClientsClientIdJSONBodyGrantTypes = "authorization_code"
+	RefreshToken      PutAdminOauthClientsClientIdJSONBodyGrantTypes = "refresh_token"
+)
+
+// Defines values for PostAdminOrganizationsOrganizationIdMembersJSONBodyRole.
+const (
+	PostAdminOrganizationsOrganizationIdMembersJSONBodyRoleAdmin  PostAdminOrganizationsOrganizationIdMembersJSONBodyRole = "admin"
+	PostAdminOrganizationsOrganizationIdMembersJSONBodyRoleClient PostAdminOrganizationsOrganizationIdMembersJSONBodyRole = "client"
+)
+
+// Defines values for PutAdminProjectsProjectIdTiersTierJSONBodyTierModel.
+const (
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierModelFree   PutAdminProjectsProjectIdTiersTierJSONBodyTierModel = "free"
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierModelHigh   PutAdminProjectsProjectIdTiersTierJSONBodyTierModel = "high"
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierModelLow    PutAdminProjectsProjectIdTiersTierJSONBodyTierModel = "low"
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierModelMedium PutAdminProjectsProjectIdTiersTierJSONBodyTierModel = "medium"
+)
+
+// Defines values for PutAdminProjectsProjectIdTiersTierJSONBodyTierTime.
+const (
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierTimeBatch  PutAdminProjectsProjectIdTiersTierJSONBodyTierTime = "batch"
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierTimeFree   PutAdminProjectsProjectIdTiersTierJSONBodyTierTime = "free"
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierTimeHigh   PutAdminProjectsProjectIdTiersTierJSONBodyTierTime = "high"
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierTimeLow    PutAdminProjectsProjectIdTiersTierJSONBodyTierTime = "low"
+	PutAdminProjectsProjectIdTiersTierJSONBodyTierTimeMedium PutAdminProjectsProjectIdTiersTierJSONBodyTierTime = "medium"
+)
+
+// Defines values for PutAdminProjectsProjectIdTiersTierJSONBodyTierUsage.
+const (
+	Free   PutAdminProjectsProjectIdTiersTierJSONBodyTierUsage = "free"
+	High   PutAdminProjectsProjectIdTiersTierJSONBodyTierUsage = "high"
+	Low    PutAdminProjectsProjectIdTiersTierJSONBodyTierUsage = "low"
+	Medium PutAdminProjectsProjectIdTiersTierJSONBodyTierUsage = "medium"
+)
+
 // Defines values for PostAdminSsoProvidersJSONBodyType.
 const (
 	Saml PostAdminSsoProvidersJSONBodyType = "saml"
 )
 
+// APIKeySchema defines model for APIKeySchema.
+type APIKeySchema struct {
+	CreatedAt      *time.Time          `json:"created_at,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Id             *openapi_types.UUID `json:"id,omitempty"`
+	Name           *string             `json:"name,omitempty"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	ProjectId      *openapi_types.UUID `json:"project_id,omitempty"`
+	TierModel      *string             `json:"tier_model,omitempty"`
+	TierTime       *string             `json:"tier_time,omitempty"`
+	TierUsage      *string             `json:"tier_usage,omitempty"`
+	UpdatedAt      *time.Time          `json:"updated_at,omitempty"`
+}
+
+// APIKeyWithSecretSchema defines model for APIKeyWithSecretSchema.
+type APIKeyWithSecretSchema struct {
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+
+	// Key The plain API key. It is not stored and cannot be retrieved again.
+	Key            *string             `json:"key,omitempty"`
+	Name           *string             `json:"name,omitempty"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	ProjectId      *openapi_types.UUID `json:"project_id,omitempty"`
+	TierModel      *string             `json:"tier_model,omitempty"`
+	TierTime       *string             `json:"tier_time,omitempty"`
+	TierUsage      *string             `json:"tier_usage,omitempty"`
+	UpdatedAt      *time.Time          `json:"updated_at,omitempty"`
+}
+
+// CustomOIDCProviderParamsSchema defines model for CustomOIDCProviderParamsSchema.
+type CustomOIDCProviderParamsSchema struct {
+	// ClaimMappings Maps standard claims, such as `email`, to the claims the provider returns them in.
+	ClaimMappings *map[string]string `json:"claim_mappings,omitempty"`
+	ClientId      *string            `json:"client_id,omitempty"`
+
+	// ClientSecret Stored encrypted and never returned. Required when registering a provider.
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// DiscoveryUrl Where the provider metadata is read from, by default `<issuer>/.well-known/openid-configuration`.
+	DiscoveryUrl *string `json:"discovery_url,omitempty"`
+	Enabled      *bool   `json:"enabled,omitempty"`
+	Issuer       *string `json:"issuer,omitempty"`
+
+	// Scopes Scopes requested in addition to `openid`.
+	Scopes *[]string `json:"scopes,omitempty"`
+
+	// Slug Name of the provider in `custom:<slug>`.
+	Slug *string `json:"slug,omitempty"`
+}
+
+// CustomOIDCProviderSchema defines model for CustomOIDCProviderSchema.
+type CustomOIDCProviderSchema struct {
+	ClaimMappings *map[string]string  `json:"claim_mappings,omitempty"`
+	ClientId      *string             `json:"client_id,omitempty"`
+	CreatedAt     *time.Time          `json:"created_at,omitempty"`
+	DiscoveryUrl  *string             `json:"discovery_url,omitempty"`
+	Enabled       *bool               `json:"enabled,omitempty"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+	Issuer        *string             `json:"issuer,omitempty"`
+	Name          *string             `json:"name,omitempty"`
+	ProjectId     *openapi_types.UUID `json:"project_id,omitempty"`
+	Scopes        *[]string           `json:"scopes,omitempty"`
+	Slug          *string             `json:"slug,omitempty"`
+	UpdatedAt     *time.Time          `json:"updated_at,omitempty"`
+}
+
 // ErrorSchema defines model for ErrorSchema.
 type ErrorSchema struct {
 	// Code The HTTP status code. Usually missing if `error` is present.
@@ -99,6 +296,7 @@ type MFAFactorSchema struct {
 	// - totp
 	// - phone
 	// - webauthn
+	// - recovery_code
 	FactorType       *string             `json:"factor_type,omitempty"`
 	FriendlyName     *string             `json:"friendly_name,omitempty"`
 	Id               *openapi_types.UUID `json:"id,omitempty"`
@@ -110,7 +308,252 @@ type MFAFactorSchema struct {
 	// - unverified
 	Status             *string    `json:"status,omitempty"`
 	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
-	WebAuthnCredential *string    `json:"web_authn_credential,omitempty"`
+	WebauthnCredential *string    `json:"webauthn_credential,omitempty"`
+}
+
+// MFAPolicyParamsSchema defines model for MFAPolicyParamsSchema.
+type MFAPolicyParamsSchema struct {
+	// EnrollmentGracePeriod Seconds members have to enroll a factor, counted from when they or the policy were created.
+	EnrollmentGracePeriod *int `json:"enrollment_grace_period,omitempty"`
+
+	// MinimumAal Sessions below this AAL cannot be refreshed once the member has a verified factor.
+	MinimumAal          *MFAPolicyParamsSchemaMinimumAal           `json:"minimum_aal,omitempty"`
+	RequiredFactorTypes []MFAPolicyParamsSchemaRequiredFactorTypes `json:"required_factor_types"`
+}
+
+// MFAPolicyParamsSchemaMinimumAal Sessions below this AAL cannot be refreshed once the member has a verified factor.
+type MFAPolicyParamsSchemaMinimumAal string
+
+// MFAPolicyParamsSchemaRequiredFactorTypes defines model for MFAPolicyParamsSchema.RequiredFactorTypes.
+type MFAPolicyParamsSchemaRequiredFactorTypes string
+
+// OAuthClientSchema Represents an OAuth 2.1 client
+type OAuthClientSchema struct {
+	// ClientId Unique client identifier
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientName Human-readable name of the client application
+	ClientName *string `json:"client_name,omitempty"`
+
+	// ClientSecret Client secret for confidential clients (only returned on registration/regeneration)
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// ClientType Type of the client
+	ClientType *OAuthClientSchemaClientType `json:"client_type,omitempty"`
+
+	// ClientUri URL of the client application's homepage
+	ClientUri *string    `json:"client_uri,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// GrantTypes OAuth grant types the client is authorized to use
+	GrantTypes *[]OAuthClientSchemaGrantTypes `json:"grant_types,omitempty"`
+
+	// LogoUri URL of the client application's logo
+	LogoUri *string `json:"logo_uri,omitempty"`
+
+	// RedirectUris Array of redirect URIs used by the client
+	RedirectUris *[]string `json:"redirect_uris,omitempty"`
+
+	// RegistrationType Registration type of the client
+	RegistrationType *OAuthClientSchemaRegistrationType `json:"registration_type,omitempty"`
+
+	// ResponseTypes OAuth response types the client can use
+	ResponseTypes *[]OAuthClientSchemaResponseTypes `json:"response_types,omitempty"`
+
+	// Scope Space-separated list of scope values
+	Scope *string `json:"scope,omitempty"`
+
+	// TokenEndpointAuthMethod Authentication method for the token endpoint
+	TokenEndpointAuthMethod *OAuthClientSchemaTokenEndpointAuthMethod `json:"token_endpoint_auth_method,omitempty"`
+	UpdatedAt               *time.Time                                `json:"updated_at,omitempty"`
+}
+
+// OAuthClientSchemaClientType Type of the client
+type OAuthClientSchemaClientType string
+
+// OAuthClientSchemaGrantTypes defines model for OAuthClientSchema.GrantTypes.
+type OAuthClientSchemaGrantTypes string
+
+// OAuthClientSchemaRegistrationType Registration type of the client
+type OAuthClientSchemaRegistrationType string
+
+// OAuthClientSchemaResponseTypes defines model for OAuthClientSchema.ResponseTypes.
+type OAuthClientSchemaResponseTypes string
+
+// OAuthClientSchemaTokenEndpointAuthMethod Authentication method for the token endpoint
+type OAuthClientSchemaTokenEndpointAuthMethod string
+
+// OrganizationInvitationSchema defines model for OrganizationInvitationSchema.
+type OrganizationInvitationSchema struct {
+	AcceptedAt     *time.Time           `json:"accepted_at,omitempty"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	ExpiresAt      *time.Time           `json:"expires_at,omitempty"`
+	Id             *openapi_types.UUID  `json:"id,omitempty"`
+	InvitedBy      *openapi_types.UUID  `json:"invited_by,omitempty"`
+	OrganizationId *openapi_types.UUID  `json:"organization_id,omitempty"`
+	Role           *string              `json:"role,omitempty"`
+	SentAt         *time.Time           `json:"sent_at,omitempty"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+}
+
+// OrganizationMFAPolicySchema defines model for OrganizationMFAPolicySchema.
+type OrganizationMFAPolicySchema struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EnrollmentGracePeriod Seconds members have to enroll a factor, counted from when they or the policy were created.
+	EnrollmentGracePeriod *int `json:"enrollment_grace_period,omitempty"`
+
+	// MinimumAal Sessions below this AAL cannot be refreshed once the member has a verified factor.
+	MinimumAal          *OrganizationMFAPolicySchemaMinimumAal           `json:"minimum_aal,omitempty"`
+	OrganizationId      *openapi_types.UUID                              `json:"organization_id,omitempty"`
+	RequiredFactorTypes []OrganizationMFAPolicySchemaRequiredFactorTypes `json:"required_factor_types"`
+	UpdatedAt           *time.Time                                       `json:"updated_at,omitempty"`
+}
+
+// OrganizationMFAPolicySchemaMinimumAal Sessions below this AAL cannot be refreshed once the member has a verified factor.
+type OrganizationMFAPolicySchemaMinimumAal string
+
+// OrganizationMFAPolicySchemaRequiredFactorTypes defines model for OrganizationMFAPolicySchema.RequiredFactorTypes.
+type OrganizationMFAPolicySchemaRequiredFactorTypes string
+
+// OrganizationMemberSchema defines model for OrganizationMemberSchema.
+type OrganizationMemberSchema struct {
+	CreatedAt      *time.Time          `json:"created_at,omitempty"`
+	Id             *openapi_types.UUID `json:"id,omitempty"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	Role           *string             `json:"role,omitempty"`
+	UpdatedAt      *time.Time          `json:"updated_at,omitempty"`
+	UserId         *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// OrganizationSMTPConfigSchema defines model for OrganizationSMTPConfigSchema.
+type OrganizationSMTPConfigSchema struct {
+	AdminEmail     *openapi_types.Email `json:"admin_email,omitempty"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Domain         *string              `json:"domain,omitempty"`
+	Host           *string              `json:"host,omitempty"`
+	OrganizationId *openapi_types.UUID  `json:"organization_id,omitempty"`
+	Port           *int                 `json:"port,omitempty"`
+	SenderName     *string              `json:"sender_name,omitempty"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+	User           *string              `json:"user,omitempty"`
+}
+
+// OrganizationSchema defines model for OrganizationSchema.
+type OrganizationSchema struct {
+	// AdminId User that owns the organization.
+	AdminId     *openapi_types.UUID `json:"admin_id,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+	ProjectId   *openapi_types.UUID `json:"project_id,omitempty"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+}
+
+// OrganizationTierParamsSchema defines model for OrganizationTierParamsSchema.
+type OrganizationTierParamsSchema struct {
+	AdminTierModel  *string `json:"admin_tier_model,omitempty"`
+	AdminTierTime   *string `json:"admin_tier_time,omitempty"`
+	AdminTierUsage  *string `json:"admin_tier_usage,omitempty"`
+	ClientTierModel *string `json:"client_tier_model,omitempty"`
+	ClientTierTime  *string `json:"client_tier_time,omitempty"`
+	ClientTierUsage *string `json:"client_tier_usage,omitempty"`
+	Tier            *string `json:"tier,omitempty"`
+}
+
+// OrganizationTierSchema Tier of an organization. Admins and clients of the organization get their own model, time and usage tiers.
+type OrganizationTierSchema struct {
+	AdminTierModel  *string             `json:"admin_tier_model,omitempty"`
+	AdminTierTime   *string             `json:"admin_tier_time,omitempty"`
+	AdminTierUsage  *string             `json:"admin_tier_usage,omitempty"`
+	ClientTierModel *string             `json:"client_tier_model,omitempty"`
+	ClientTierTime  *string             `json:"client_tier_time,omitempty"`
+	ClientTierUsage *string             `json:"client_tier_usage,omitempty"`
+	CreatedAt       *time.Time          `json:"created_at,omitempty"`
+	OrganizationId  *openapi_types.UUID `json:"organization_id,omitempty"`
+	Tier            *string             `json:"tier,omitempty"`
+	UpdatedAt       *time.Time          `json:"updated_at,omitempty"`
+}
+
+// ProjectSMTPConfigSchema defines model for ProjectSMTPConfigSchema.
+type ProjectSMTPConfigSchema struct {
+	AdminEmail *openapi_types.Email `json:"admin_email,omitempty"`
+	CreatedAt  *time.Time           `json:"created_at,omitempty"`
+	Domain     *string              `json:"domain,omitempty"`
+	Host       *string              `json:"host,omitempty"`
+	Port       *int                 `json:"port,omitempty"`
+	ProjectId  *openapi_types.UUID  `json:"project_id,omitempty"`
+	SenderName *string              `json:"sender_name,omitempty"`
+	UpdatedAt  *time.Time           `json:"updated_at,omitempty"`
+	User       *string              `json:"user,omitempty"`
+}
+
+// ProjectSchema defines model for ProjectSchema.
+type ProjectSchema struct {
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+
+	// RateLimits Number of requests allowed per tier within a sliding window of `seconds`.
+	RateLimits *RateLimitSchema `json:"rate_limits,omitempty"`
+	UpdatedAt  *time.Time       `json:"updated_at,omitempty"`
+}
+
+// ProjectSettingsParamsSchema Settings left out or null inherit the global configuration.
+type ProjectSettingsParamsSchema struct {
+	AnonymousUsers *bool `json:"anonymous_users"`
+	DisableSignup  *bool `json:"disable_signup"`
+
+	// EnabledProviders Providers users of the project can sign in with. They must also be enabled globally.
+	EnabledProviders  *[]string `json:"enabled_providers"`
+	MailerAutoconfirm *bool     `json:"mailer_autoconfirm"`
+	PhoneAutoconfirm  *bool     `json:"phone_autoconfirm"`
+
+	// UriAllowList Redirect URLs of the project, replacing the global list.
+	UriAllowList *[]string `json:"uri_allow_list"`
+}
+
+// ProjectSettingsSchema defines model for ProjectSettingsSchema.
+type ProjectSettingsSchema struct {
+	AnonymousUsers *bool      `json:"anonymous_users"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	DisableSignup  *bool      `json:"disable_signup"`
+
+	// EnabledProviders Providers users of the project can sign in with. They must also be enabled globally.
+	EnabledProviders  *[]string           `json:"enabled_providers"`
+	MailerAutoconfirm *bool               `json:"mailer_autoconfirm"`
+	PhoneAutoconfirm  *bool               `json:"phone_autoconfirm"`
+	ProjectId         *openapi_types.UUID `json:"project_id,omitempty"`
+	UpdatedAt         *time.Time          `json:"updated_at,omitempty"`
+
+	// UriAllowList Redirect URLs of the project, replacing the global list.
+	UriAllowList *[]string `json:"uri_allow_list"`
+}
+
+// ProjectTierSchema defines model for ProjectTierSchema.
+type ProjectTierSchema struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// IsDefault Organizations without a tier of their own use the default tier of their project.
+	IsDefault *bool               `json:"is_default,omitempty"`
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	Tier      *string             `json:"tier,omitempty"`
+	TierModel *string             `json:"tier_model,omitempty"`
+	TierTime  *string             `json:"tier_time,omitempty"`
+	TierUsage *string             `json:"tier_usage,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// RateLimitSchema Number of requests allowed per tier within a sliding window of `seconds`.
+type RateLimitSchema struct {
+	RateLimits *[]struct {
+		Limit *int    `json:"limit,omitempty"`
+		Tier  *string `json:"tier,omitempty"`
+	} `json:"rate_limits,omitempty"`
+	Seconds *int `json:"seconds,omitempty"`
 }
 
 // SAMLAttributeMappingSchema defines model for SAMLAttributeMappingSchema.
@@ -118,6 +561,31 @@ type SAMLAttributeMappingSchema struct {
 	Keys *map[string]interface{} `json:"keys,omitempty"`
 }
 
+// SMTPConfigParamsSchema defines model for SMTPConfigParamsSchema.
+type SMTPConfigParamsSchema struct {
+	AdminEmail openapi_types.Email `json:"admin_email"`
+	Domain     *string             `json:"domain,omitempty"`
+	Host       string              `json:"host"`
+
+	// Pass Left out to keep the current password. The password is never returned.
+	Pass       *string `json:"pass,omitempty"`
+	Port       *int    `json:"port,omitempty"`
+	SenderName *string `json:"sender_name,omitempty"`
+	User       *string `json:"user,omitempty"`
+}
+
+// SMTPSettingsSchema defines model for SMTPSettingsSchema.
+type SMTPSettingsSchema struct {
+	AdminEmail *openapi_types.Email `json:"admin_email,omitempty"`
+	CreatedAt  *time.Time           `json:"created_at,omitempty"`
+	Domain     *string              `json:"domain,omitempty"`
+	Host       *string              `json:"host,omitempty"`
+	Port       *int                 `json:"port,omitempty"`
+	SenderName *string              `json:"sender_name,omitempty"`
+	UpdatedAt  *time.Time           `json:"updated_at,omitempty"`
+	User       *string              `json:"user,omitempty"`
+}
+
 // SSOProviderSchema defines model for SSOProviderSchema.
 type SSOProviderSchema struct {
 	Id   *openapi_types.UUID `json:"id,omitempty"`
@@ -132,10 +600,35 @@ type SSOProviderSchema struct {
 	} `json:"sso_domains,omitempty"`
 }
 
+// SessionSchema defines model for SessionSchema.
+type SessionSchema struct {
+	Aal       *SessionSchemaAal `json:"aal,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+
+	// Current Whether this is the session of the access token making the request.
+	Current *bool               `json:"current,omitempty"`
+	Id      *openapi_types.UUID `json:"id,omitempty"`
+	Ip      *string             `json:"ip,omitempty"`
+
+	// NotAfter Time after which the session can no longer be refreshed, if limited.
+	NotAfter *time.Time `json:"not_after,omitempty"`
+
+	// OauthClientId OAuth client the session was issued to, if any.
+	OauthClientId *openapi_types.UUID `json:"oauth_client_id,omitempty"`
+	RefreshedAt   *time.Time          `json:"refreshed_at,omitempty"`
+
+	// Tag Tag of the session, one of the configured session tags.
+	Tag       *string `json:"tag,omitempty"`
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// SessionSchemaAal defines model for SessionSchema.Aal.
+type SessionSchemaAal string
+
 // UserSchema Object describing the user related to the issued access and refresh tokens.
 type UserSchema struct {
 	AppMetadata *map[string]interface{} `json:"app_metadata,omitempty"`
-	// Deprecated:
+	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
 	Aud                *string    `json:"aud,omitempty"`
 	BannedUntil        *time.Time `json:"banned_until,omitempty"`
 	ConfirmationSentAt *time.Time `json:"confirmation_sent_at,omitempty"`
@@ -155,10 +648,17 @@ type UserSchema struct {
 	NewEmail          *openapi_types.Email `json:"new_email,omitempty"`
 	NewPhone          *string              `json:"new_phone,omitempty"`
 
+	// OrganizationId Organization of the user. Project admins do not belong to an organization.
+	OrganizationId *openapi_types.UUID `json:"organization_id"`
+
+	// OrganizationRole Role of the user in their organization, one of `admin`, `project_admin` or `client`.
+	OrganizationRole *string `json:"organization_role,omitempty"`
+
 	// Phone User's primary contact phone number. In most cases you can uniquely identify a user by their phone number, but not in all cases.
 	Phone                  *string                 `json:"phone,omitempty"`
 	PhoneChangeSentAt      *time.Time              `json:"phone_change_sent_at,omitempty"`
 	PhoneConfirmedAt       *time.Time              `json:"phone_confirmed_at,omitempty"`
+	ProjectId              *openapi_types.UUID     `json:"project_id,omitempty"`
 	ReauthenticationSentAt *time.Time              `json:"reauthentication_sent_at,omitempty"`
 	RecoverySentAt         *time.Time              `json:"recovery_sent_at,omitempty"`
 	Role                   *string                 `json:"role,omitempty"`
@@ -172,9 +672,32 @@ type BadRequestResponse = ErrorSchema
 // ForbiddenResponse defines model for ForbiddenResponse.
 type ForbiddenResponse = ErrorSchema
 
+// NotFoundResponse defines model for NotFoundResponse.
+type NotFoundResponse = ErrorSchema
+
 // UnauthorizedResponse defines model for UnauthorizedResponse.
 type UnauthorizedResponse = ErrorSchema
 
+// GetAdminApiKeysParams defines parameters for GetAdminApiKeys.
+type GetAdminApiKeysParams struct {
+	// OrganizationId Only list the keys of this organization.
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+	Page           *int                `form:"page,omitempty" json:"page,omitempty"`
+	PerPage        *int                `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostAdminApiKeysJSONBody defines parameters for PostAdminApiKeys.
+type PostAdminApiKeysJSONBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// OrganizationId Defaults to the organization in the admin's token.
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	TierModel      *string             `json:"tier_model,omitempty"`
+	TierTime       *string             `json:"tier_time,omitempty"`
+	TierUsage      *string             `json:"tier_usage,omitempty"`
+}
+
 // GetAdminAuditParams defines parameters for GetAdminAudit.
 type GetAdminAuditParams struct {
 	Page    *int `form:"page,omitempty" json:"page,omitempty"`
@@ -194,6 +717,192 @@ type PostAdminGenerateLinkJSONBody struct {
 // PostAdminGenerateLinkJSONBodyType defines parameters for PostAdminGenerateLink.
 type PostAdminGenerateLinkJSONBodyType string
 
+// GetAdminInvitationsParams defines parameters for GetAdminInvitations.
+type GetAdminInvitationsParams struct {
+	// OrganizationId Defaults to the organization in the admin's token.
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+	Page           *int                `form:"page,omitempty" json:"page,omitempty"`
+	PerPage        *int                `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostAdminInvitationsJSONBody defines parameters for PostAdminInvitations.
+type PostAdminInvitationsJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+
+	// OrganizationId Defaults to the organization in the admin's token.
+	OrganizationId *openapi_types.UUID               `json:"organization_id,omitempty"`
+	Role           *PostAdminInvitationsJSONBodyRole `json:"role,omitempty"`
+}
+
+// PostAdminInvitationsJSONBodyRole defines parameters for PostAdminInvitations.
+type PostAdminInvitationsJSONBodyRole string
+
+// GetAdminOauthClientsParams defines parameters for GetAdminOauthClients.
+type GetAdminOauthClientsParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostAdminOauthClientsJSONBody defines parameters for PostAdminOauthClients.
+type PostAdminOauthClientsJSONBody struct {
+	// ClientName Human-readable name of the client application
+	ClientName string `json:"client_name"`
+
+	// ClientType Type of the client. Optional. If not provided, will be inferred from token_endpoint_auth_method or defaults to 'confidential'. Public clients are used for applications that cannot securely store credentials (e.g., SPAs, mobile apps). Confidential clients can securely store credentials (e.g., server-side applications).
+	ClientType *PostAdminOauthClientsJSONBodyClientType `json:"client_type,omitempty"`
+
+	// ClientUri URL of the client application's homepage
+	ClientUri *string `json:"client_uri,omitempty"`
+
+	// GrantTypes OAuth grant types the client will use (defaults to both if not specified)
+	GrantTypes *[]PostAdminOauthClientsJSONBodyGrantTypes `json:"grant_types,omitempty"`
+
+	// LogoUri URL of the client application's logo
+	LogoUri *string `json:"logo_uri,omitempty"`
+
+	// RedirectUris Array of redirect URIs used by the client (maximum 10)
+	RedirectUris []string `json:"redirect_uris"`
+
+	// ResponseTypes OAuth response types the client can use
+	ResponseTypes *[]PostAdminOauthClientsJSONBodyResponseTypes `json:"response_types,omitempty"`
+
+	// Scope Space-separated list of scope values
+	Scope *string `json:"scope,omitempty"`
+
+	// TokenEndpointAuthMethod Authentication method for the token endpoint. Optional. 'none' is for public clients, 'client_secret_basic' and 'client_secret_post' are for confidential clients. If provided, must be consistent with client_type. If not provided, will be inferred from client_type.
+	TokenEndpointAuthMethod *PostAdminOauthClientsJSONBodyTokenEndpointAuthMethod `json:"token_endpoint_auth_method,omitempty"`
+}
+
+// PostAdminOauthClientsJSONBodyClientType defines parameters for PostAdminOauthClients.
+type PostAdminOauthClientsJSONBodyClientType string
+
+// PostAdminOauthClientsJSONBodyGrantTypes defines parameters for PostAdminOauthClients.
+type PostAdminOauthClientsJSONBodyGrantTypes string
+
+// PostAdminOauthClientsJSONBodyResponseTypes defines parameters for PostAdminOauthClients.
+type PostAdminOauthClientsJSONBodyResponseTypes string
+
+// PostAdminOauthClientsJSONBodyTokenEndpointAuthMethod defines parameters for PostAdminOauthClients.
+type PostAdminOauthClientsJSONBodyTokenEndpointAuthMethod string
+
+// PutAdminOauthClientsClientIdJSONBody defines parameters for PutAdminOauthClientsClientId.
+type PutAdminOauthClientsClientIdJSONBody struct {
+	// ClientName Human-readable name of the client application
+	ClientName *string `json:"client_name,omitempty"`
+
+	// ClientUri URL of the client application's homepage
+	ClientUri *string `json:"client_uri,omitempty"`
+
+	// GrantTypes OAuth grant types the client is authorized to use
+	GrantTypes *[]PutAdminOauthClientsClientIdJSONBodyGrantTypes `json:"grant_types,omitempty"`
+
+	// LogoUri URL of the client application's logo
+	LogoUri *string `json:"logo_uri,omitempty"`
+
+	// RedirectUris Array of redirect URIs used by the client
+	RedirectUris *[]string `json:"redirect_uris,omitempty"`
+}
+
+// PutAdminOauthClientsClientIdJSONBodyGrantTypes defines parameters for PutAdminOauthClientsClientId.
+type PutAdminOauthClientsClientIdJSONBodyGrantTypes string
+
+// GetAdminOrganizationsParams defines parameters for GetAdminOrganizations.
+type GetAdminOrganizationsParams struct {
+	// ProjectId Only list the organizations of this project.
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Filter Only list the organizations whose name contains this value.
+	Filter  *string `form:"filter,omitempty" json:"filter,omitempty"`
+	Page    *int    `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int    `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostAdminOrganizationsJSONBody defines parameters for PostAdminOrganizations.
+type PostAdminOrganizationsJSONBody struct {
+	AdminId     openapi_types.UUID `json:"admin_id"`
+	Description *string            `json:"description,omitempty"`
+	Name        string             `json:"name"`
+
+	// ProjectId Defaults to the project in the admin's token.
+	ProjectId *openapi_types.UUID           `json:"project_id,omitempty"`
+	Tier      *OrganizationTierParamsSchema `json:"tier,omitempty"`
+}
+
+// PutAdminOrganizationsOrganizationIdJSONBody defines parameters for PutAdminOrganizationsOrganizationId.
+type PutAdminOrganizationsOrganizationIdJSONBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// GetAdminOrganizationsOrganizationIdMembersParams defines parameters for GetAdminOrganizationsOrganizationIdMembers.
+type GetAdminOrganizationsOrganizationIdMembersParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostAdminOrganizationsOrganizationIdMembersJSONBody defines parameters for PostAdminOrganizationsOrganizationIdMembers.
+type PostAdminOrganizationsOrganizationIdMembersJSONBody struct {
+	Role   *PostAdminOrganizationsOrganizationIdMembersJSONBodyRole `json:"role,omitempty"`
+	UserId openapi_types.UUID                                       `json:"user_id"`
+}
+
+// PostAdminOrganizationsOrganizationIdMembersJSONBodyRole defines parameters for PostAdminOrganizationsOrganizationIdMembers.
+type PostAdminOrganizationsOrganizationIdMembersJSONBodyRole string
+
+// GetAdminProjectsParams defines parameters for GetAdminProjects.
+type GetAdminProjectsParams struct {
+	// Filter Only list the projects whose name contains this value.
+	Filter  *string `form:"filter,omitempty" json:"filter,omitempty"`
+	Page    *int    `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int    `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostAdminProjectsJSONBody defines parameters for PostAdminProjects.
+type PostAdminProjectsJSONBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// RateLimits Number of requests allowed per tier within a sliding window of `seconds`.
+	RateLimits *RateLimitSchema `json:"rate_limits,omitempty"`
+}
+
+// DeleteAdminProjectsProjectIdJSONBody defines parameters for DeleteAdminProjectsProjectId.
+type DeleteAdminProjectsProjectIdJSONBody struct {
+	Cascade *bool `json:"cascade,omitempty"`
+}
+
+// PutAdminProjectsProjectIdJSONBody defines parameters for PutAdminProjectsProjectId.
+type PutAdminProjectsProjectIdJSONBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+
+	// RateLimits Number of requests allowed per tier within a sliding window of `seconds`.
+	RateLimits *RateLimitSchema `json:"rate_limits,omitempty"`
+}
+
+// GetAdminProjectsProjectIdOrganizationsParams defines parameters for GetAdminProjectsProjectIdOrganizations.
+type GetAdminProjectsProjectIdOrganizationsParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PutAdminProjectsProjectIdTiersTierJSONBody defines parameters for PutAdminProjectsProjectIdTiersTier.
+type PutAdminProjectsProjectIdTiersTierJSONBody struct {
+	IsDefault *bool                                                `json:"is_default,omitempty"`
+	TierModel *PutAdminProjectsProjectIdTiersTierJSONBodyTierModel `json:"tier_model,omitempty"`
+	TierTime  *PutAdminProjectsProjectIdTiersTierJSONBodyTierTime  `json:"tier_time,omitempty"`
+	TierUsage *PutAdminProjectsProjectIdTiersTierJSONBodyTierUsage `json:"tier_usage,omitempty"`
+}
+
+// PutAdminProjectsProjectIdTiersTierJSONBodyTierModel defines parameters for PutAdminProjectsProjectIdTiersTier.
+type PutAdminProjectsProjectIdTiersTierJSONBodyTierModel string
+
+// PutAdminProjectsProjectIdTiersTierJSONBodyTierTime defines parameters for PutAdminProjectsProjectIdTiersTier.
+type PutAdminProjectsProjectIdTiersTierJSONBodyTierTime string
+
+// PutAdminProjectsProjectIdTiersTierJSONBodyTierUsage defines parameters for PutAdminProjectsProjectIdTiersTier.
+type PutAdminProjectsProjectIdTiersTierJSONBodyTierUsage string
+
 // PostAdminSsoProvidersJSONBody defines parameters for PostAdminSsoProviders.
 type PostAdminSsoProvidersJSONBody struct {
 	AttributeMapping *SAMLAttributeMappingSchema       `json:"attribute_mapping,omitempty"`
@@ -227,11 +936,71 @@ type PutAdminUsersUserIdFactorsFactorIdJSONBody = map[string]interface{}
 type PostInviteJSONBody struct {
 	Data  *map[string]interface{} `json:"data,omitempty"`
 	Email string                  `json:"email"`
+
+	// OrganizationId Organization the invited user joins.
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+
+	// ProjectId Project the invited user joins.
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
 }
 
+// PostAdminApiKeysJSONRequestBody defines body for PostAdminApiKeys for application/json ContentType.
+type PostAdminApiKeysJSONRequestBody PostAdminApiKeysJSONBody
+
 // PostAdminGenerateLinkJSONRequestBody defines body for PostAdminGenerateLink for application/json ContentType.
 type PostAdminGenerateLinkJSONRequestBody PostAdminGenerateLinkJSONBody
 
+// PostAdminInvitationsJSONRequestBody defines body for PostAdminInvitations for application/json ContentType.
+type PostAdminInvitationsJSONRequestBody PostAdminInvitationsJSONBody
+
+// PutAdminMfaOrganizationsOrganizationIdJSONRequestBody defines body for PutAdminMfaOrganizationsOrganizationId for application/json ContentType.
+type PutAdminMfaOrganizationsOrganizationIdJSONRequestBody = MFAPolicyParamsSchema
+
+// PostAdminOauthClientsJSONRequestBody defines body for PostAdminOauthClients for application/json ContentType.
+type PostAdminOauthClientsJSONRequestBody PostAdminOauthClientsJSONBody
+
+// PutAdminOauthClientsClientIdJSONRequestBody defines body for PutAdminOauthClientsClientId for application/json ContentType.
+type PutAdminOauthClientsClientIdJSONRequestBody PutAdminOauthClientsClientIdJSONBody
+
+// PostAdminOidcProjectsProjectIdProvidersJSONRequestBody defines body for PostAdminOidcProjectsProjectIdProviders for application/json ContentType.
+type PostAdminOidcProjectsProjectIdProvidersJSONRequestBody = CustomOIDCProviderParamsSchema
+
+// PutAdminOidcProjectsProjectIdProvidersProviderIdJSONRequestBody defines body for PutAdminOidcProjectsProjectIdProvidersProviderId for application/json ContentType.
+type PutAdminOidcProjectsProjectIdProvidersProviderIdJSONRequestBody = CustomOIDCProviderParamsSchema
+
+// PostAdminOrganizationsJSONRequestBody defines body for PostAdminOrganizations for application/json ContentType.
+type PostAdminOrganizationsJSONRequestBody PostAdminOrganizationsJSONBody
+
+// PutAdminOrganizationsOrganizationIdJSONRequestBody defines body for PutAdminOrganizationsOrganizationId for application/json ContentType.
+type PutAdminOrganizationsOrganizationIdJSONRequestBody PutAdminOrganizationsOrganizationIdJSONBody
+
+// PostAdminOrganizationsOrganizationIdMembersJSONRequestBody defines body for PostAdminOrganizationsOrganizationIdMembers for application/json ContentType.
+type PostAdminOrganizationsOrganizationIdMembersJSONRequestBody PostAdminOrganizationsOrganizationIdMembersJSONBody
+
+// PutAdminOrganizationsOrganizationIdTierJSONRequestBody defines body for PutAdminOrganizationsOrganizationIdTier for application/json ContentType.
+type PutAdminOrganizationsOrganizationIdTierJSONRequestBody = OrganizationTierParamsSchema
+
+// PostAdminProjectsJSONRequestBody defines body for PostAdminProjects for application/json ContentType.
+type PostAdminProjectsJSONRequestBody PostAdminProjectsJSONBody
+
+// DeleteAdminProjectsProjectIdJSONRequestBody defines body for DeleteAdminProjectsProjectId for application/json ContentType.
+type DeleteAdminProjectsProjectIdJSONRequestBody DeleteAdminProjectsProjectIdJSONBody
+
+// PutAdminProjectsProjectIdJSONRequestBody defines body for PutAdminProjectsProjectId for application/json ContentType.
+type PutAdminProjectsProjectIdJSONRequestBody PutAdminProjectsProjectIdJSONBody
+
+// PutAdminProjectsProjectIdTiersTierJSONRequestBody defines body for PutAdminProjectsProjectIdTiersTier for application/json ContentType.
+type PutAdminProjectsProjectIdTiersTierJSONRequestBody PutAdminProjectsProjectIdTiersTierJSONBody
+
+// PutAdminSettingsProjectsProjectIdJSONRequestBody defines body for PutAdminSettingsProjectsProjectId for application/json ContentType.
+type PutAdminSettingsProjectsProjectIdJSONRequestBody = ProjectSettingsParamsSchema
+
+// PutAdminSmtpOrganizationsOrganizationIdJSONRequestBody defines body for PutAdminSmtpOrganizationsOrganizationId for application/json ContentType.
+type PutAdminSmtpOrganizationsOrganizationIdJSONRequestBody = SMTPConfigParamsSchema
+
+// PutAdminSmtpProjectsProjectIdJSONRequestBody defines body for PutAdminSmtpProjectsProjectId for application/json ContentType.
+type PutAdminSmtpProjectsProjectIdJSONRequestBody = SMTPConfigParamsSchema
+
 // PostAdminSsoProvidersJSONRequestBody defines body for PostAdminSsoProviders for application/json ContentType.
 type PostAdminSsoProvidersJSONRequestBody PostAdminSsoProvidersJSONBody
 
@@ -320,6 +1089,20 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAdminApiKeys request
+	GetAdminApiKeys(ctx context.Context, params *GetAdminApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminApiKeysWithBody request with any body
+	PostAdminApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminApiKeys(ctx context.Context, body PostAdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminApiKeysKeyId request
+	DeleteAdminApiKeysKeyId(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminApiKeysKeyIdRotate request
+	PostAdminApiKeysKeyIdRotate(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAdminAudit request
 	GetAdminAudit(ctx context.Context, params *GetAdminAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -328,82 +1111,251 @@ type ClientInterface interface {
 
 	PostAdminGenerateLink(ctx context.Context, body PostAdminGenerateLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetAdminSsoProviders request
-	GetAdminSsoProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetAdminInvitations request
+	GetAdminInvitations(ctx context.Context, params *GetAdminInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PostAdminSsoProvidersWithBody request with any body
-	PostAdminSsoProvidersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostAdminInvitationsWithBody request with any body
+	PostAdminInvitationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostAdminSsoProviders(ctx context.Context, body PostAdminSsoProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostAdminInvitations(ctx context.Context, body PostAdminInvitationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeleteAdminSsoProvidersSsoProviderId request
-	DeleteAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DeleteAdminInvitationsInvitationId request
+	DeleteAdminInvitationsInvitationId(ctx context.Context, invitationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetAdminSsoProvidersSsoProviderId request
-	GetAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostAdminInvitationsInvitationIdResend request
+	PostAdminInvitationsInvitationIdResend(ctx context.Context, invitationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PutAdminSsoProvidersSsoProviderIdWithBody request with any body
-	PutAdminSsoProvidersSsoProviderIdWithBody(ctx context.Context, ssoProviderId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DeleteAdminMfaOrganizationsOrganizationId request
+	DeleteAdminMfaOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetAdminMfaOrganizationsOrganizationId request
+	GetAdminMfaOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetAdminUsers request
-	GetAdminUsers(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PutAdminMfaOrganizationsOrganizationIdWithBody request with any body
+	PutAdminMfaOrganizationsOrganizationIdWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeleteAdminUsersUserId request
-	DeleteAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutAdminMfaOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, body PutAdminMfaOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetAdminUsersUserId request
-	GetAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetAdminOauthClients request
+	GetAdminOauthClients(ctx context.Context, params *GetAdminOauthClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PutAdminUsersUserIdWithBody request with any body
-	PutAdminUsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostAdminOauthClientsWithBody request with any body
+	PostAdminOauthClientsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostAdminOauthClients(ctx context.Context, body PostAdminOauthClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetAdminUsersUserIdFactors request
-	GetAdminUsersUserIdFactors(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DeleteAdminOauthClientsClientId request
+	DeleteAdminOauthClientsClientId(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeleteAdminUsersUserIdFactorsFactorId request
-	DeleteAdminUsersUserIdFactorsFactorId(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetAdminOauthClientsClientId request
+	GetAdminOauthClientsClientId(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PutAdminUsersUserIdFactorsFactorIdWithBody request with any body
-	PutAdminUsersUserIdFactorsFactorIdWithBody(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PutAdminOauthClientsClientIdWithBody request with any body
+	PutAdminOauthClientsClientIdWithBody(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutAdminUsersUserIdFactorsFactorId(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutAdminOauthClientsClientId(ctx context.Context, clientId string, body PutAdminOauthClientsClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PostInviteWithBody request with any body
-	PostInviteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostAdminOauthClientsClientIdRegenerateSecret request
+	PostAdminOauthClientsClientIdRegenerateSecret(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostInvite(ctx context.Context, body PostInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
+	// GetAdminOidcProjectsProjectIdProviders request
+	GetAdminOidcProjectsProjectIdProviders(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (c *Client) GetAdminAudit(ctx context.Context, params *GetAdminAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAdminAuditRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// PostAdminOidcProjectsProjectIdProvidersWithBody request with any body
+	PostAdminOidcProjectsProjectIdProvidersWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (c *Client) PostAdminGenerateLinkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAdminGenerateLinkRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	PostAdminOidcProjectsProjectIdProviders(ctx context.Context, projectId openapi_types.UUID, body PostAdminOidcProjectsProjectIdProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminOidcProjectsProjectIdProvidersProviderId request
+	DeleteAdminOidcProjectsProjectIdProvidersProviderId(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminOidcProjectsProjectIdProvidersProviderId request
+	GetAdminOidcProjectsProjectIdProvidersProviderId(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminOidcProjectsProjectIdProvidersProviderIdWithBody request with any body
+	PutAdminOidcProjectsProjectIdProvidersProviderIdWithBody(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminOidcProjectsProjectIdProvidersProviderId(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, body PutAdminOidcProjectsProjectIdProvidersProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminOrganizations request
+	GetAdminOrganizations(ctx context.Context, params *GetAdminOrganizationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminOrganizationsWithBody request with any body
+	PostAdminOrganizationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminOrganizations(ctx context.Context, body PostAdminOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminOrganizationsOrganizationId request
+	DeleteAdminOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminOrganizationsOrganizationId request
+	GetAdminOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminOrganizationsOrganizationIdWithBody request with any body
+	PutAdminOrganizationsOrganizationIdWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminOrganizationsOrganizationIdMembers request
+	GetAdminOrganizationsOrganizationIdMembers(ctx context.Context, organizationId openapi_types.UUID, params *GetAdminOrganizationsOrganizationIdMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminOrganizationsOrganizationIdMembersWithBody request with any body
+	PostAdminOrganizationsOrganizationIdMembersWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminOrganizationsOrganizationIdMembers(ctx context.Context, organizationId openapi_types.UUID, body PostAdminOrganizationsOrganizationIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminOrganizationsOrganizationIdMembersUserId request
+	DeleteAdminOrganizationsOrganizationIdMembersUserId(ctx context.Context, organizationId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminOrganizationsOrganizationIdTier request
+	GetAdminOrganizationsOrganizationIdTier(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminOrganizationsOrganizationIdTierWithBody request with any body
+	PutAdminOrganizationsOrganizationIdTierWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminOrganizationsOrganizationIdTier(ctx context.Context, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminProjects request
+	GetAdminProjects(ctx context.Context, params *GetAdminProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminProjectsWithBody request with any body
+	PostAdminProjectsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminProjects(ctx context.Context, body PostAdminProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminProjectsProjectIdWithBody request with any body
+	DeleteAdminProjectsProjectIdWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteAdminProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, body DeleteAdminProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminProjectsProjectId request
+	GetAdminProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminProjectsProjectIdWithBody request with any body
+	PutAdminProjectsProjectIdWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, body PutAdminProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminProjectsProjectIdOrganizations request
+	GetAdminProjectsProjectIdOrganizations(ctx context.Context, projectId openapi_types.UUID, params *GetAdminProjectsProjectIdOrganizationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminProjectsProjectIdTiers request
+	GetAdminProjectsProjectIdTiers(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminProjectsProjectIdTiersTier request
+	DeleteAdminProjectsProjectIdTiersTier(ctx context.Context, projectId openapi_types.UUID, tier string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminProjectsProjectIdTiersTierWithBody request with any body
+	PutAdminProjectsProjectIdTiersTierWithBody(ctx context.Context, projectId openapi_types.UUID, tier string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminProjectsProjectIdTiersTier(ctx context.Context, projectId openapi_types.UUID, tier string, body PutAdminProjectsProjectIdTiersTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminSettingsProjectsProjectId request
+	DeleteAdminSettingsProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminSettingsProjectsProjectId request
+	GetAdminSettingsProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminSettingsProjectsProjectIdWithBody request with any body
+	PutAdminSettingsProjectsProjectIdWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminSettingsProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, body PutAdminSettingsProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminSmtpOrganizationsOrganizationId request
+	DeleteAdminSmtpOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminSmtpOrganizationsOrganizationId request
+	GetAdminSmtpOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminSmtpOrganizationsOrganizationIdWithBody request with any body
+	PutAdminSmtpOrganizationsOrganizationIdWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminSmtpOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, body PutAdminSmtpOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminSmtpProjectsProjectId request
+	DeleteAdminSmtpProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminSmtpProjectsProjectId request
+	GetAdminSmtpProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminSmtpProjectsProjectIdWithBody request with any body
+	PutAdminSmtpProjectsProjectIdWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminSmtpProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, body PutAdminSmtpProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminSsoProviders request
+	GetAdminSsoProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminSsoProvidersWithBody request with any body
+	PostAdminSsoProvidersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminSsoProviders(ctx context.Context, body PostAdminSsoProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminSsoProvidersSsoProviderId request
+	DeleteAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminSsoProvidersSsoProviderId request
+	GetAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminSsoProvidersSsoProviderIdWithBody request with any body
+	PutAdminSsoProvidersSsoProviderIdWithBody(ctx context.Context, ssoProviderId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminUsers request
+	GetAdminUsers(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminUsersUserId request
+	DeleteAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminUsersUserId request
+	GetAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminUsersUserIdWithBody request with any body
+	PutAdminUsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminUsersUserIdFactors request
+	GetAdminUsersUserIdFactors(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminUsersUserIdFactorsFactorId request
+	DeleteAdminUsersUserIdFactorsFactorId(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminUsersUserIdFactorsFactorIdWithBody request with any body
+	PutAdminUsersUserIdFactorsFactorIdWithBody(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminUsersUserIdFactorsFactorId(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminUsersUserIdRecoveryCodes request
+	DeleteAdminUsersUserIdRecoveryCodes(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminUsersUserIdSessions request
+	GetAdminUsersUserIdSessions(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminUsersUserIdSessionsSessionId request
+	DeleteAdminUsersUserIdSessionsSessionId(ctx context.Context, userId openapi_types.UUID, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostInviteWithBody request with any body
+	PostInviteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostInvite(ctx context.Context, body PostInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAdminApiKeys(ctx context.Context, params *GetAdminApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminApiKeysRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAdminGenerateLink(ctx context.Context, body PostAdminGenerateLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAdminGenerateLinkRequest(c.Server, body)
+func (c *Client) PostAdminApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminApiKeysRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -414,8 +1366,8 @@ func (c *Client) PostAdminGenerateLink(ctx context.Context, body PostAdminGenera
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAdminSsoProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAdminSsoProvidersRequest(c.Server)
+func (c *Client) PostAdminApiKeys(ctx context.Context, body PostAdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminApiKeysRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -426,8 +1378,8 @@ func (c *Client) GetAdminSsoProviders(ctx context.Context, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAdminSsoProvidersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAdminSsoProvidersRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteAdminApiKeysKeyId(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminApiKeysKeyIdRequest(c.Server, keyId)
 	if err != nil {
 		return nil, err
 	}
@@ -438,8 +1390,8 @@ func (c *Client) PostAdminSsoProvidersWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAdminSsoProviders(ctx context.Context, body PostAdminSsoProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAdminSsoProvidersRequest(c.Server, body)
+func (c *Client) PostAdminApiKeysKeyIdRotate(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminApiKeysKeyIdRotateRequest(c.Server, keyId)
 	if err != nil {
 		return nil, err
 	}
@@ -450,8 +1402,8 @@ func (c *Client) PostAdminSsoProviders(ctx context.Context, body PostAdminSsoPro
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAdminSsoProvidersSsoProviderIdRequest(c.Server, ssoProviderId)
+func (c *Client) GetAdminAudit(ctx context.Context, params *GetAdminAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminAuditRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -462,8 +1414,8 @@ func (c *Client) DeleteAdminSsoProvidersSsoProviderId(ctx context.Context, ssoPr
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAdminSsoProvidersSsoProviderIdRequest(c.Server, ssoProviderId)
+func (c *Client) PostAdminGenerateLinkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminGenerateLinkRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -474,8 +1426,8 @@ func (c *Client) GetAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProvi
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutAdminSsoProvidersSsoProviderIdWithBody(ctx context.Context, ssoProviderId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutAdminSsoProvidersSsoProviderIdRequestWithBody(c.Server, ssoProviderId, contentType, body)
+func (c *Client) PostAdminGenerateLink(ctx context.Context, body PostAdminGenerateLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminGenerateLinkRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -486,8 +1438,8 @@ func (c *Client) PutAdminSsoProvidersSsoProviderIdWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutAdminSsoProvidersSsoProviderIdRequest(c.Server, ssoProviderId, body)
+func (c *Client) GetAdminInvitations(ctx context.Context, params *GetAdminInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminInvitationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -498,8 +1450,8 @@ func (c *Client) PutAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProvi
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAdminUsers(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAdminUsersRequest(c.Server, params)
+func (c *Client) PostAdminInvitationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminInvitationsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -510,8 +1462,8 @@ func (c *Client) GetAdminUsers(ctx context.Context, params *GetAdminUsersParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAdminUsersUserIdRequest(c.Server, userId)
+func (c *Client) PostAdminInvitations(ctx context.Context, body PostAdminInvitationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminInvitationsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -522,8 +1474,8 @@ func (c *Client) DeleteAdminUsersUserId(ctx context.Context, userId openapi_type
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAdminUsersUserIdRequest(c.Server, userId)
+func (c *Client) DeleteAdminInvitationsInvitationId(ctx context.Context, invitationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminInvitationsInvitationIdRequest(c.Server, invitationId)
 	if err != nil {
 		return nil, err
 	}
@@ -534,8 +1486,8 @@ func (c *Client) GetAdminUsersUserId(ctx context.Context, userId openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutAdminUsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutAdminUsersUserIdRequestWithBody(c.Server, userId, contentType, body)
+func (c *Client) PostAdminInvitationsInvitationIdResend(ctx context.Context, invitationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminInvitationsInvitationIdResendRequest(c.Server, invitationId)
 	if err != nil {
 		return nil, err
 	}
@@ -546,8 +1498,8 @@ func (c *Client) PutAdminUsersUserIdWithBody(ctx context.Context, userId openapi
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutAdminUsersUserIdRequest(c.Server, userId, body)
+func (c *Client) DeleteAdminMfaOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminMfaOrganizationsOrganizationIdRequest(c.Server, organizationId)
 	if err != nil {
 		return nil, err
 	}
@@ -558,8 +1510,8 @@ func (c *Client) PutAdminUsersUserId(ctx context.Context, userId openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAdminUsersUserIdFactors(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAdminUsersUserIdFactorsRequest(c.Server, userId)
+func (c *Client) GetAdminMfaOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminMfaOrganizationsOrganizationIdRequest(c.Server, organizationId)
 	if err != nil {
 		return nil, err
 	}
@@ -570,8 +1522,8 @@ func (c *Client) GetAdminUsersUserIdFactors(ctx context.Context, userId openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAdminUsersUserIdFactorsFactorId(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAdminUsersUserIdFactorsFactorIdRequest(c.Server, userId, factorId)
+func (c *Client) PutAdminMfaOrganizationsOrganizationIdWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminMfaOrganizationsOrganizationIdRequestWithBody(c.Server, organizationId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -582,8 +1534,8 @@ func (c *Client) DeleteAdminUsersUserIdFactorsFactorId(ctx context.Context, user
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutAdminUsersUserIdFactorsFactorIdWithBody(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutAdminUsersUserIdFactorsFactorIdRequestWithBody(c.Server, userId, factorId, contentType, body)
+func (c *Client) PutAdminMfaOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, body PutAdminMfaOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminMfaOrganizationsOrganizationIdRequest(c.Server, organizationId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -594,8 +1546,8 @@ func (c *Client) PutAdminUsersUserIdFactorsFactorIdWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutAdminUsersUserIdFactorsFactorId(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutAdminUsersUserIdFactorsFactorIdRequest(c.Server, userId, factorId, body)
+func (c *Client) GetAdminOauthClients(ctx context.Context, params *GetAdminOauthClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminOauthClientsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -606,8 +1558,8 @@ func (c *Client) PutAdminUsersUserIdFactorsFactorId(ctx context.Context, userId
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostInviteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostInviteRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostAdminOauthClientsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOauthClientsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -618,8 +1570,8 @@ func (c *Client) PostInviteWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostInvite(ctx context.Context, body PostInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostInviteRequest(c.Server, body)
+func (c *Client) PostAdminOauthClients(ctx context.Context, body PostAdminOauthClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOauthClientsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -630,654 +1582,3879 @@ func (c *Client) PostInvite(ctx context.Context, body PostInviteJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-// NewGetAdminAuditRequest generates requests for GetAdminAudit
-func NewGetAdminAuditRequest(server string, params *GetAdminAuditParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteAdminOauthClientsClientId(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminOauthClientsClientIdRequest(c.Server, clientId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/audit")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetAdminOauthClientsClientId(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminOauthClientsClientIdRequest(c.Server, clientId)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PerPage != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PutAdminOauthClientsClientIdWithBody(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminOauthClientsClientIdRequestWithBody(c.Server, clientId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAdminGenerateLinkRequest calls the generic PostAdminGenerateLink builder with application/json body
-func NewPostAdminGenerateLinkRequest(server string, body PostAdminGenerateLinkJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutAdminOauthClientsClientId(ctx context.Context, clientId string, body PutAdminOauthClientsClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminOauthClientsClientIdRequest(c.Server, clientId, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAdminGenerateLinkRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAdminGenerateLinkRequestWithBody generates requests for PostAdminGenerateLink with any type of body
-func NewPostAdminGenerateLinkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostAdminOauthClientsClientIdRegenerateSecret(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOauthClientsClientIdRegenerateSecretRequest(c.Server, clientId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/generate_link")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetAdminOidcProjectsProjectIdProviders(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminOidcProjectsProjectIdProvidersRequest(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAdminSsoProvidersRequest generates requests for GetAdminSsoProviders
-func NewGetAdminSsoProvidersRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostAdminOidcProjectsProjectIdProvidersWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOidcProjectsProjectIdProvidersRequestWithBody(c.Server, projectId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/sso/providers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostAdminOidcProjectsProjectIdProviders(ctx context.Context, projectId openapi_types.UUID, body PostAdminOidcProjectsProjectIdProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOidcProjectsProjectIdProvidersRequest(c.Server, projectId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostAdminSsoProvidersRequest calls the generic PostAdminSsoProviders builder with application/json body
-func NewPostAdminSsoProvidersRequest(server string, body PostAdminSsoProvidersJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteAdminOidcProjectsProjectIdProvidersProviderId(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminOidcProjectsProjectIdProvidersProviderIdRequest(c.Server, projectId, providerId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAdminSsoProvidersRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAdminSsoProvidersRequestWithBody generates requests for PostAdminSsoProviders with any type of body
-func NewPostAdminSsoProvidersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetAdminOidcProjectsProjectIdProvidersProviderId(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminOidcProjectsProjectIdProvidersProviderIdRequest(c.Server, projectId, providerId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/sso/providers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutAdminOidcProjectsProjectIdProvidersProviderIdWithBody(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminOidcProjectsProjectIdProvidersProviderIdRequestWithBody(c.Server, projectId, providerId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteAdminSsoProvidersSsoProviderIdRequest generates requests for DeleteAdminSsoProvidersSsoProviderId
-func NewDeleteAdminSsoProvidersSsoProviderIdRequest(server string, ssoProviderId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ssoProviderId", runtime.ParamLocationPath, ssoProviderId)
+func (c *Client) PutAdminOidcProjectsProjectIdProvidersProviderId(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, body PutAdminOidcProjectsProjectIdProvidersProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminOidcProjectsProjectIdProvidersProviderIdRequest(c.Server, projectId, providerId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetAdminOrganizations(ctx context.Context, params *GetAdminOrganizationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminOrganizationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/sso/providers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostAdminOrganizationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOrganizationsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAdminSsoProvidersSsoProviderIdRequest generates requests for GetAdminSsoProvidersSsoProviderId
-func NewGetAdminSsoProvidersSsoProviderIdRequest(server string, ssoProviderId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ssoProviderId", runtime.ParamLocationPath, ssoProviderId)
+func (c *Client) PostAdminOrganizations(ctx context.Context, body PostAdminOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOrganizationsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteAdminOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminOrganizationsOrganizationIdRequest(c.Server, organizationId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/sso/providers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetAdminOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminOrganizationsOrganizationIdRequest(c.Server, organizationId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutAdminSsoProvidersSsoProviderIdRequest calls the generic PutAdminSsoProvidersSsoProviderId builder with application/json body
-func NewPutAdminSsoProvidersSsoProviderIdRequest(server string, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutAdminOrganizationsOrganizationIdWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminOrganizationsOrganizationIdRequestWithBody(c.Server, organizationId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutAdminSsoProvidersSsoProviderIdRequestWithBody(server, ssoProviderId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutAdminSsoProvidersSsoProviderIdRequestWithBody generates requests for PutAdminSsoProvidersSsoProviderId with any type of body
-func NewPutAdminSsoProvidersSsoProviderIdRequestWithBody(server string, ssoProviderId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ssoProviderId", runtime.ParamLocationPath, ssoProviderId)
+func (c *Client) PutAdminOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminOrganizationsOrganizationIdRequest(c.Server, organizationId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetAdminOrganizationsOrganizationIdMembers(ctx context.Context, organizationId openapi_types.UUID, params *GetAdminOrganizationsOrganizationIdMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminOrganizationsOrganizationIdMembersRequest(c.Server, organizationId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/sso/providers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostAdminOrganizationsOrganizationIdMembersWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOrganizationsOrganizationIdMembersRequestWithBody(c.Server, organizationId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAdminUsersRequest generates requests for GetAdminUsers
-func NewGetAdminUsersRequest(server string, params *GetAdminUsersParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostAdminOrganizationsOrganizationIdMembers(ctx context.Context, organizationId openapi_types.UUID, body PostAdminOrganizationsOrganizationIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminOrganizationsOrganizationIdMembersRequest(c.Server, organizationId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteAdminOrganizationsOrganizationIdMembersUserId(ctx context.Context, organizationId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminOrganizationsOrganizationIdMembersUserIdRequest(c.Server, organizationId, userId)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PerPage != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetAdminOrganizationsOrganizationIdTier(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminOrganizationsOrganizationIdTierRequest(c.Server, organizationId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteAdminUsersUserIdRequest generates requests for DeleteAdminUsersUserId
-func NewDeleteAdminUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) PutAdminOrganizationsOrganizationIdTierWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminOrganizationsOrganizationIdTierRequestWithBody(c.Server, organizationId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutAdminOrganizationsOrganizationIdTier(ctx context.Context, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminOrganizationsOrganizationIdTierRequest(c.Server, organizationId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetAdminProjects(ctx context.Context, params *GetAdminProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminProjectsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAdminUsersUserIdRequest generates requests for GetAdminUsersUserId
-func NewGetAdminUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) PostAdminProjectsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminProjectsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostAdminProjects(ctx context.Context, body PostAdminProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminProjectsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteAdminProjectsProjectIdWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminProjectsProjectIdRequestWithBody(c.Server, projectId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutAdminUsersUserIdRequest calls the generic PutAdminUsersUserId builder with application/json body
-func NewPutAdminUsersUserIdRequest(server string, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteAdminProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, body DeleteAdminProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminProjectsProjectIdRequest(c.Server, projectId, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutAdminUsersUserIdRequestWithBody(server, userId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutAdminUsersUserIdRequestWithBody generates requests for PutAdminUsersUserId with any type of body
-func NewPutAdminUsersUserIdRequestWithBody(server string, userId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) GetAdminProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminProjectsProjectIdRequest(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutAdminProjectsProjectIdWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminProjectsProjectIdRequestWithBody(c.Server, projectId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutAdminProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, body PutAdminProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminProjectsProjectIdRequest(c.Server, projectId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAdminUsersUserIdFactorsRequest generates requests for GetAdminUsersUserIdFactors
-func NewGetAdminUsersUserIdFactorsRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) GetAdminProjectsProjectIdOrganizations(ctx context.Context, projectId openapi_types.UUID, params *GetAdminProjectsProjectIdOrganizationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminProjectsProjectIdOrganizationsRequest(c.Server, projectId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetAdminProjectsProjectIdTiers(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminProjectsProjectIdTiersRequest(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/users/%s/factors", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteAdminProjectsProjectIdTiersTier(ctx context.Context, projectId openapi_types.UUID, tier string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminProjectsProjectIdTiersTierRequest(c.Server, projectId, tier)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteAdminUsersUserIdFactorsFactorIdRequest generates requests for DeleteAdminUsersUserIdFactorsFactorId
-func NewDeleteAdminUsersUserIdFactorsFactorIdRequest(server string, userId openapi_types.UUID, factorId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) PutAdminProjectsProjectIdTiersTierWithBody(ctx context.Context, projectId openapi_types.UUID, tier string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminProjectsProjectIdTiersTierRequestWithBody(c.Server, projectId, tier, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "factorId", runtime.ParamLocationPath, factorId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutAdminProjectsProjectIdTiersTier(ctx context.Context, projectId openapi_types.UUID, tier string, body PutAdminProjectsProjectIdTiersTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminProjectsProjectIdTiersTierRequest(c.Server, projectId, tier, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/users/%s/factors/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteAdminSettingsProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminSettingsProjectsProjectIdRequest(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutAdminUsersUserIdFactorsFactorIdRequest calls the generic PutAdminUsersUserIdFactorsFactorId builder with application/json body
-func NewPutAdminUsersUserIdFactorsFactorIdRequest(server string, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetAdminSettingsProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminSettingsProjectsProjectIdRequest(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutAdminUsersUserIdFactorsFactorIdRequestWithBody(server, userId, factorId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutAdminUsersUserIdFactorsFactorIdRequestWithBody generates requests for PutAdminUsersUserIdFactorsFactorId with any type of body
-func NewPutAdminUsersUserIdFactorsFactorIdRequestWithBody(server string, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) PutAdminSettingsProjectsProjectIdWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminSettingsProjectsProjectIdRequestWithBody(c.Server, projectId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "factorId", runtime.ParamLocationPath, factorId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutAdminSettingsProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, body PutAdminSettingsProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminSettingsProjectsProjectIdRequest(c.Server, projectId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/users/%s/factors/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteAdminSmtpOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminSmtpOrganizationsOrganizationIdRequest(c.Server, organizationId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) GetAdminSmtpOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminSmtpOrganizationsOrganizationIdRequest(c.Server, organizationId)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostInviteRequest calls the generic PostInvite builder with application/json body
-func NewPostInviteRequest(server string, body PostInviteJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutAdminSmtpOrganizationsOrganizationIdWithBody(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminSmtpOrganizationsOrganizationIdRequestWithBody(c.Server, organizationId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostInviteRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostInviteRequestWithBody generates requests for PostInvite with any type of body
-func NewPostInviteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutAdminSmtpOrganizationsOrganizationId(ctx context.Context, organizationId openapi_types.UUID, body PutAdminSmtpOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminSmtpOrganizationsOrganizationIdRequest(c.Server, organizationId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/invite")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteAdminSmtpProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminSmtpProjectsProjectIdRequest(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetAdminSmtpProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminSmtpProjectsProjectIdRequest(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PutAdminSmtpProjectsProjectIdWithBody(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminSmtpProjectsProjectIdRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminSmtpProjectsProjectId(ctx context.Context, projectId openapi_types.UUID, body PutAdminSmtpProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminSmtpProjectsProjectIdRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminSsoProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminSsoProvidersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminSsoProvidersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminSsoProvidersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminSsoProviders(ctx context.Context, body PostAdminSsoProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminSsoProvidersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminSsoProvidersSsoProviderIdRequest(c.Server, ssoProviderId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminSsoProvidersSsoProviderIdRequest(c.Server, ssoProviderId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminSsoProvidersSsoProviderIdWithBody(ctx context.Context, ssoProviderId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminSsoProvidersSsoProviderIdRequestWithBody(c.Server, ssoProviderId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminSsoProvidersSsoProviderId(ctx context.Context, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminSsoProvidersSsoProviderIdRequest(c.Server, ssoProviderId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminUsers(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminUsersUserIdRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminUsersUserIdRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminUsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminUsersUserIdRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminUsersUserId(ctx context.Context, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminUsersUserIdRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminUsersUserIdFactors(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminUsersUserIdFactorsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminUsersUserIdFactorsFactorId(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminUsersUserIdFactorsFactorIdRequest(c.Server, userId, factorId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminUsersUserIdFactorsFactorIdWithBody(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminUsersUserIdFactorsFactorIdRequestWithBody(c.Server, userId, factorId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminUsersUserIdFactorsFactorId(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminUsersUserIdFactorsFactorIdRequest(c.Server, userId, factorId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminUsersUserIdRecoveryCodes(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminUsersUserIdRecoveryCodesRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminUsersUserIdSessions(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminUsersUserIdSessionsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminUsersUserIdSessionsSessionId(ctx context.Context, userId openapi_types.UUID, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminUsersUserIdSessionsSessionIdRequest(c.Server, userId, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInviteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInviteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInvite(ctx context.Context, body PostInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInviteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAdminApiKeysRequest generates requests for GetAdminApiKeys
+func NewGetAdminApiKeysRequest(server string, params *GetAdminApiKeysParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/api_keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrganizationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organization_id", runtime.ParamLocationQuery, *params.OrganizationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminApiKeysRequest calls the generic PostAdminApiKeys builder with application/json body
+func NewPostAdminApiKeysRequest(server string, body PostAdminApiKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminApiKeysRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAdminApiKeysRequestWithBody generates requests for PostAdminApiKeys with any type of body
+func NewPostAdminApiKeysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/api_keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminApiKeysKeyIdRequest generates requests for DeleteAdminApiKeysKeyId
+func NewDeleteAdminApiKeysKeyIdRequest(server string, keyId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key_id", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/api_keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminApiKeysKeyIdRotateRequest generates requests for PostAdminApiKeysKeyIdRotate
+func NewPostAdminApiKeysKeyIdRotateRequest(server string, keyId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key_id", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/api_keys/%s/rotate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminAuditRequest generates requests for GetAdminAudit
+func NewGetAdminAuditRequest(server string, params *GetAdminAuditParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminGenerateLinkRequest calls the generic PostAdminGenerateLink builder with application/json body
+func NewPostAdminGenerateLinkRequest(server string, body PostAdminGenerateLinkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminGenerateLinkRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAdminGenerateLinkRequestWithBody generates requests for PostAdminGenerateLink with any type of body
+func NewPostAdminGenerateLinkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/generate_link")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminInvitationsRequest generates requests for GetAdminInvitations
+func NewGetAdminInvitationsRequest(server string, params *GetAdminInvitationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrganizationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organization_id", runtime.ParamLocationQuery, *params.OrganizationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminInvitationsRequest calls the generic PostAdminInvitations builder with application/json body
+func NewPostAdminInvitationsRequest(server string, body PostAdminInvitationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminInvitationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAdminInvitationsRequestWithBody generates requests for PostAdminInvitations with any type of body
+func NewPostAdminInvitationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminInvitationsInvitationIdRequest generates requests for DeleteAdminInvitationsInvitationId
+func NewDeleteAdminInvitationsInvitationIdRequest(server string, invitationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invitation_id", runtime.ParamLocationPath, invitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/invitations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminInvitationsInvitationIdResendRequest generates requests for PostAdminInvitationsInvitationIdResend
+func NewPostAdminInvitationsInvitationIdResendRequest(server string, invitationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invitation_id", runtime.ParamLocationPath, invitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/invitations/%s/resend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminMfaOrganizationsOrganizationIdRequest generates requests for DeleteAdminMfaOrganizationsOrganizationId
+func NewDeleteAdminMfaOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/mfa/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminMfaOrganizationsOrganizationIdRequest generates requests for GetAdminMfaOrganizationsOrganizationId
+func NewGetAdminMfaOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/mfa/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminMfaOrganizationsOrganizationIdRequest calls the generic PutAdminMfaOrganizationsOrganizationId builder with application/json body
+func NewPutAdminMfaOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID, body PutAdminMfaOrganizationsOrganizationIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminMfaOrganizationsOrganizationIdRequestWithBody(server, organizationId, "application/json", bodyReader)
+}
+
+// NewPutAdminMfaOrganizationsOrganizationIdRequestWithBody generates requests for PutAdminMfaOrganizationsOrganizationId with any type of body
+func NewPutAdminMfaOrganizationsOrganizationIdRequestWithBody(server string, organizationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/mfa/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminOauthClientsRequest generates requests for GetAdminOauthClients
+func NewGetAdminOauthClientsRequest(server string, params *GetAdminOauthClientsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oauth/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminOauthClientsRequest calls the generic PostAdminOauthClients builder with application/json body
+func NewPostAdminOauthClientsRequest(server string, body PostAdminOauthClientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminOauthClientsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAdminOauthClientsRequestWithBody generates requests for PostAdminOauthClients with any type of body
+func NewPostAdminOauthClientsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oauth/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminOauthClientsClientIdRequest generates requests for DeleteAdminOauthClientsClientId
+func NewDeleteAdminOauthClientsClientIdRequest(server string, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oauth/clients/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminOauthClientsClientIdRequest generates requests for GetAdminOauthClientsClientId
+func NewGetAdminOauthClientsClientIdRequest(server string, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oauth/clients/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminOauthClientsClientIdRequest calls the generic PutAdminOauthClientsClientId builder with application/json body
+func NewPutAdminOauthClientsClientIdRequest(server string, clientId string, body PutAdminOauthClientsClientIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminOauthClientsClientIdRequestWithBody(server, clientId, "application/json", bodyReader)
+}
+
+// NewPutAdminOauthClientsClientIdRequestWithBody generates requests for PutAdminOauthClientsClientId with any type of body
+func NewPutAdminOauthClientsClientIdRequestWithBody(server string, clientId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oauth/clients/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAdminOauthClientsClientIdRegenerateSecretRequest generates requests for PostAdminOauthClientsClientIdRegenerateSecret
+func NewPostAdminOauthClientsClientIdRegenerateSecretRequest(server string, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oauth/clients/%s/regenerate_secret", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminOidcProjectsProjectIdProvidersRequest generates requests for GetAdminOidcProjectsProjectIdProviders
+func NewGetAdminOidcProjectsProjectIdProvidersRequest(server string, projectId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oidc/projects/%s/providers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminOidcProjectsProjectIdProvidersRequest calls the generic PostAdminOidcProjectsProjectIdProviders builder with application/json body
+func NewPostAdminOidcProjectsProjectIdProvidersRequest(server string, projectId openapi_types.UUID, body PostAdminOidcProjectsProjectIdProvidersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminOidcProjectsProjectIdProvidersRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewPostAdminOidcProjectsProjectIdProvidersRequestWithBody generates requests for PostAdminOidcProjectsProjectIdProviders with any type of body
+func NewPostAdminOidcProjectsProjectIdProvidersRequestWithBody(server string, projectId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oidc/projects/%s/providers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminOidcProjectsProjectIdProvidersProviderIdRequest generates requests for DeleteAdminOidcProjectsProjectIdProvidersProviderId
+func NewDeleteAdminOidcProjectsProjectIdProvidersProviderIdRequest(server string, projectId openapi_types.UUID, providerId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "provider_id", runtime.ParamLocationPath, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oidc/projects/%s/providers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminOidcProjectsProjectIdProvidersProviderIdRequest generates requests for GetAdminOidcProjectsProjectIdProvidersProviderId
+func NewGetAdminOidcProjectsProjectIdProvidersProviderIdRequest(server string, projectId openapi_types.UUID, providerId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "provider_id", runtime.ParamLocationPath, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oidc/projects/%s/providers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminOidcProjectsProjectIdProvidersProviderIdRequest calls the generic PutAdminOidcProjectsProjectIdProvidersProviderId builder with application/json body
+func NewPutAdminOidcProjectsProjectIdProvidersProviderIdRequest(server string, projectId openapi_types.UUID, providerId openapi_types.UUID, body PutAdminOidcProjectsProjectIdProvidersProviderIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminOidcProjectsProjectIdProvidersProviderIdRequestWithBody(server, projectId, providerId, "application/json", bodyReader)
+}
+
+// NewPutAdminOidcProjectsProjectIdProvidersProviderIdRequestWithBody generates requests for PutAdminOidcProjectsProjectIdProvidersProviderId with any type of body
+func NewPutAdminOidcProjectsProjectIdProvidersProviderIdRequestWithBody(server string, projectId openapi_types.UUID, providerId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "provider_id", runtime.ParamLocationPath, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/oidc/projects/%s/providers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminOrganizationsRequest generates requests for GetAdminOrganizations
+func NewGetAdminOrganizationsRequest(server string, params *GetAdminOrganizationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminOrganizationsRequest calls the generic PostAdminOrganizations builder with application/json body
+func NewPostAdminOrganizationsRequest(server string, body PostAdminOrganizationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminOrganizationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAdminOrganizationsRequestWithBody generates requests for PostAdminOrganizations with any type of body
+func NewPostAdminOrganizationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminOrganizationsOrganizationIdRequest generates requests for DeleteAdminOrganizationsOrganizationId
+func NewDeleteAdminOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminOrganizationsOrganizationIdRequest generates requests for GetAdminOrganizationsOrganizationId
+func NewGetAdminOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminOrganizationsOrganizationIdRequest calls the generic PutAdminOrganizationsOrganizationId builder with application/json body
+func NewPutAdminOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminOrganizationsOrganizationIdRequestWithBody(server, organizationId, "application/json", bodyReader)
+}
+
+// NewPutAdminOrganizationsOrganizationIdRequestWithBody generates requests for PutAdminOrganizationsOrganizationId with any type of body
+func NewPutAdminOrganizationsOrganizationIdRequestWithBody(server string, organizationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminOrganizationsOrganizationIdMembersRequest generates requests for GetAdminOrganizationsOrganizationIdMembers
+func NewGetAdminOrganizationsOrganizationIdMembersRequest(server string, organizationId openapi_types.UUID, params *GetAdminOrganizationsOrganizationIdMembersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminOrganizationsOrganizationIdMembersRequest calls the generic PostAdminOrganizationsOrganizationIdMembers builder with application/json body
+func NewPostAdminOrganizationsOrganizationIdMembersRequest(server string, organizationId openapi_types.UUID, body PostAdminOrganizationsOrganizationIdMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminOrganizationsOrganizationIdMembersRequestWithBody(server, organizationId, "application/json", bodyReader)
+}
+
+// NewPostAdminOrganizationsOrganizationIdMembersRequestWithBody generates requests for PostAdminOrganizationsOrganizationIdMembers with any type of body
+func NewPostAdminOrganizationsOrganizationIdMembersRequestWithBody(server string, organizationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminOrganizationsOrganizationIdMembersUserIdRequest generates requests for DeleteAdminOrganizationsOrganizationIdMembersUserId
+func NewDeleteAdminOrganizationsOrganizationIdMembersUserIdRequest(server string, organizationId openapi_types.UUID, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminOrganizationsOrganizationIdTierRequest generates requests for GetAdminOrganizationsOrganizationIdTier
+func NewGetAdminOrganizationsOrganizationIdTierRequest(server string, organizationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations/%s/tier", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminOrganizationsOrganizationIdTierRequest calls the generic PutAdminOrganizationsOrganizationIdTier builder with application/json body
+func NewPutAdminOrganizationsOrganizationIdTierRequest(server string, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdTierJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminOrganizationsOrganizationIdTierRequestWithBody(server, organizationId, "application/json", bodyReader)
+}
+
+// NewPutAdminOrganizationsOrganizationIdTierRequestWithBody generates requests for PutAdminOrganizationsOrganizationIdTier with any type of body
+func NewPutAdminOrganizationsOrganizationIdTierRequestWithBody(server string, organizationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organizations/%s/tier", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminProjectsRequest generates requests for GetAdminProjects
+func NewGetAdminProjectsRequest(server string, params *GetAdminProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminProjectsRequest calls the generic PostAdminProjects builder with application/json body
+func NewPostAdminProjectsRequest(server string, body PostAdminProjectsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminProjectsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAdminProjectsRequestWithBody generates requests for PostAdminProjects with any type of body
+func NewPostAdminProjectsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminProjectsProjectIdRequest calls the generic DeleteAdminProjectsProjectId builder with application/json body
+func NewDeleteAdminProjectsProjectIdRequest(server string, projectId openapi_types.UUID, body DeleteAdminProjectsProjectIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteAdminProjectsProjectIdRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewDeleteAdminProjectsProjectIdRequestWithBody generates requests for DeleteAdminProjectsProjectId with any type of body
+func NewDeleteAdminProjectsProjectIdRequestWithBody(server string, projectId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminProjectsProjectIdRequest generates requests for GetAdminProjectsProjectId
+func NewGetAdminProjectsProjectIdRequest(server string, projectId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminProjectsProjectIdRequest calls the generic PutAdminProjectsProjectId builder with application/json body
+func NewPutAdminProjectsProjectIdRequest(server string, projectId openapi_types.UUID, body PutAdminProjectsProjectIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminProjectsProjectIdRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewPutAdminProjectsProjectIdRequestWithBody generates requests for PutAdminProjectsProjectId with any type of body
+func NewPutAdminProjectsProjectIdRequestWithBody(server string, projectId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminProjectsProjectIdOrganizationsRequest generates requests for GetAdminProjectsProjectIdOrganizations
+func NewGetAdminProjectsProjectIdOrganizationsRequest(server string, projectId openapi_types.UUID, params *GetAdminProjectsProjectIdOrganizationsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects/%s/organizations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminProjectsProjectIdTiersRequest generates requests for GetAdminProjectsProjectIdTiers
+func NewGetAdminProjectsProjectIdTiersRequest(server string, projectId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects/%s/tiers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminProjectsProjectIdTiersTierRequest generates requests for DeleteAdminProjectsProjectIdTiersTier
+func NewDeleteAdminProjectsProjectIdTiersTierRequest(server string, projectId openapi_types.UUID, tier string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tier", runtime.ParamLocationPath, tier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects/%s/tiers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminProjectsProjectIdTiersTierRequest calls the generic PutAdminProjectsProjectIdTiersTier builder with application/json body
+func NewPutAdminProjectsProjectIdTiersTierRequest(server string, projectId openapi_types.UUID, tier string, body PutAdminProjectsProjectIdTiersTierJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminProjectsProjectIdTiersTierRequestWithBody(server, projectId, tier, "application/json", bodyReader)
+}
+
+// NewPutAdminProjectsProjectIdTiersTierRequestWithBody generates requests for PutAdminProjectsProjectIdTiersTier with any type of body
+func NewPutAdminProjectsProjectIdTiersTierRequestWithBody(server string, projectId openapi_types.UUID, tier string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tier", runtime.ParamLocationPath, tier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/projects/%s/tiers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminSettingsProjectsProjectIdRequest generates requests for DeleteAdminSettingsProjectsProjectId
+func NewDeleteAdminSettingsProjectsProjectIdRequest(server string, projectId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/settings/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminSettingsProjectsProjectIdRequest generates requests for GetAdminSettingsProjectsProjectId
+func NewGetAdminSettingsProjectsProjectIdRequest(server string, projectId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/settings/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminSettingsProjectsProjectIdRequest calls the generic PutAdminSettingsProjectsProjectId builder with application/json body
+func NewPutAdminSettingsProjectsProjectIdRequest(server string, projectId openapi_types.UUID, body PutAdminSettingsProjectsProjectIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminSettingsProjectsProjectIdRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewPutAdminSettingsProjectsProjectIdRequestWithBody generates requests for PutAdminSettingsProjectsProjectId with any type of body
+func NewPutAdminSettingsProjectsProjectIdRequestWithBody(server string, projectId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/settings/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminSmtpOrganizationsOrganizationIdRequest generates requests for DeleteAdminSmtpOrganizationsOrganizationId
+func NewDeleteAdminSmtpOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/smtp/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminSmtpOrganizationsOrganizationIdRequest generates requests for GetAdminSmtpOrganizationsOrganizationId
+func NewGetAdminSmtpOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/smtp/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminSmtpOrganizationsOrganizationIdRequest calls the generic PutAdminSmtpOrganizationsOrganizationId builder with application/json body
+func NewPutAdminSmtpOrganizationsOrganizationIdRequest(server string, organizationId openapi_types.UUID, body PutAdminSmtpOrganizationsOrganizationIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminSmtpOrganizationsOrganizationIdRequestWithBody(server, organizationId, "application/json", bodyReader)
+}
+
+// NewPutAdminSmtpOrganizationsOrganizationIdRequestWithBody generates requests for PutAdminSmtpOrganizationsOrganizationId with any type of body
+func NewPutAdminSmtpOrganizationsOrganizationIdRequestWithBody(server string, organizationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization_id", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/smtp/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminSmtpProjectsProjectIdRequest generates requests for DeleteAdminSmtpProjectsProjectId
+func NewDeleteAdminSmtpProjectsProjectIdRequest(server string, projectId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/smtp/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminSmtpProjectsProjectIdRequest generates requests for GetAdminSmtpProjectsProjectId
+func NewGetAdminSmtpProjectsProjectIdRequest(server string, projectId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/smtp/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminSmtpProjectsProjectIdRequest calls the generic PutAdminSmtpProjectsProjectId builder with application/json body
+func NewPutAdminSmtpProjectsProjectIdRequest(server string, projectId openapi_types.UUID, body PutAdminSmtpProjectsProjectIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminSmtpProjectsProjectIdRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewPutAdminSmtpProjectsProjectIdRequestWithBody generates requests for PutAdminSmtpProjectsProjectId with any type of body
+func NewPutAdminSmtpProjectsProjectIdRequestWithBody(server string, projectId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/smtp/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminSsoProvidersRequest generates requests for GetAdminSsoProviders
+func NewGetAdminSsoProvidersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sso/providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminSsoProvidersRequest calls the generic PostAdminSsoProviders builder with application/json body
+func NewPostAdminSsoProvidersRequest(server string, body PostAdminSsoProvidersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminSsoProvidersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAdminSsoProvidersRequestWithBody generates requests for PostAdminSsoProviders with any type of body
+func NewPostAdminSsoProvidersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sso/providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminSsoProvidersSsoProviderIdRequest generates requests for DeleteAdminSsoProvidersSsoProviderId
+func NewDeleteAdminSsoProvidersSsoProviderIdRequest(server string, ssoProviderId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ssoProviderId", runtime.ParamLocationPath, ssoProviderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sso/providers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminSsoProvidersSsoProviderIdRequest generates requests for GetAdminSsoProvidersSsoProviderId
+func NewGetAdminSsoProvidersSsoProviderIdRequest(server string, ssoProviderId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ssoProviderId", runtime.ParamLocationPath, ssoProviderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sso/providers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminSsoProvidersSsoProviderIdRequest calls the generic PutAdminSsoProvidersSsoProviderId builder with application/json body
+func NewPutAdminSsoProvidersSsoProviderIdRequest(server string, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminSsoProvidersSsoProviderIdRequestWithBody(server, ssoProviderId, "application/json", bodyReader)
+}
+
+// NewPutAdminSsoProvidersSsoProviderIdRequestWithBody generates requests for PutAdminSsoProvidersSsoProviderId with any type of body
+func NewPutAdminSsoProvidersSsoProviderIdRequestWithBody(server string, ssoProviderId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ssoProviderId", runtime.ParamLocationPath, ssoProviderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sso/providers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminUsersRequest generates requests for GetAdminUsers
+func NewGetAdminUsersRequest(server string, params *GetAdminUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminUsersUserIdRequest generates requests for DeleteAdminUsersUserId
+func NewDeleteAdminUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminUsersUserIdRequest generates requests for GetAdminUsersUserId
+func NewGetAdminUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminUsersUserIdRequest calls the generic PutAdminUsersUserId builder with application/json body
+func NewPutAdminUsersUserIdRequest(server string, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminUsersUserIdRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewPutAdminUsersUserIdRequestWithBody generates requests for PutAdminUsersUserId with any type of body
+func NewPutAdminUsersUserIdRequestWithBody(server string, userId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminUsersUserIdFactorsRequest generates requests for GetAdminUsersUserIdFactors
+func NewGetAdminUsersUserIdFactorsRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/factors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminUsersUserIdFactorsFactorIdRequest generates requests for DeleteAdminUsersUserIdFactorsFactorId
+func NewDeleteAdminUsersUserIdFactorsFactorIdRequest(server string, userId openapi_types.UUID, factorId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "factorId", runtime.ParamLocationPath, factorId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/factors/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminUsersUserIdFactorsFactorIdRequest calls the generic PutAdminUsersUserIdFactorsFactorId builder with application/json body
+func NewPutAdminUsersUserIdFactorsFactorIdRequest(server string, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminUsersUserIdFactorsFactorIdRequestWithBody(server, userId, factorId, "application/json", bodyReader)
+}
+
+// NewPutAdminUsersUserIdFactorsFactorIdRequestWithBody generates requests for PutAdminUsersUserIdFactorsFactorId with any type of body
+func NewPutAdminUsersUserIdFactorsFactorIdRequestWithBody(server string, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "factorId", runtime.ParamLocationPath, factorId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/factors/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminUsersUserIdRecoveryCodesRequest generates requests for DeleteAdminUsersUserIdRecoveryCodes
+func NewDeleteAdminUsersUserIdRecoveryCodesRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/recovery_codes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminUsersUserIdSessionsRequest generates requests for GetAdminUsersUserIdSessions
+func NewGetAdminUsersUserIdSessionsRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminUsersUserIdSessionsSessionIdRequest generates requests for DeleteAdminUsersUserIdSessionsSessionId
+func NewDeleteAdminUsersUserIdSessionsSessionIdRequest(server string, userId openapi_types.UUID, sessionId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/sessions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostInviteRequest calls the generic PostInvite builder with application/json body
+func NewPostInviteRequest(server string, body PostInviteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostInviteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostInviteRequestWithBody generates requests for PostInvite with any type of body
+func NewPostInviteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invite")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // ClientWithResponses builds on ClientInterface to offer response payloads
@@ -1285,766 +5462,5438 @@ type ClientWithResponses struct {
 	ClientInterface
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAdminApiKeysWithResponse request
+	GetAdminApiKeysWithResponse(ctx context.Context, params *GetAdminApiKeysParams, reqEditors ...RequestEditorFn) (*GetAdminApiKeysResponse, error)
+
+	// PostAdminApiKeysWithBodyWithResponse request with any body
+	PostAdminApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminApiKeysResponse, error)
+
+	PostAdminApiKeysWithResponse(ctx context.Context, body PostAdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminApiKeysResponse, error)
+
+	// DeleteAdminApiKeysKeyIdWithResponse request
+	DeleteAdminApiKeysKeyIdWithResponse(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminApiKeysKeyIdResponse, error)
+
+	// PostAdminApiKeysKeyIdRotateWithResponse request
+	PostAdminApiKeysKeyIdRotateWithResponse(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminApiKeysKeyIdRotateResponse, error)
+
+	// GetAdminAuditWithResponse request
+	GetAdminAuditWithResponse(ctx context.Context, params *GetAdminAuditParams, reqEditors ...RequestEditorFn) (*GetAdminAuditResponse, error)
+
+	// PostAdminGenerateLinkWithBodyWithResponse request with any body
+	PostAdminGenerateLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminGenerateLinkResponse, error)
+
+	PostAdminGenerateLinkWithResponse(ctx context.Context, body PostAdminGenerateLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminGenerateLinkResponse, error)
+
+	// GetAdminInvitationsWithResponse request
+	GetAdminInvitationsWithResponse(ctx context.Context, params *GetAdminInvitationsParams, reqEditors ...RequestEditorFn) (*GetAdminInvitationsResponse, error)
+
+	// PostAdminInvitationsWithBodyWithResponse request with any body
+	PostAdminInvitationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminInvitationsResponse, error)
+
+	PostAdminInvitationsWithResponse(ctx context.Context, body PostAdminInvitationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminInvitationsResponse, error)
+
+	// DeleteAdminInvitationsInvitationIdWithResponse request
+	DeleteAdminInvitationsInvitationIdWithResponse(ctx context.Context, invitationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminInvitationsInvitationIdResponse, error)
+
+	// PostAdminInvitationsInvitationIdResendWithResponse request
+	PostAdminInvitationsInvitationIdResendWithResponse(ctx context.Context, invitationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminInvitationsInvitationIdResendResponse, error)
+
+	// DeleteAdminMfaOrganizationsOrganizationIdWithResponse request
+	DeleteAdminMfaOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminMfaOrganizationsOrganizationIdResponse, error)
+
+	// GetAdminMfaOrganizationsOrganizationIdWithResponse request
+	GetAdminMfaOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminMfaOrganizationsOrganizationIdResponse, error)
+
+	// PutAdminMfaOrganizationsOrganizationIdWithBodyWithResponse request with any body
+	PutAdminMfaOrganizationsOrganizationIdWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminMfaOrganizationsOrganizationIdResponse, error)
+
+	PutAdminMfaOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PutAdminMfaOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminMfaOrganizationsOrganizationIdResponse, error)
+
+	// GetAdminOauthClientsWithResponse request
+	GetAdminOauthClientsWithResponse(ctx context.Context, params *GetAdminOauthClientsParams, reqEditors ...RequestEditorFn) (*GetAdminOauthClientsResponse, error)
+
+	// PostAdminOauthClientsWithBodyWithResponse request with any body
+	PostAdminOauthClientsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminOauthClientsResponse, error)
+
+	PostAdminOauthClientsWithResponse(ctx context.Context, body PostAdminOauthClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminOauthClientsResponse, error)
+
+	// DeleteAdminOauthClientsClientIdWithResponse request
+	DeleteAdminOauthClientsClientIdWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*DeleteAdminOauthClientsClientIdResponse, error)
+
+	// GetAdminOauthClientsClientIdWithResponse request
+	GetAdminOauthClientsClientIdWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*GetAdminOauthClientsClientIdResponse, error)
+
+	// PutAdminOauthClientsClientIdWithBodyWithResponse request with any body
+	PutAdminOauthClientsClientIdWithBodyWithResponse(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminOauthClientsClientIdResponse, error)
+
+	PutAdminOauthClientsClientIdWithResponse(ctx context.Context, clientId string, body PutAdminOauthClientsClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminOauthClientsClientIdResponse, error)
+
+	// PostAdminOauthClientsClientIdRegenerateSecretWithResponse request
+	PostAdminOauthClientsClientIdRegenerateSecretWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*PostAdminOauthClientsClientIdRegenerateSecretResponse, error)
+
+	// GetAdminOidcProjectsProjectIdProvidersWithResponse request
+	GetAdminOidcProjectsProjectIdProvidersWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminOidcProjectsProjectIdProvidersResponse, error)
+
+	// PostAdminOidcProjectsProjectIdProvidersWithBodyWithResponse request with any body
+	PostAdminOidcProjectsProjectIdProvidersWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminOidcProjectsProjectIdProvidersResponse, error)
+
+	PostAdminOidcProjectsProjectIdProvidersWithResponse(ctx context.Context, projectId openapi_types.UUID, body PostAdminOidcProjectsProjectIdProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminOidcProjectsProjectIdProvidersResponse, error)
+
+	// DeleteAdminOidcProjectsProjectIdProvidersProviderIdWithResponse request
+	DeleteAdminOidcProjectsProjectIdProvidersProviderIdWithResponse(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse, error)
+
+	// GetAdminOidcProjectsProjectIdProvidersProviderIdWithResponse request
+	GetAdminOidcProjectsProjectIdProvidersProviderIdWithResponse(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminOidcProjectsProjectIdProvidersProviderIdResponse, error)
+
+	// PutAdminOidcProjectsProjectIdProvidersProviderIdWithBodyWithResponse request with any body
+	PutAdminOidcProjectsProjectIdProvidersProviderIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminOidcProjectsProjectIdProvidersProviderIdResponse, error)
+
+	PutAdminOidcProjectsProjectIdProvidersProviderIdWithResponse(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, body PutAdminOidcProjectsProjectIdProvidersProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminOidcProjectsProjectIdProvidersProviderIdResponse, error)
+
+	// GetAdminOrganizationsWithResponse request
+	GetAdminOrganizationsWithResponse(ctx context.Context, params *GetAdminOrganizationsParams, reqEditors ...RequestEditorFn) (*GetAdminOrganizationsResponse, error)
+
+	// PostAdminOrganizationsWithBodyWithResponse request with any body
+	PostAdminOrganizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminOrganizationsResponse, error)
+
+	PostAdminOrganizationsWithResponse(ctx context.Context, body PostAdminOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminOrganizationsResponse, error)
+
+	// DeleteAdminOrganizationsOrganizationIdWithResponse request
+	DeleteAdminOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminOrganizationsOrganizationIdResponse, error)
+
+	// GetAdminOrganizationsOrganizationIdWithResponse request
+	GetAdminOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminOrganizationsOrganizationIdResponse, error)
+
+	// PutAdminOrganizationsOrganizationIdWithBodyWithResponse request with any body
+	PutAdminOrganizationsOrganizationIdWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminOrganizationsOrganizationIdResponse, error)
+
+	PutAdminOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminOrganizationsOrganizationIdResponse, error)
+
+	// GetAdminOrganizationsOrganizationIdMembersWithResponse request
+	GetAdminOrganizationsOrganizationIdMembersWithResponse(ctx context.Context, organizationId openapi_types.UUID, params *GetAdminOrganizationsOrganizationIdMembersParams, reqEditors ...RequestEditorFn) (*GetAdminOrganizationsOrganizationIdMembersResponse, error)
+
+	// PostAdminOrganizationsOrganizationIdMembersWithBodyWithResponse request with any body
+	PostAdminOrganizationsOrganizationIdMembersWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminOrganizationsOrganizationIdMembersResponse, error)
+
+	PostAdminOrganizationsOrganizationIdMembersWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PostAdminOrganizationsOrganizationIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminOrganizationsOrganizationIdMembersResponse, error)
+
+	// DeleteAdminOrganizationsOrganizationIdMembersUserIdWithResponse request
+	DeleteAdminOrganizationsOrganizationIdMembersUserIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminOrganizationsOrganizationIdMembersUserIdResponse, error)
+
+	// GetAdminOrganizationsOrganizationIdTierWithResponse request
+	GetAdminOrganizationsOrganizationIdTierWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminOrganizationsOrganizationIdTierResponse, error)
+
+	// PutAdminOrganizationsOrganizationIdTierWithBodyWithResponse request with any body
+	PutAdminOrganizationsOrganizationIdTierWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminOrganizationsOrganizationIdTierResponse, error)
+
+	PutAdminOrganizationsOrganizationIdTierWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdTierJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminOrganizationsOrganizationIdTierResponse, error)
+
+	// GetAdminProjectsWithResponse request
+	GetAdminProjectsWithResponse(ctx context.Context, params *GetAdminProjectsParams, reqEditors ...RequestEditorFn) (*GetAdminProjectsResponse, error)
+
+	// PostAdminProjectsWithBodyWithResponse request with any body
+	PostAdminProjectsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminProjectsResponse, error)
+
+	PostAdminProjectsWithResponse(ctx context.Context, body PostAdminProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminProjectsResponse, error)
+
+	// DeleteAdminProjectsProjectIdWithBodyWithResponse request with any body
+	DeleteAdminProjectsProjectIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAdminProjectsProjectIdResponse, error)
+
+	DeleteAdminProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, body DeleteAdminProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAdminProjectsProjectIdResponse, error)
+
+	// GetAdminProjectsProjectIdWithResponse request
+	GetAdminProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminProjectsProjectIdResponse, error)
+
+	// PutAdminProjectsProjectIdWithBodyWithResponse request with any body
+	PutAdminProjectsProjectIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminProjectsProjectIdResponse, error)
+
+	PutAdminProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, body PutAdminProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminProjectsProjectIdResponse, error)
+
+	// GetAdminProjectsProjectIdOrganizationsWithResponse request
+	GetAdminProjectsProjectIdOrganizationsWithResponse(ctx context.Context, projectId openapi_types.UUID, params *GetAdminProjectsProjectIdOrganizationsParams, reqEditors ...RequestEditorFn) (*GetAdminProjectsProjectIdOrganizationsResponse, error)
+
+	// GetAdminProjectsProjectIdTiersWithResponse request
+	GetAdminProjectsProjectIdTiersWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminProjectsProjectIdTiersResponse, error)
+
+	// DeleteAdminProjectsProjectIdTiersTierWithResponse request
+	DeleteAdminProjectsProjectIdTiersTierWithResponse(ctx context.Context, projectId openapi_types.UUID, tier string, reqEditors ...RequestEditorFn) (*DeleteAdminProjectsProjectIdTiersTierResponse, error)
+
+	// PutAdminProjectsProjectIdTiersTierWithBodyWithResponse request with any body
+	PutAdminProjectsProjectIdTiersTierWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, tier string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminProjectsProjectIdTiersTierResponse, error)
+
+	PutAdminProjectsProjectIdTiersTierWithResponse(ctx context.Context, projectId openapi_types.UUID, tier string, body PutAdminProjectsProjectIdTiersTierJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminProjectsProjectIdTiersTierResponse, error)
+
+	// DeleteAdminSettingsProjectsProjectIdWithResponse request
+	DeleteAdminSettingsProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSettingsProjectsProjectIdResponse, error)
+
+	// GetAdminSettingsProjectsProjectIdWithResponse request
+	GetAdminSettingsProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSettingsProjectsProjectIdResponse, error)
+
+	// PutAdminSettingsProjectsProjectIdWithBodyWithResponse request with any body
+	PutAdminSettingsProjectsProjectIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSettingsProjectsProjectIdResponse, error)
+
+	PutAdminSettingsProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, body PutAdminSettingsProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSettingsProjectsProjectIdResponse, error)
+
+	// DeleteAdminSmtpOrganizationsOrganizationIdWithResponse request
+	DeleteAdminSmtpOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSmtpOrganizationsOrganizationIdResponse, error)
+
+	// GetAdminSmtpOrganizationsOrganizationIdWithResponse request
+	GetAdminSmtpOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSmtpOrganizationsOrganizationIdResponse, error)
+
+	// PutAdminSmtpOrganizationsOrganizationIdWithBodyWithResponse request with any body
+	PutAdminSmtpOrganizationsOrganizationIdWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSmtpOrganizationsOrganizationIdResponse, error)
+
+	PutAdminSmtpOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PutAdminSmtpOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSmtpOrganizationsOrganizationIdResponse, error)
+
+	// DeleteAdminSmtpProjectsProjectIdWithResponse request
+	DeleteAdminSmtpProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSmtpProjectsProjectIdResponse, error)
+
+	// GetAdminSmtpProjectsProjectIdWithResponse request
+	GetAdminSmtpProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSmtpProjectsProjectIdResponse, error)
+
+	// PutAdminSmtpProjectsProjectIdWithBodyWithResponse request with any body
+	PutAdminSmtpProjectsProjectIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSmtpProjectsProjectIdResponse, error)
+
+	PutAdminSmtpProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, body PutAdminSmtpProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSmtpProjectsProjectIdResponse, error)
+
+	// GetAdminSsoProvidersWithResponse request
+	GetAdminSsoProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminSsoProvidersResponse, error)
+
+	// PostAdminSsoProvidersWithBodyWithResponse request with any body
+	PostAdminSsoProvidersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminSsoProvidersResponse, error)
+
+	PostAdminSsoProvidersWithResponse(ctx context.Context, body PostAdminSsoProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminSsoProvidersResponse, error)
+
+	// DeleteAdminSsoProvidersSsoProviderIdWithResponse request
+	DeleteAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSsoProvidersSsoProviderIdResponse, error)
+
+	// GetAdminSsoProvidersSsoProviderIdWithResponse request
+	GetAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSsoProvidersSsoProviderIdResponse, error)
+
+	// PutAdminSsoProvidersSsoProviderIdWithBodyWithResponse request with any body
+	PutAdminSsoProvidersSsoProviderIdWithBodyWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSsoProvidersSsoProviderIdResponse, error)
+
+	PutAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSsoProvidersSsoProviderIdResponse, error)
+
+	// GetAdminUsersWithResponse request
+	GetAdminUsersWithResponse(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*GetAdminUsersResponse, error)
+
+	// DeleteAdminUsersUserIdWithResponse request
+	DeleteAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdResponse, error)
+
+	// GetAdminUsersUserIdWithResponse request
+	GetAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdResponse, error)
+
+	// PutAdminUsersUserIdWithBodyWithResponse request with any body
+	PutAdminUsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdResponse, error)
+
+	PutAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdResponse, error)
+
+	// GetAdminUsersUserIdFactorsWithResponse request
+	GetAdminUsersUserIdFactorsWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdFactorsResponse, error)
+
+	// DeleteAdminUsersUserIdFactorsFactorIdWithResponse request
+	DeleteAdminUsersUserIdFactorsFactorIdWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdFactorsFactorIdResponse, error)
+
+	// PutAdminUsersUserIdFactorsFactorIdWithBodyWithResponse request with any body
+	PutAdminUsersUserIdFactorsFactorIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdFactorsFactorIdResponse, error)
+
+	PutAdminUsersUserIdFactorsFactorIdWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdFactorsFactorIdResponse, error)
+
+	// DeleteAdminUsersUserIdRecoveryCodesWithResponse request
+	DeleteAdminUsersUserIdRecoveryCodesWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdRecoveryCodesResponse, error)
+
+	// GetAdminUsersUserIdSessionsWithResponse request
+	GetAdminUsersUserIdSessionsWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdSessionsResponse, error)
+
+	// DeleteAdminUsersUserIdSessionsSessionIdWithResponse request
+	DeleteAdminUsersUserIdSessionsSessionIdWithResponse(ctx context.Context, userId openapi_types.UUID, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdSessionsSessionIdResponse, error)
+
+	// PostInviteWithBodyWithResponse request with any body
+	PostInviteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInviteResponse, error)
+
+	PostInviteWithResponse(ctx context.Context, body PostInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInviteResponse, error)
+}
+
+type GetAdminApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		ApiKeys *[]APIKeySchema `json:"api_keys,omitempty"`
+	}
+	JSON400 *BadRequestResponse
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *APIKeyWithSecretSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminApiKeysKeyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminApiKeysKeyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminApiKeysKeyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminApiKeysKeyIdRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIKeyWithSecretSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminApiKeysKeyIdRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminApiKeysKeyIdRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminAuditResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]struct {
+		CreatedAt *time.Time          `json:"created_at,omitempty"`
+		Id        *openapi_types.UUID `json:"id,omitempty"`
+		IpAddress *string             `json:"ip_address,omitempty"`
+		Payload   *struct {
+			// Action Usually one of these values:
+			// - login
+			// - logout
+			// - invite_accepted
+			// - user_signedup
+			// - user_invited
+			// - user_deleted
+			// - user_modified
+			// - user_recovery_requested
+			// - user_reauthenticate_requested
+			// - user_confirmation_requested
+			// - user_repeated_signup
+			// - user_updated_password
+			// - token_revoked
+			// - token_refreshed
+			// - generate_recovery_codes
+			// - factor_in_progress
+			// - factor_unenrolled
+			// - challenge_created
+			// - verification_attempted
+			// - factor_deleted
+			// - recovery_codes_deleted
+			// - factor_updated
+			// - mfa_code_login
+			Action        *string `json:"action,omitempty"`
+			ActorId       *string `json:"actor_id,omitempty"`
+			ActorName     *string `json:"actor_name,omitempty"`
+			ActorUsername *string `json:"actor_username,omitempty"`
+
+			// ActorViaSso Whether the actor used a SSO protocol (like SAML 2.0 or OIDC) to authenticate.
+			ActorViaSso *bool `json:"actor_via_sso,omitempty"`
+
+			// LogType Usually one of these values:
+			// - account
+			// - team
+			// - token
+			// - user
+			// - factor
+			// - recovery_codes
+			LogType *string                 `json:"log_type,omitempty"`
+			Traits  *map[string]interface{} `json:"traits,omitempty"`
+		} `json:"payload,omitempty"`
+	}
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminGenerateLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		ActionLink           *string                `json:"action_link,omitempty"`
+		EmailOtp             *string                `json:"email_otp,omitempty"`
+		HashedToken          *string                `json:"hashed_token,omitempty"`
+		RedirectTo           *string                `json:"redirect_to,omitempty"`
+		VerificationType     *string                `json:"verification_type,omitempty"`
+		AdditionalProperties map[string]interface{} `json:"-"`
+	}
+	JSON400 *BadRequestResponse
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+	JSON404 *ErrorSchema
+	JSON422 *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminGenerateLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminGenerateLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminInvitationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Invitations *[]OrganizationInvitationSchema `json:"invitations,omitempty"`
+	}
+	JSON400 *BadRequestResponse
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+	JSON404 *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminInvitationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminInvitationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminInvitationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OrganizationInvitationSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminInvitationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminInvitationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminInvitationsInvitationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminInvitationsInvitationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminInvitationsInvitationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminInvitationsInvitationIdResendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationInvitationSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminInvitationsInvitationIdResendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminInvitationsInvitationIdResendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminMfaOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminMfaOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminMfaOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminMfaOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationMFAPolicySchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminMfaOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminMfaOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminMfaOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationMFAPolicySchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminMfaOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminMfaOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminOauthClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Clients *[]OAuthClientSchema `json:"clients,omitempty"`
+	}
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminOauthClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminOauthClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminOauthClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OAuthClientSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminOauthClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminOauthClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminOauthClientsClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminOauthClientsClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminOauthClientsClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminOauthClientsClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthClientSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminOauthClientsClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminOauthClientsClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminOauthClientsClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthClientSchema
+	JSON400      *ErrorSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminOauthClientsClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminOauthClientsClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminOauthClientsClientIdRegenerateSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthClientSchema
+	JSON400      *ErrorSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminOauthClientsClientIdRegenerateSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminOauthClientsClientIdRegenerateSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminOidcProjectsProjectIdProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Providers *[]CustomOIDCProviderSchema `json:"providers,omitempty"`
+	}
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+	JSON404 *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminOidcProjectsProjectIdProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminOidcProjectsProjectIdProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminOidcProjectsProjectIdProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CustomOIDCProviderSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+	JSON422      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminOidcProjectsProjectIdProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminOidcProjectsProjectIdProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminOidcProjectsProjectIdProvidersProviderIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomOIDCProviderSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminOidcProjectsProjectIdProvidersProviderIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminOidcProjectsProjectIdProvidersProviderIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminOidcProjectsProjectIdProvidersProviderIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomOIDCProviderSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+	JSON422      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminOidcProjectsProjectIdProvidersProviderIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminOidcProjectsProjectIdProvidersProviderIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminOrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Organizations *[]OrganizationSchema `json:"organizations,omitempty"`
+	}
+	JSON400 *BadRequestResponse
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminOrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminOrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminOrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OrganizationSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminOrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminOrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminOrganizationsOrganizationIdMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Members *[]OrganizationMemberSchema `json:"members,omitempty"`
+	}
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+	JSON404 *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminOrganizationsOrganizationIdMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminOrganizationsOrganizationIdMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminOrganizationsOrganizationIdMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationMemberSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminOrganizationsOrganizationIdMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminOrganizationsOrganizationIdMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminOrganizationsOrganizationIdMembersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminOrganizationsOrganizationIdMembersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminOrganizationsOrganizationIdMembersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminOrganizationsOrganizationIdTierResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationTierSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminOrganizationsOrganizationIdTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminOrganizationsOrganizationIdTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminOrganizationsOrganizationIdTierResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationTierSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminOrganizationsOrganizationIdTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminOrganizationsOrganizationIdTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Projects *[]ProjectSchema `json:"projects,omitempty"`
+	}
+	JSON400 *BadRequestResponse
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProjectSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminProjectsProjectIdOrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Organizations *[]OrganizationSchema `json:"organizations,omitempty"`
+	}
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+	JSON404 *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminProjectsProjectIdOrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminProjectsProjectIdOrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminProjectsProjectIdTiersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Tiers *[]ProjectTierSchema `json:"tiers,omitempty"`
+	}
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+	JSON404 *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminProjectsProjectIdTiersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminProjectsProjectIdTiersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminProjectsProjectIdTiersTierResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminProjectsProjectIdTiersTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminProjectsProjectIdTiersTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminProjectsProjectIdTiersTierResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectTierSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminProjectsProjectIdTiersTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminProjectsProjectIdTiersTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminSettingsProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminSettingsProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminSettingsProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminSettingsProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectSettingsSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminSettingsProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminSettingsProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminSettingsProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectSettingsSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminSettingsProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminSettingsProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminSmtpOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminSmtpOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminSmtpOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminSmtpOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationSMTPConfigSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminSmtpOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminSmtpOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminSmtpOrganizationsOrganizationIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationSMTPConfigSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminSmtpOrganizationsOrganizationIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminSmtpOrganizationsOrganizationIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminSmtpProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminSmtpProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminSmtpProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminSmtpProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectSMTPConfigSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminSmtpProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminSmtpProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminSmtpProjectsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectSMTPConfigSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminSmtpProjectsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminSmtpProjectsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminSsoProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]SSOProviderSchema `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminSsoProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminSsoProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminSsoProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SSOProviderSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminSsoProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminSsoProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminSsoProvidersSsoProviderIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SSOProviderSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminSsoProvidersSsoProviderIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminSsoProvidersSsoProviderIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminSsoProvidersSsoProviderIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SSOProviderSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminSsoProvidersSsoProviderIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminSsoProvidersSsoProviderIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminSsoProvidersSsoProviderIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SSOProviderSchema
+	JSON400      *BadRequestResponse
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminSsoProvidersSsoProviderIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminSsoProvidersSsoProviderIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
+		Aud   *string       `json:"aud,omitempty"`
+		Users *[]UserSchema `json:"users,omitempty"`
+	}
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminUsersUserIdFactorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MFAFactorSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminUsersUserIdFactorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminUsersUserIdFactorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminUsersUserIdFactorsFactorIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MFAFactorSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminUsersUserIdFactorsFactorIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminUsersUserIdFactorsFactorIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminUsersUserIdFactorsFactorIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MFAFactorSchema
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminUsersUserIdFactorsFactorIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminUsersUserIdFactorsFactorIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminUsersUserIdRecoveryCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminUsersUserIdRecoveryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminUsersUserIdRecoveryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminUsersUserIdSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Sessions *[]SessionSchema `json:"sessions,omitempty"`
+	}
+	JSON401 *UnauthorizedResponse
+	JSON403 *ForbiddenResponse
+	JSON404 *NotFoundResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminUsersUserIdSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminUsersUserIdSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminUsersUserIdSessionsSessionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *UnauthorizedResponse
+	JSON403      *ForbiddenResponse
+	JSON404      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminUsersUserIdSessionsSessionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminUsersUserIdSessionsSessionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostInviteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSchema
+	JSON400      *BadRequestResponse
+	JSON422      *ErrorSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r PostInviteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostInviteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAdminApiKeysWithResponse request returning *GetAdminApiKeysResponse
+func (c *ClientWithResponses) GetAdminApiKeysWithResponse(ctx context.Context, params *GetAdminApiKeysParams, reqEditors ...RequestEditorFn) (*GetAdminApiKeysResponse, error) {
+	rsp, err := c.GetAdminApiKeys(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminApiKeysResponse(rsp)
+}
+
+// PostAdminApiKeysWithBodyWithResponse request with arbitrary body returning *PostAdminApiKeysResponse
+func (c *ClientWithResponses) PostAdminApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminApiKeysResponse, error) {
+	rsp, err := c.PostAdminApiKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminApiKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminApiKeysWithResponse(ctx context.Context, body PostAdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminApiKeysResponse, error) {
+	rsp, err := c.PostAdminApiKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminApiKeysResponse(rsp)
+}
+
+// DeleteAdminApiKeysKeyIdWithResponse request returning *DeleteAdminApiKeysKeyIdResponse
+func (c *ClientWithResponses) DeleteAdminApiKeysKeyIdWithResponse(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminApiKeysKeyIdResponse, error) {
+	rsp, err := c.DeleteAdminApiKeysKeyId(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminApiKeysKeyIdResponse(rsp)
+}
+
+// PostAdminApiKeysKeyIdRotateWithResponse request returning *PostAdminApiKeysKeyIdRotateResponse
+func (c *ClientWithResponses) PostAdminApiKeysKeyIdRotateWithResponse(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminApiKeysKeyIdRotateResponse, error) {
+	rsp, err := c.PostAdminApiKeysKeyIdRotate(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminApiKeysKeyIdRotateResponse(rsp)
+}
+
+// GetAdminAuditWithResponse request returning *GetAdminAuditResponse
+func (c *ClientWithResponses) GetAdminAuditWithResponse(ctx context.Context, params *GetAdminAuditParams, reqEditors ...RequestEditorFn) (*GetAdminAuditResponse, error) {
+	rsp, err := c.GetAdminAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminAuditResponse(rsp)
+}
+
+// PostAdminGenerateLinkWithBodyWithResponse request with arbitrary body returning *PostAdminGenerateLinkResponse
+func (c *ClientWithResponses) PostAdminGenerateLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminGenerateLinkResponse, error) {
+	rsp, err := c.PostAdminGenerateLinkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminGenerateLinkResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminGenerateLinkWithResponse(ctx context.Context, body PostAdminGenerateLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminGenerateLinkResponse, error) {
+	rsp, err := c.PostAdminGenerateLink(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminGenerateLinkResponse(rsp)
+}
+
+// GetAdminInvitationsWithResponse request returning *GetAdminInvitationsResponse
+func (c *ClientWithResponses) GetAdminInvitationsWithResponse(ctx context.Context, params *GetAdminInvitationsParams, reqEditors ...RequestEditorFn) (*GetAdminInvitationsResponse, error) {
+	rsp, err := c.GetAdminInvitations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminInvitationsResponse(rsp)
+}
+
+// PostAdminInvitationsWithBodyWithResponse request with arbitrary body returning *PostAdminInvitationsResponse
+func (c *ClientWithResponses) PostAdminInvitationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminInvitationsResponse, error) {
+	rsp, err := c.PostAdminInvitationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminInvitationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminInvitationsWithResponse(ctx context.Context, body PostAdminInvitationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminInvitationsResponse, error) {
+	rsp, err := c.PostAdminInvitations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminInvitationsResponse(rsp)
+}
+
+// DeleteAdminInvitationsInvitationIdWithResponse request returning *DeleteAdminInvitationsInvitationIdResponse
+func (c *ClientWithResponses) DeleteAdminInvitationsInvitationIdWithResponse(ctx context.Context, invitationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminInvitationsInvitationIdResponse, error) {
+	rsp, err := c.DeleteAdminInvitationsInvitationId(ctx, invitationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminInvitationsInvitationIdResponse(rsp)
+}
+
+// PostAdminInvitationsInvitationIdResendWithResponse request returning *PostAdminInvitationsInvitationIdResendResponse
+func (c *ClientWithResponses) PostAdminInvitationsInvitationIdResendWithResponse(ctx context.Context, invitationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminInvitationsInvitationIdResendResponse, error) {
+	rsp, err := c.PostAdminInvitationsInvitationIdResend(ctx, invitationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminInvitationsInvitationIdResendResponse(rsp)
+}
+
+// DeleteAdminMfaOrganizationsOrganizationIdWithResponse request returning *DeleteAdminMfaOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) DeleteAdminMfaOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminMfaOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.DeleteAdminMfaOrganizationsOrganizationId(ctx, organizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminMfaOrganizationsOrganizationIdResponse(rsp)
+}
+
+// GetAdminMfaOrganizationsOrganizationIdWithResponse request returning *GetAdminMfaOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) GetAdminMfaOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminMfaOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.GetAdminMfaOrganizationsOrganizationId(ctx, organizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminMfaOrganizationsOrganizationIdResponse(rsp)
+}
+
+// PutAdminMfaOrganizationsOrganizationIdWithBodyWithResponse request with arbitrary body returning *PutAdminMfaOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) PutAdminMfaOrganizationsOrganizationIdWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminMfaOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.PutAdminMfaOrganizationsOrganizationIdWithBody(ctx, organizationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminMfaOrganizationsOrganizationIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminMfaOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PutAdminMfaOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminMfaOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.PutAdminMfaOrganizationsOrganizationId(ctx, organizationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminMfaOrganizationsOrganizationIdResponse(rsp)
+}
+
+// GetAdminOauthClientsWithResponse request returning *GetAdminOauthClientsResponse
+func (c *ClientWithResponses) GetAdminOauthClientsWithResponse(ctx context.Context, params *GetAdminOauthClientsParams, reqEditors ...RequestEditorFn) (*GetAdminOauthClientsResponse, error) {
+	rsp, err := c.GetAdminOauthClients(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminOauthClientsResponse(rsp)
+}
+
+// PostAdminOauthClientsWithBodyWithResponse request with arbitrary body returning *PostAdminOauthClientsResponse
+func (c *ClientWithResponses) PostAdminOauthClientsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminOauthClientsResponse, error) {
+	rsp, err := c.PostAdminOauthClientsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOauthClientsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminOauthClientsWithResponse(ctx context.Context, body PostAdminOauthClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminOauthClientsResponse, error) {
+	rsp, err := c.PostAdminOauthClients(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOauthClientsResponse(rsp)
+}
+
+// DeleteAdminOauthClientsClientIdWithResponse request returning *DeleteAdminOauthClientsClientIdResponse
+func (c *ClientWithResponses) DeleteAdminOauthClientsClientIdWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*DeleteAdminOauthClientsClientIdResponse, error) {
+	rsp, err := c.DeleteAdminOauthClientsClientId(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminOauthClientsClientIdResponse(rsp)
+}
+
+// GetAdminOauthClientsClientIdWithResponse request returning *GetAdminOauthClientsClientIdResponse
+func (c *ClientWithResponses) GetAdminOauthClientsClientIdWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*GetAdminOauthClientsClientIdResponse, error) {
+	rsp, err := c.GetAdminOauthClientsClientId(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminOauthClientsClientIdResponse(rsp)
+}
+
+// PutAdminOauthClientsClientIdWithBodyWithResponse request with arbitrary body returning *PutAdminOauthClientsClientIdResponse
+func (c *ClientWithResponses) PutAdminOauthClientsClientIdWithBodyWithResponse(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminOauthClientsClientIdResponse, error) {
+	rsp, err := c.PutAdminOauthClientsClientIdWithBody(ctx, clientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminOauthClientsClientIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminOauthClientsClientIdWithResponse(ctx context.Context, clientId string, body PutAdminOauthClientsClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminOauthClientsClientIdResponse, error) {
+	rsp, err := c.PutAdminOauthClientsClientId(ctx, clientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminOauthClientsClientIdResponse(rsp)
+}
+
+// PostAdminOauthClientsClientIdRegenerateSecretWithResponse request returning *PostAdminOauthClientsClientIdRegenerateSecretResponse
+func (c *ClientWithResponses) PostAdminOauthClientsClientIdRegenerateSecretWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*PostAdminOauthClientsClientIdRegenerateSecretResponse, error) {
+	rsp, err := c.PostAdminOauthClientsClientIdRegenerateSecret(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOauthClientsClientIdRegenerateSecretResponse(rsp)
+}
+
+// GetAdminOidcProjectsProjectIdProvidersWithResponse request returning *GetAdminOidcProjectsProjectIdProvidersResponse
+func (c *ClientWithResponses) GetAdminOidcProjectsProjectIdProvidersWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminOidcProjectsProjectIdProvidersResponse, error) {
+	rsp, err := c.GetAdminOidcProjectsProjectIdProviders(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminOidcProjectsProjectIdProvidersResponse(rsp)
+}
+
+// PostAdminOidcProjectsProjectIdProvidersWithBodyWithResponse request with arbitrary body returning *PostAdminOidcProjectsProjectIdProvidersResponse
+func (c *ClientWithResponses) PostAdminOidcProjectsProjectIdProvidersWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminOidcProjectsProjectIdProvidersResponse, error) {
+	rsp, err := c.PostAdminOidcProjectsProjectIdProvidersWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOidcProjectsProjectIdProvidersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminOidcProjectsProjectIdProvidersWithResponse(ctx context.Context, projectId openapi_types.UUID, body PostAdminOidcProjectsProjectIdProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminOidcProjectsProjectIdProvidersResponse, error) {
+	rsp, err := c.PostAdminOidcProjectsProjectIdProviders(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOidcProjectsProjectIdProvidersResponse(rsp)
+}
+
+// DeleteAdminOidcProjectsProjectIdProvidersProviderIdWithResponse request returning *DeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse
+func (c *ClientWithResponses) DeleteAdminOidcProjectsProjectIdProvidersProviderIdWithResponse(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse, error) {
+	rsp, err := c.DeleteAdminOidcProjectsProjectIdProvidersProviderId(ctx, projectId, providerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse(rsp)
+}
+
+// GetAdminOidcProjectsProjectIdProvidersProviderIdWithResponse request returning *GetAdminOidcProjectsProjectIdProvidersProviderIdResponse
+func (c *ClientWithResponses) GetAdminOidcProjectsProjectIdProvidersProviderIdWithResponse(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminOidcProjectsProjectIdProvidersProviderIdResponse, error) {
+	rsp, err := c.GetAdminOidcProjectsProjectIdProvidersProviderId(ctx, projectId, providerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminOidcProjectsProjectIdProvidersProviderIdResponse(rsp)
+}
+
+// PutAdminOidcProjectsProjectIdProvidersProviderIdWithBodyWithResponse request with arbitrary body returning *PutAdminOidcProjectsProjectIdProvidersProviderIdResponse
+func (c *ClientWithResponses) PutAdminOidcProjectsProjectIdProvidersProviderIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminOidcProjectsProjectIdProvidersProviderIdResponse, error) {
+	rsp, err := c.PutAdminOidcProjectsProjectIdProvidersProviderIdWithBody(ctx, projectId, providerId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminOidcProjectsProjectIdProvidersProviderIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminOidcProjectsProjectIdProvidersProviderIdWithResponse(ctx context.Context, projectId openapi_types.UUID, providerId openapi_types.UUID, body PutAdminOidcProjectsProjectIdProvidersProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminOidcProjectsProjectIdProvidersProviderIdResponse, error) {
+	rsp, err := c.PutAdminOidcProjectsProjectIdProvidersProviderId(ctx, projectId, providerId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminOidcProjectsProjectIdProvidersProviderIdResponse(rsp)
+}
+
+// GetAdminOrganizationsWithResponse request returning *GetAdminOrganizationsResponse
+func (c *ClientWithResponses) GetAdminOrganizationsWithResponse(ctx context.Context, params *GetAdminOrganizationsParams, reqEditors ...RequestEditorFn) (*GetAdminOrganizationsResponse, error) {
+	rsp, err := c.GetAdminOrganizations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminOrganizationsResponse(rsp)
+}
+
+// PostAdminOrganizationsWithBodyWithResponse request with arbitrary body returning *PostAdminOrganizationsResponse
+func (c *ClientWithResponses) PostAdminOrganizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminOrganizationsResponse, error) {
+	rsp, err := c.PostAdminOrganizationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOrganizationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminOrganizationsWithResponse(ctx context.Context, body PostAdminOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminOrganizationsResponse, error) {
+	rsp, err := c.PostAdminOrganizations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOrganizationsResponse(rsp)
+}
+
+// DeleteAdminOrganizationsOrganizationIdWithResponse request returning *DeleteAdminOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) DeleteAdminOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.DeleteAdminOrganizationsOrganizationId(ctx, organizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminOrganizationsOrganizationIdResponse(rsp)
+}
+
+// GetAdminOrganizationsOrganizationIdWithResponse request returning *GetAdminOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) GetAdminOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.GetAdminOrganizationsOrganizationId(ctx, organizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminOrganizationsOrganizationIdResponse(rsp)
+}
+
+// PutAdminOrganizationsOrganizationIdWithBodyWithResponse request with arbitrary body returning *PutAdminOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) PutAdminOrganizationsOrganizationIdWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.PutAdminOrganizationsOrganizationIdWithBody(ctx, organizationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminOrganizationsOrganizationIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.PutAdminOrganizationsOrganizationId(ctx, organizationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminOrganizationsOrganizationIdResponse(rsp)
+}
+
+// GetAdminOrganizationsOrganizationIdMembersWithResponse request returning *GetAdminOrganizationsOrganizationIdMembersResponse
+func (c *ClientWithResponses) GetAdminOrganizationsOrganizationIdMembersWithResponse(ctx context.Context, organizationId openapi_types.UUID, params *GetAdminOrganizationsOrganizationIdMembersParams, reqEditors ...RequestEditorFn) (*GetAdminOrganizationsOrganizationIdMembersResponse, error) {
+	rsp, err := c.GetAdminOrganizationsOrganizationIdMembers(ctx, organizationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminOrganizationsOrganizationIdMembersResponse(rsp)
+}
+
+// PostAdminOrganizationsOrganizationIdMembersWithBodyWithResponse request with arbitrary body returning *PostAdminOrganizationsOrganizationIdMembersResponse
+func (c *ClientWithResponses) PostAdminOrganizationsOrganizationIdMembersWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminOrganizationsOrganizationIdMembersResponse, error) {
+	rsp, err := c.PostAdminOrganizationsOrganizationIdMembersWithBody(ctx, organizationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOrganizationsOrganizationIdMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminOrganizationsOrganizationIdMembersWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PostAdminOrganizationsOrganizationIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminOrganizationsOrganizationIdMembersResponse, error) {
+	rsp, err := c.PostAdminOrganizationsOrganizationIdMembers(ctx, organizationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminOrganizationsOrganizationIdMembersResponse(rsp)
+}
+
+// DeleteAdminOrganizationsOrganizationIdMembersUserIdWithResponse request returning *DeleteAdminOrganizationsOrganizationIdMembersUserIdResponse
+func (c *ClientWithResponses) DeleteAdminOrganizationsOrganizationIdMembersUserIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminOrganizationsOrganizationIdMembersUserIdResponse, error) {
+	rsp, err := c.DeleteAdminOrganizationsOrganizationIdMembersUserId(ctx, organizationId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminOrganizationsOrganizationIdMembersUserIdResponse(rsp)
+}
+
+// GetAdminOrganizationsOrganizationIdTierWithResponse request returning *GetAdminOrganizationsOrganizationIdTierResponse
+func (c *ClientWithResponses) GetAdminOrganizationsOrganizationIdTierWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminOrganizationsOrganizationIdTierResponse, error) {
+	rsp, err := c.GetAdminOrganizationsOrganizationIdTier(ctx, organizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminOrganizationsOrganizationIdTierResponse(rsp)
+}
+
+// PutAdminOrganizationsOrganizationIdTierWithBodyWithResponse request with arbitrary body returning *PutAdminOrganizationsOrganizationIdTierResponse
+func (c *ClientWithResponses) PutAdminOrganizationsOrganizationIdTierWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminOrganizationsOrganizationIdTierResponse, error) {
+	rsp, err := c.PutAdminOrganizationsOrganizationIdTierWithBody(ctx, organizationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminOrganizationsOrganizationIdTierResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminOrganizationsOrganizationIdTierWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PutAdminOrganizationsOrganizationIdTierJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminOrganizationsOrganizationIdTierResponse, error) {
+	rsp, err := c.PutAdminOrganizationsOrganizationIdTier(ctx, organizationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminOrganizationsOrganizationIdTierResponse(rsp)
+}
+
+// GetAdminProjectsWithResponse request returning *GetAdminProjectsResponse
+func (c *ClientWithResponses) GetAdminProjectsWithResponse(ctx context.Context, params *GetAdminProjectsParams, reqEditors ...RequestEditorFn) (*GetAdminProjectsResponse, error) {
+	rsp, err := c.GetAdminProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminProjectsResponse(rsp)
+}
+
+// PostAdminProjectsWithBodyWithResponse request with arbitrary body returning *PostAdminProjectsResponse
+func (c *ClientWithResponses) PostAdminProjectsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminProjectsResponse, error) {
+	rsp, err := c.PostAdminProjectsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminProjectsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminProjectsWithResponse(ctx context.Context, body PostAdminProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminProjectsResponse, error) {
+	rsp, err := c.PostAdminProjects(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminProjectsResponse(rsp)
+}
+
+// DeleteAdminProjectsProjectIdWithBodyWithResponse request with arbitrary body returning *DeleteAdminProjectsProjectIdResponse
+func (c *ClientWithResponses) DeleteAdminProjectsProjectIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAdminProjectsProjectIdResponse, error) {
+	rsp, err := c.DeleteAdminProjectsProjectIdWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminProjectsProjectIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteAdminProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, body DeleteAdminProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAdminProjectsProjectIdResponse, error) {
+	rsp, err := c.DeleteAdminProjectsProjectId(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminProjectsProjectIdResponse(rsp)
+}
+
+// GetAdminProjectsProjectIdWithResponse request returning *GetAdminProjectsProjectIdResponse
+func (c *ClientWithResponses) GetAdminProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminProjectsProjectIdResponse, error) {
+	rsp, err := c.GetAdminProjectsProjectId(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminProjectsProjectIdResponse(rsp)
+}
+
+// PutAdminProjectsProjectIdWithBodyWithResponse request with arbitrary body returning *PutAdminProjectsProjectIdResponse
+func (c *ClientWithResponses) PutAdminProjectsProjectIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminProjectsProjectIdResponse, error) {
+	rsp, err := c.PutAdminProjectsProjectIdWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminProjectsProjectIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, body PutAdminProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminProjectsProjectIdResponse, error) {
+	rsp, err := c.PutAdminProjectsProjectId(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminProjectsProjectIdResponse(rsp)
+}
+
+// GetAdminProjectsProjectIdOrganizationsWithResponse request returning *GetAdminProjectsProjectIdOrganizationsResponse
+func (c *ClientWithResponses) GetAdminProjectsProjectIdOrganizationsWithResponse(ctx context.Context, projectId openapi_types.UUID, params *GetAdminProjectsProjectIdOrganizationsParams, reqEditors ...RequestEditorFn) (*GetAdminProjectsProjectIdOrganizationsResponse, error) {
+	rsp, err := c.GetAdminProjectsProjectIdOrganizations(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminProjectsProjectIdOrganizationsResponse(rsp)
+}
+
+// GetAdminProjectsProjectIdTiersWithResponse request returning *GetAdminProjectsProjectIdTiersResponse
+func (c *ClientWithResponses) GetAdminProjectsProjectIdTiersWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminProjectsProjectIdTiersResponse, error) {
+	rsp, err := c.GetAdminProjectsProjectIdTiers(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminProjectsProjectIdTiersResponse(rsp)
+}
+
+// DeleteAdminProjectsProjectIdTiersTierWithResponse request returning *DeleteAdminProjectsProjectIdTiersTierResponse
+func (c *ClientWithResponses) DeleteAdminProjectsProjectIdTiersTierWithResponse(ctx context.Context, projectId openapi_types.UUID, tier string, reqEditors ...RequestEditorFn) (*DeleteAdminProjectsProjectIdTiersTierResponse, error) {
+	rsp, err := c.DeleteAdminProjectsProjectIdTiersTier(ctx, projectId, tier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminProjectsProjectIdTiersTierResponse(rsp)
+}
+
+// PutAdminProjectsProjectIdTiersTierWithBodyWithResponse request with arbitrary body returning *PutAdminProjectsProjectIdTiersTierResponse
+func (c *ClientWithResponses) PutAdminProjectsProjectIdTiersTierWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, tier string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminProjectsProjectIdTiersTierResponse, error) {
+	rsp, err := c.PutAdminProjectsProjectIdTiersTierWithBody(ctx, projectId, tier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminProjectsProjectIdTiersTierResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminProjectsProjectIdTiersTierWithResponse(ctx context.Context, projectId openapi_types.UUID, tier string, body PutAdminProjectsProjectIdTiersTierJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminProjectsProjectIdTiersTierResponse, error) {
+	rsp, err := c.PutAdminProjectsProjectIdTiersTier(ctx, projectId, tier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminProjectsProjectIdTiersTierResponse(rsp)
+}
+
+// DeleteAdminSettingsProjectsProjectIdWithResponse request returning *DeleteAdminSettingsProjectsProjectIdResponse
+func (c *ClientWithResponses) DeleteAdminSettingsProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSettingsProjectsProjectIdResponse, error) {
+	rsp, err := c.DeleteAdminSettingsProjectsProjectId(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminSettingsProjectsProjectIdResponse(rsp)
+}
+
+// GetAdminSettingsProjectsProjectIdWithResponse request returning *GetAdminSettingsProjectsProjectIdResponse
+func (c *ClientWithResponses) GetAdminSettingsProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSettingsProjectsProjectIdResponse, error) {
+	rsp, err := c.GetAdminSettingsProjectsProjectId(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminSettingsProjectsProjectIdResponse(rsp)
+}
+
+// PutAdminSettingsProjectsProjectIdWithBodyWithResponse request with arbitrary body returning *PutAdminSettingsProjectsProjectIdResponse
+func (c *ClientWithResponses) PutAdminSettingsProjectsProjectIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSettingsProjectsProjectIdResponse, error) {
+	rsp, err := c.PutAdminSettingsProjectsProjectIdWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminSettingsProjectsProjectIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminSettingsProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, body PutAdminSettingsProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSettingsProjectsProjectIdResponse, error) {
+	rsp, err := c.PutAdminSettingsProjectsProjectId(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminSettingsProjectsProjectIdResponse(rsp)
+}
+
+// DeleteAdminSmtpOrganizationsOrganizationIdWithResponse request returning *DeleteAdminSmtpOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) DeleteAdminSmtpOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSmtpOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.DeleteAdminSmtpOrganizationsOrganizationId(ctx, organizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminSmtpOrganizationsOrganizationIdResponse(rsp)
+}
+
+// GetAdminSmtpOrganizationsOrganizationIdWithResponse request returning *GetAdminSmtpOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) GetAdminSmtpOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSmtpOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.GetAdminSmtpOrganizationsOrganizationId(ctx, organizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminSmtpOrganizationsOrganizationIdResponse(rsp)
+}
+
+// PutAdminSmtpOrganizationsOrganizationIdWithBodyWithResponse request with arbitrary body returning *PutAdminSmtpOrganizationsOrganizationIdResponse
+func (c *ClientWithResponses) PutAdminSmtpOrganizationsOrganizationIdWithBodyWithResponse(ctx context.Context, organizationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSmtpOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.PutAdminSmtpOrganizationsOrganizationIdWithBody(ctx, organizationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminSmtpOrganizationsOrganizationIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminSmtpOrganizationsOrganizationIdWithResponse(ctx context.Context, organizationId openapi_types.UUID, body PutAdminSmtpOrganizationsOrganizationIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSmtpOrganizationsOrganizationIdResponse, error) {
+	rsp, err := c.PutAdminSmtpOrganizationsOrganizationId(ctx, organizationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminSmtpOrganizationsOrganizationIdResponse(rsp)
+}
+
+// DeleteAdminSmtpProjectsProjectIdWithResponse request returning *DeleteAdminSmtpProjectsProjectIdResponse
+func (c *ClientWithResponses) DeleteAdminSmtpProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSmtpProjectsProjectIdResponse, error) {
+	rsp, err := c.DeleteAdminSmtpProjectsProjectId(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminSmtpProjectsProjectIdResponse(rsp)
+}
+
+// GetAdminSmtpProjectsProjectIdWithResponse request returning *GetAdminSmtpProjectsProjectIdResponse
+func (c *ClientWithResponses) GetAdminSmtpProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSmtpProjectsProjectIdResponse, error) {
+	rsp, err := c.GetAdminSmtpProjectsProjectId(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminSmtpProjectsProjectIdResponse(rsp)
+}
+
+// PutAdminSmtpProjectsProjectIdWithBodyWithResponse request with arbitrary body returning *PutAdminSmtpProjectsProjectIdResponse
+func (c *ClientWithResponses) PutAdminSmtpProjectsProjectIdWithBodyWithResponse(ctx context.Context, projectId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSmtpProjectsProjectIdResponse, error) {
+	rsp, err := c.PutAdminSmtpProjectsProjectIdWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminSmtpProjectsProjectIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminSmtpProjectsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, body PutAdminSmtpProjectsProjectIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSmtpProjectsProjectIdResponse, error) {
+	rsp, err := c.PutAdminSmtpProjectsProjectId(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminSmtpProjectsProjectIdResponse(rsp)
+}
+
+// GetAdminSsoProvidersWithResponse request returning *GetAdminSsoProvidersResponse
+func (c *ClientWithResponses) GetAdminSsoProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminSsoProvidersResponse, error) {
+	rsp, err := c.GetAdminSsoProviders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminSsoProvidersResponse(rsp)
+}
+
+// PostAdminSsoProvidersWithBodyWithResponse request with arbitrary body returning *PostAdminSsoProvidersResponse
+func (c *ClientWithResponses) PostAdminSsoProvidersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminSsoProvidersResponse, error) {
+	rsp, err := c.PostAdminSsoProvidersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminSsoProvidersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminSsoProvidersWithResponse(ctx context.Context, body PostAdminSsoProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminSsoProvidersResponse, error) {
+	rsp, err := c.PostAdminSsoProviders(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminSsoProvidersResponse(rsp)
+}
+
+// DeleteAdminSsoProvidersSsoProviderIdWithResponse request returning *DeleteAdminSsoProvidersSsoProviderIdResponse
+func (c *ClientWithResponses) DeleteAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSsoProvidersSsoProviderIdResponse, error) {
+	rsp, err := c.DeleteAdminSsoProvidersSsoProviderId(ctx, ssoProviderId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminSsoProvidersSsoProviderIdResponse(rsp)
+}
+
+// GetAdminSsoProvidersSsoProviderIdWithResponse request returning *GetAdminSsoProvidersSsoProviderIdResponse
+func (c *ClientWithResponses) GetAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSsoProvidersSsoProviderIdResponse, error) {
+	rsp, err := c.GetAdminSsoProvidersSsoProviderId(ctx, ssoProviderId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminSsoProvidersSsoProviderIdResponse(rsp)
+}
+
+// PutAdminSsoProvidersSsoProviderIdWithBodyWithResponse request with arbitrary body returning *PutAdminSsoProvidersSsoProviderIdResponse
+func (c *ClientWithResponses) PutAdminSsoProvidersSsoProviderIdWithBodyWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSsoProvidersSsoProviderIdResponse, error) {
+	rsp, err := c.PutAdminSsoProvidersSsoProviderIdWithBody(ctx, ssoProviderId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminSsoProvidersSsoProviderIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSsoProvidersSsoProviderIdResponse, error) {
+	rsp, err := c.PutAdminSsoProvidersSsoProviderId(ctx, ssoProviderId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminSsoProvidersSsoProviderIdResponse(rsp)
+}
+
+// GetAdminUsersWithResponse request returning *GetAdminUsersResponse
+func (c *ClientWithResponses) GetAdminUsersWithResponse(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*GetAdminUsersResponse, error) {
+	rsp, err := c.GetAdminUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminUsersResponse(rsp)
+}
+
+// DeleteAdminUsersUserIdWithResponse request returning *DeleteAdminUsersUserIdResponse
+func (c *ClientWithResponses) DeleteAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdResponse, error) {
+	rsp, err := c.DeleteAdminUsersUserId(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminUsersUserIdResponse(rsp)
+}
+
+// GetAdminUsersUserIdWithResponse request returning *GetAdminUsersUserIdResponse
+func (c *ClientWithResponses) GetAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdResponse, error) {
+	rsp, err := c.GetAdminUsersUserId(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminUsersUserIdResponse(rsp)
+}
+
+// PutAdminUsersUserIdWithBodyWithResponse request with arbitrary body returning *PutAdminUsersUserIdResponse
+func (c *ClientWithResponses) PutAdminUsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdResponse, error) {
+	rsp, err := c.PutAdminUsersUserIdWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminUsersUserIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdResponse, error) {
+	rsp, err := c.PutAdminUsersUserId(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminUsersUserIdResponse(rsp)
+}
+
+// GetAdminUsersUserIdFactorsWithResponse request returning *GetAdminUsersUserIdFactorsResponse
+func (c *ClientWithResponses) GetAdminUsersUserIdFactorsWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdFactorsResponse, error) {
+	rsp, err := c.GetAdminUsersUserIdFactors(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminUsersUserIdFactorsResponse(rsp)
+}
+
+// DeleteAdminUsersUserIdFactorsFactorIdWithResponse request returning *DeleteAdminUsersUserIdFactorsFactorIdResponse
+func (c *ClientWithResponses) DeleteAdminUsersUserIdFactorsFactorIdWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdFactorsFactorIdResponse, error) {
+	rsp, err := c.DeleteAdminUsersUserIdFactorsFactorId(ctx, userId, factorId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminUsersUserIdFactorsFactorIdResponse(rsp)
+}
+
+// PutAdminUsersUserIdFactorsFactorIdWithBodyWithResponse request with arbitrary body returning *PutAdminUsersUserIdFactorsFactorIdResponse
+func (c *ClientWithResponses) PutAdminUsersUserIdFactorsFactorIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdFactorsFactorIdResponse, error) {
+	rsp, err := c.PutAdminUsersUserIdFactorsFactorIdWithBody(ctx, userId, factorId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminUsersUserIdFactorsFactorIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminUsersUserIdFactorsFactorIdWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdFactorsFactorIdResponse, error) {
+	rsp, err := c.PutAdminUsersUserIdFactorsFactorId(ctx, userId, factorId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminUsersUserIdFactorsFactorIdResponse(rsp)
+}
+
+// DeleteAdminUsersUserIdRecoveryCodesWithResponse request returning *DeleteAdminUsersUserIdRecoveryCodesResponse
+func (c *ClientWithResponses) DeleteAdminUsersUserIdRecoveryCodesWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdRecoveryCodesResponse, error) {
+	rsp, err := c.DeleteAdminUsersUserIdRecoveryCodes(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminUsersUserIdRecoveryCodesResponse(rsp)
+}
+
+// GetAdminUsersUserIdSessionsWithResponse request returning *GetAdminUsersUserIdSessionsResponse
+func (c *ClientWithResponses) GetAdminUsersUserIdSessionsWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdSessionsResponse, error) {
+	rsp, err := c.GetAdminUsersUserIdSessions(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminUsersUserIdSessionsResponse(rsp)
+}
+
+// DeleteAdminUsersUserIdSessionsSessionIdWithResponse request returning *DeleteAdminUsersUserIdSessionsSessionIdResponse
+func (c *ClientWithResponses) DeleteAdminUsersUserIdSessionsSessionIdWithResponse(ctx context.Context, userId openapi_types.UUID, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdSessionsSessionIdResponse, error) {
+	rsp, err := c.DeleteAdminUsersUserIdSessionsSessionId(ctx, userId, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminUsersUserIdSessionsSessionIdResponse(rsp)
+}
+
+// PostInviteWithBodyWithResponse request with arbitrary body returning *PostInviteResponse
+func (c *ClientWithResponses) PostInviteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInviteResponse, error) {
+	rsp, err := c.PostInviteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInviteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostInviteWithResponse(ctx context.Context, body PostInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInviteResponse, error) {
+	rsp, err := c.PostInvite(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInviteResponse(rsp)
+}
+
+// ParseGetAdminApiKeysResponse parses an HTTP response from a GetAdminApiKeysWithResponse call
+func ParseGetAdminApiKeysResponse(rsp *http.Response) (*GetAdminApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			ApiKeys *[]APIKeySchema `json:"api_keys,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminApiKeysResponse parses an HTTP response from a PostAdminApiKeysWithResponse call
+func ParsePostAdminApiKeysResponse(rsp *http.Response) (*PostAdminApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest APIKeyWithSecretSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminApiKeysKeyIdResponse parses an HTTP response from a DeleteAdminApiKeysKeyIdWithResponse call
+func ParseDeleteAdminApiKeysKeyIdResponse(rsp *http.Response) (*DeleteAdminApiKeysKeyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminApiKeysKeyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminApiKeysKeyIdRotateResponse parses an HTTP response from a PostAdminApiKeysKeyIdRotateWithResponse call
+func ParsePostAdminApiKeysKeyIdRotateResponse(rsp *http.Response) (*PostAdminApiKeysKeyIdRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminApiKeysKeyIdRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKeyWithSecretSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminAuditResponse parses an HTTP response from a GetAdminAuditWithResponse call
+func ParseGetAdminAuditResponse(rsp *http.Response) (*GetAdminAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []struct {
+			CreatedAt *time.Time          `json:"created_at,omitempty"`
+			Id        *openapi_types.UUID `json:"id,omitempty"`
+			IpAddress *string             `json:"ip_address,omitempty"`
+			Payload   *struct {
+				// Action Usually one of these values:
+				// - login
+				// - logout
+				// - invite_accepted
+				// - user_signedup
+				// - user_invited
+				// - user_deleted
+				// - user_modified
+				// - user_recovery_requested
+				// - user_reauthenticate_requested
+				// - user_confirmation_requested
+				// - user_repeated_signup
+				// - user_updated_password
+				// - token_revoked
+				// - token_refreshed
+				// - generate_recovery_codes
+				// - factor_in_progress
+				// - factor_unenrolled
+				// - challenge_created
+				// - verification_attempted
+				// - factor_deleted
+				// - recovery_codes_deleted
+				// - factor_updated
+				// - mfa_code_login
+				Action        *string `json:"action,omitempty"`
+				ActorId       *string `json:"actor_id,omitempty"`
+				ActorName     *string `json:"actor_name,omitempty"`
+				ActorUsername *string `json:"actor_username,omitempty"`
+
+				// ActorViaSso Whether the actor used a SSO protocol (like SAML 2.0 or OIDC) to authenticate.
+				ActorViaSso *bool `json:"actor_via_sso,omitempty"`
+
+				// LogType Usually one of these values:
+				// - account
+				// - team
+				// - token
+				// - user
+				// - factor
+				// - recovery_codes
+				LogType *string                 `json:"log_type,omitempty"`
+				Traits  *map[string]interface{} `json:"traits,omitempty"`
+			} `json:"payload,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminGenerateLinkResponse parses an HTTP response from a PostAdminGenerateLinkWithResponse call
+func ParsePostAdminGenerateLinkResponse(rsp *http.Response) (*PostAdminGenerateLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminGenerateLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			ActionLink           *string                `json:"action_link,omitempty"`
+			EmailOtp             *string                `json:"email_otp,omitempty"`
+			HashedToken          *string                `json:"hashed_token,omitempty"`
+			RedirectTo           *string                `json:"redirect_to,omitempty"`
+			VerificationType     *string                `json:"verification_type,omitempty"`
+			AdditionalProperties map[string]interface{} `json:"-"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminInvitationsResponse parses an HTTP response from a GetAdminInvitationsWithResponse call
+func ParseGetAdminInvitationsResponse(rsp *http.Response) (*GetAdminInvitationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminInvitationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Invitations *[]OrganizationInvitationSchema `json:"invitations,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminInvitationsResponse parses an HTTP response from a PostAdminInvitationsWithResponse call
+func ParsePostAdminInvitationsResponse(rsp *http.Response) (*PostAdminInvitationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminInvitationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OrganizationInvitationSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminInvitationsInvitationIdResponse parses an HTTP response from a DeleteAdminInvitationsInvitationIdWithResponse call
+func ParseDeleteAdminInvitationsInvitationIdResponse(rsp *http.Response) (*DeleteAdminInvitationsInvitationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminInvitationsInvitationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminInvitationsInvitationIdResendResponse parses an HTTP response from a PostAdminInvitationsInvitationIdResendWithResponse call
+func ParsePostAdminInvitationsInvitationIdResendResponse(rsp *http.Response) (*PostAdminInvitationsInvitationIdResendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminInvitationsInvitationIdResendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationInvitationSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminMfaOrganizationsOrganizationIdResponse parses an HTTP response from a DeleteAdminMfaOrganizationsOrganizationIdWithResponse call
+func ParseDeleteAdminMfaOrganizationsOrganizationIdResponse(rsp *http.Response) (*DeleteAdminMfaOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminMfaOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminMfaOrganizationsOrganizationIdResponse parses an HTTP response from a GetAdminMfaOrganizationsOrganizationIdWithResponse call
+func ParseGetAdminMfaOrganizationsOrganizationIdResponse(rsp *http.Response) (*GetAdminMfaOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminMfaOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationMFAPolicySchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminMfaOrganizationsOrganizationIdResponse parses an HTTP response from a PutAdminMfaOrganizationsOrganizationIdWithResponse call
+func ParsePutAdminMfaOrganizationsOrganizationIdResponse(rsp *http.Response) (*PutAdminMfaOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminMfaOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationMFAPolicySchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminOauthClientsResponse parses an HTTP response from a GetAdminOauthClientsWithResponse call
+func ParseGetAdminOauthClientsResponse(rsp *http.Response) (*GetAdminOauthClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminOauthClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Clients *[]OAuthClientSchema `json:"clients,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminOauthClientsResponse parses an HTTP response from a PostAdminOauthClientsWithResponse call
+func ParsePostAdminOauthClientsResponse(rsp *http.Response) (*PostAdminOauthClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminOauthClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OAuthClientSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminOauthClientsClientIdResponse parses an HTTP response from a DeleteAdminOauthClientsClientIdWithResponse call
+func ParseDeleteAdminOauthClientsClientIdResponse(rsp *http.Response) (*DeleteAdminOauthClientsClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminOauthClientsClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminOauthClientsClientIdResponse parses an HTTP response from a GetAdminOauthClientsClientIdWithResponse call
+func ParseGetAdminOauthClientsClientIdResponse(rsp *http.Response) (*GetAdminOauthClientsClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminOauthClientsClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthClientSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminOauthClientsClientIdResponse parses an HTTP response from a PutAdminOauthClientsClientIdWithResponse call
+func ParsePutAdminOauthClientsClientIdResponse(rsp *http.Response) (*PutAdminOauthClientsClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminOauthClientsClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthClientSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminOauthClientsClientIdRegenerateSecretResponse parses an HTTP response from a PostAdminOauthClientsClientIdRegenerateSecretWithResponse call
+func ParsePostAdminOauthClientsClientIdRegenerateSecretResponse(rsp *http.Response) (*PostAdminOauthClientsClientIdRegenerateSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminOauthClientsClientIdRegenerateSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthClientSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminOidcProjectsProjectIdProvidersResponse parses an HTTP response from a GetAdminOidcProjectsProjectIdProvidersWithResponse call
+func ParseGetAdminOidcProjectsProjectIdProvidersResponse(rsp *http.Response) (*GetAdminOidcProjectsProjectIdProvidersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminOidcProjectsProjectIdProvidersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Providers *[]CustomOIDCProviderSchema `json:"providers,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminOidcProjectsProjectIdProvidersResponse parses an HTTP response from a PostAdminOidcProjectsProjectIdProvidersWithResponse call
+func ParsePostAdminOidcProjectsProjectIdProvidersResponse(rsp *http.Response) (*PostAdminOidcProjectsProjectIdProvidersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminOidcProjectsProjectIdProvidersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CustomOIDCProviderSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse parses an HTTP response from a DeleteAdminOidcProjectsProjectIdProvidersProviderIdWithResponse call
+func ParseDeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse(rsp *http.Response) (*DeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminOidcProjectsProjectIdProvidersProviderIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminOidcProjectsProjectIdProvidersProviderIdResponse parses an HTTP response from a GetAdminOidcProjectsProjectIdProvidersProviderIdWithResponse call
+func ParseGetAdminOidcProjectsProjectIdProvidersProviderIdResponse(rsp *http.Response) (*GetAdminOidcProjectsProjectIdProvidersProviderIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminOidcProjectsProjectIdProvidersProviderIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomOIDCProviderSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminOidcProjectsProjectIdProvidersProviderIdResponse parses an HTTP response from a PutAdminOidcProjectsProjectIdProvidersProviderIdWithResponse call
+func ParsePutAdminOidcProjectsProjectIdProvidersProviderIdResponse(rsp *http.Response) (*PutAdminOidcProjectsProjectIdProvidersProviderIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminOidcProjectsProjectIdProvidersProviderIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomOIDCProviderSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminOrganizationsResponse parses an HTTP response from a GetAdminOrganizationsWithResponse call
+func ParseGetAdminOrganizationsResponse(rsp *http.Response) (*GetAdminOrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminOrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Organizations *[]OrganizationSchema `json:"organizations,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminOrganizationsResponse parses an HTTP response from a PostAdminOrganizationsWithResponse call
+func ParsePostAdminOrganizationsResponse(rsp *http.Response) (*PostAdminOrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminOrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OrganizationSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminOrganizationsOrganizationIdResponse parses an HTTP response from a DeleteAdminOrganizationsOrganizationIdWithResponse call
+func ParseDeleteAdminOrganizationsOrganizationIdResponse(rsp *http.Response) (*DeleteAdminOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminOrganizationsOrganizationIdResponse parses an HTTP response from a GetAdminOrganizationsOrganizationIdWithResponse call
+func ParseGetAdminOrganizationsOrganizationIdResponse(rsp *http.Response) (*GetAdminOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminOrganizationsOrganizationIdResponse parses an HTTP response from a PutAdminOrganizationsOrganizationIdWithResponse call
+func ParsePutAdminOrganizationsOrganizationIdResponse(rsp *http.Response) (*PutAdminOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminOrganizationsOrganizationIdMembersResponse parses an HTTP response from a GetAdminOrganizationsOrganizationIdMembersWithResponse call
+func ParseGetAdminOrganizationsOrganizationIdMembersResponse(rsp *http.Response) (*GetAdminOrganizationsOrganizationIdMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	response := &GetAdminOrganizationsOrganizationIdMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Members *[]OrganizationMemberSchema `json:"members,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+// ParsePostAdminOrganizationsOrganizationIdMembersResponse parses an HTTP response from a PostAdminOrganizationsOrganizationIdMembersWithResponse call
+func ParsePostAdminOrganizationsOrganizationIdMembersResponse(rsp *http.Response) (*PostAdminOrganizationsOrganizationIdMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminOrganizationsOrganizationIdMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationMemberSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
-		c.Server = newBaseURL.String()
-		return nil
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
+
+	return response, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAdminAuditWithResponse request
-	GetAdminAuditWithResponse(ctx context.Context, params *GetAdminAuditParams, reqEditors ...RequestEditorFn) (*GetAdminAuditResponse, error)
+// ParseDeleteAdminOrganizationsOrganizationIdMembersUserIdResponse parses an HTTP response from a DeleteAdminOrganizationsOrganizationIdMembersUserIdWithResponse call
+func ParseDeleteAdminOrganizationsOrganizationIdMembersUserIdResponse(rsp *http.Response) (*DeleteAdminOrganizationsOrganizationIdMembersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminOrganizationsOrganizationIdMembersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminOrganizationsOrganizationIdTierResponse parses an HTTP response from a GetAdminOrganizationsOrganizationIdTierWithResponse call
+func ParseGetAdminOrganizationsOrganizationIdTierResponse(rsp *http.Response) (*GetAdminOrganizationsOrganizationIdTierResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminOrganizationsOrganizationIdTierResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationTierSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminOrganizationsOrganizationIdTierResponse parses an HTTP response from a PutAdminOrganizationsOrganizationIdTierWithResponse call
+func ParsePutAdminOrganizationsOrganizationIdTierResponse(rsp *http.Response) (*PutAdminOrganizationsOrganizationIdTierResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminOrganizationsOrganizationIdTierResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationTierSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminProjectsResponse parses an HTTP response from a GetAdminProjectsWithResponse call
+func ParseGetAdminProjectsResponse(rsp *http.Response) (*GetAdminProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// PostAdminGenerateLinkWithBodyWithResponse request with any body
-	PostAdminGenerateLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminGenerateLinkResponse, error)
+	response := &GetAdminProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	PostAdminGenerateLinkWithResponse(ctx context.Context, body PostAdminGenerateLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminGenerateLinkResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Projects *[]ProjectSchema `json:"projects,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// GetAdminSsoProvidersWithResponse request
-	GetAdminSsoProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminSsoProvidersResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	// PostAdminSsoProvidersWithBodyWithResponse request with any body
-	PostAdminSsoProvidersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminSsoProvidersResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-	PostAdminSsoProvidersWithResponse(ctx context.Context, body PostAdminSsoProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminSsoProvidersResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	// DeleteAdminSsoProvidersSsoProviderIdWithResponse request
-	DeleteAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSsoProvidersSsoProviderIdResponse, error)
+	}
 
-	// GetAdminSsoProvidersSsoProviderIdWithResponse request
-	GetAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSsoProvidersSsoProviderIdResponse, error)
+	return response, nil
+}
 
-	// PutAdminSsoProvidersSsoProviderIdWithBodyWithResponse request with any body
-	PutAdminSsoProvidersSsoProviderIdWithBodyWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSsoProvidersSsoProviderIdResponse, error)
+// ParsePostAdminProjectsResponse parses an HTTP response from a PostAdminProjectsWithResponse call
+func ParsePostAdminProjectsResponse(rsp *http.Response) (*PostAdminProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	PutAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSsoProvidersSsoProviderIdResponse, error)
+	response := &PostAdminProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// GetAdminUsersWithResponse request
-	GetAdminUsersWithResponse(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*GetAdminUsersResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-	// DeleteAdminUsersUserIdWithResponse request
-	DeleteAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	// GetAdminUsersUserIdWithResponse request
-	GetAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-	// PutAdminUsersUserIdWithBodyWithResponse request with any body
-	PutAdminUsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	PutAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdResponse, error)
+	}
 
-	// GetAdminUsersUserIdFactorsWithResponse request
-	GetAdminUsersUserIdFactorsWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdFactorsResponse, error)
+	return response, nil
+}
 
-	// DeleteAdminUsersUserIdFactorsFactorIdWithResponse request
-	DeleteAdminUsersUserIdFactorsFactorIdWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdFactorsFactorIdResponse, error)
+// ParseDeleteAdminProjectsProjectIdResponse parses an HTTP response from a DeleteAdminProjectsProjectIdWithResponse call
+func ParseDeleteAdminProjectsProjectIdResponse(rsp *http.Response) (*DeleteAdminProjectsProjectIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// PutAdminUsersUserIdFactorsFactorIdWithBodyWithResponse request with any body
-	PutAdminUsersUserIdFactorsFactorIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdFactorsFactorIdResponse, error)
+	response := &DeleteAdminProjectsProjectIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	PutAdminUsersUserIdFactorsFactorIdWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdFactorsFactorIdResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// PostInviteWithBodyWithResponse request with any body
-	PostInviteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInviteResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	PostInviteWithResponse(ctx context.Context, body PostInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInviteResponse, error)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-type GetAdminAuditResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]struct {
-		CreatedAt *time.Time          `json:"created_at,omitempty"`
-		Id        *openapi_types.UUID `json:"id,omitempty"`
-		IpAddress *string             `json:"ip_address,omitempty"`
-		Payload   *struct {
-			// Action Usually one of these values:
-			// - login
-			// - logout
-			// - invite_accepted
-			// - user_signedup
-			// - user_invited
-			// - user_deleted
-			// - user_modified
-			// - user_recovery_requested
-			// - user_reauthenticate_requested
-			// - user_confirmation_requested
-			// - user_repeated_signup
-			// - user_updated_password
-			// - token_revoked
-			// - token_refreshed
-			// - generate_recovery_codes
-			// - factor_in_progress
-			// - factor_unenrolled
-			// - challenge_created
-			// - verification_attempted
-			// - factor_deleted
-			// - recovery_codes_deleted
-			// - factor_updated
-			// - mfa_code_login
-			Action        *string `json:"action,omitempty"`
-			ActorId       *string `json:"actor_id,omitempty"`
-			ActorName     *string `json:"actor_name,omitempty"`
-			ActorUsername *string `json:"actor_username,omitempty"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-			// ActorViaSso Whether the actor used a SSO protocol (like SAML 2.0 or OIDC) to authenticate.
-			ActorViaSso *bool `json:"actor_via_sso,omitempty"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-			// LogType Usually one of these values:
-			// - account
-			// - team
-			// - token
-			// - user
-			// - factor
-			// - recovery_codes
-			LogType *string                 `json:"log_type,omitempty"`
-			Traits  *map[string]interface{} `json:"traits,omitempty"`
-		} `json:"payload,omitempty"`
 	}
-	JSON401 *UnauthorizedResponse
-	JSON403 *ForbiddenResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r GetAdminAuditResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAdminAuditResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetAdminProjectsProjectIdResponse parses an HTTP response from a GetAdminProjectsProjectIdWithResponse call
+func ParseGetAdminProjectsProjectIdResponse(rsp *http.Response) (*GetAdminProjectsProjectIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostAdminGenerateLinkResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		ActionLink           *string                `json:"action_link,omitempty"`
-		EmailOtp             *string                `json:"email_otp,omitempty"`
-		HashedToken          *string                `json:"hashed_token,omitempty"`
-		RedirectTo           *string                `json:"redirect_to,omitempty"`
-		VerificationType     *string                `json:"verification_type,omitempty"`
-		AdditionalProperties map[string]interface{} `json:"-"`
+	response := &GetAdminProjectsProjectIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	JSON400 *BadRequestResponse
-	JSON401 *UnauthorizedResponse
-	JSON403 *ForbiddenResponse
-	JSON404 *ErrorSchema
-	JSON422 *ErrorSchema
-}
 
-// Status returns HTTPResponse.Status
-func (r PostAdminGenerateLinkResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAdminGenerateLinkResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-type GetAdminSsoProvidersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items *[]SSOProviderSchema `json:"items,omitempty"`
+// ParsePutAdminProjectsProjectIdResponse parses an HTTP response from a PutAdminProjectsProjectIdWithResponse call
+func ParsePutAdminProjectsProjectIdResponse(rsp *http.Response) (*PutAdminProjectsProjectIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetAdminSsoProvidersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &PutAdminProjectsProjectIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAdminSsoProvidersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return 0
-}
 
-type PostAdminSsoProvidersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SSOProviderSchema
-	JSON400      *BadRequestResponse
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAdminSsoProvidersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAdminProjectsProjectIdOrganizationsResponse parses an HTTP response from a GetAdminProjectsProjectIdOrganizationsWithResponse call
+func ParseGetAdminProjectsProjectIdOrganizationsResponse(rsp *http.Response) (*GetAdminProjectsProjectIdOrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAdminSsoProvidersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAdminProjectsProjectIdOrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteAdminSsoProvidersSsoProviderIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SSOProviderSchema
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Organizations *[]OrganizationSchema `json:"organizations,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteAdminSsoProvidersSsoProviderIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAdminSsoProvidersSsoProviderIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetAdminSsoProvidersSsoProviderIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SSOProviderSchema
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAdminSsoProvidersSsoProviderIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAdminProjectsProjectIdTiersResponse parses an HTTP response from a GetAdminProjectsProjectIdTiersWithResponse call
+func ParseGetAdminProjectsProjectIdTiersResponse(rsp *http.Response) (*GetAdminProjectsProjectIdTiersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAdminSsoProvidersSsoProviderIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAdminProjectsProjectIdTiersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PutAdminSsoProvidersSsoProviderIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SSOProviderSchema
-	JSON400      *BadRequestResponse
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Tiers *[]ProjectTierSchema `json:"tiers,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutAdminSsoProvidersSsoProviderIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutAdminSsoProvidersSsoProviderIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-type GetAdminUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// Deprecated:
-		Aud   *string       `json:"aud,omitempty"`
-		Users *[]UserSchema `json:"users,omitempty"`
+// ParseDeleteAdminProjectsProjectIdTiersTierResponse parses an HTTP response from a DeleteAdminProjectsProjectIdTiersTierWithResponse call
+func ParseDeleteAdminProjectsProjectIdTiersTierResponse(rsp *http.Response) (*DeleteAdminProjectsProjectIdTiersTierResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *UnauthorizedResponse
-	JSON403 *ForbiddenResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r GetAdminUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeleteAdminProjectsProjectIdTiersTierResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAdminUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return 0
-}
 
-type DeleteAdminUsersUserIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserSchema
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteAdminUsersUserIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePutAdminProjectsProjectIdTiersTierResponse parses an HTTP response from a PutAdminProjectsProjectIdTiersTierWithResponse call
+func ParsePutAdminProjectsProjectIdTiersTierResponse(rsp *http.Response) (*PutAdminProjectsProjectIdTiersTierResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAdminUsersUserIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PutAdminProjectsProjectIdTiersTierResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetAdminUsersUserIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserSchema
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectTierSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetAdminUsersUserIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAdminUsersUserIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-type PutAdminUsersUserIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserSchema
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutAdminUsersUserIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutAdminUsersUserIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetAdminUsersUserIdFactorsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]MFAFactorSchema
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAdminUsersUserIdFactorsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteAdminSettingsProjectsProjectIdResponse parses an HTTP response from a DeleteAdminSettingsProjectsProjectIdWithResponse call
+func ParseDeleteAdminSettingsProjectsProjectIdResponse(rsp *http.Response) (*DeleteAdminSettingsProjectsProjectIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAdminUsersUserIdFactorsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteAdminSettingsProjectsProjectIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteAdminUsersUserIdFactorsFactorIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MFAFactorSchema
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteAdminUsersUserIdFactorsFactorIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAdminUsersUserIdFactorsFactorIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PutAdminUsersUserIdFactorsFactorIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MFAFactorSchema
-	JSON401      *UnauthorizedResponse
-	JSON403      *ForbiddenResponse
-	JSON404      *ErrorSchema
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutAdminUsersUserIdFactorsFactorIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAdminSettingsProjectsProjectIdResponse parses an HTTP response from a GetAdminSettingsProjectsProjectIdWithResponse call
+func ParseGetAdminSettingsProjectsProjectIdResponse(rsp *http.Response) (*GetAdminSettingsProjectsProjectIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutAdminUsersUserIdFactorsFactorIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAdminSettingsProjectsProjectIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PostInviteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserSchema
-	JSON400      *BadRequestResponse
-	JSON422      *ErrorSchema
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectSettingsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PostInviteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostInviteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// GetAdminAuditWithResponse request returning *GetAdminAuditResponse
-func (c *ClientWithResponses) GetAdminAuditWithResponse(ctx context.Context, params *GetAdminAuditParams, reqEditors ...RequestEditorFn) (*GetAdminAuditResponse, error) {
-	rsp, err := c.GetAdminAudit(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetAdminAuditResponse(rsp)
-}
 
-// PostAdminGenerateLinkWithBodyWithResponse request with arbitrary body returning *PostAdminGenerateLinkResponse
-func (c *ClientWithResponses) PostAdminGenerateLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminGenerateLinkResponse, error) {
-	rsp, err := c.PostAdminGenerateLinkWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAdminGenerateLinkResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostAdminGenerateLinkWithResponse(ctx context.Context, body PostAdminGenerateLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminGenerateLinkResponse, error) {
-	rsp, err := c.PostAdminGenerateLink(ctx, body, reqEditors...)
+// ParsePutAdminSettingsProjectsProjectIdResponse parses an HTTP response from a PutAdminSettingsProjectsProjectIdWithResponse call
+func ParsePutAdminSettingsProjectsProjectIdResponse(rsp *http.Response) (*PutAdminSettingsProjectsProjectIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAdminGenerateLinkResponse(rsp)
-}
 
-// GetAdminSsoProvidersWithResponse request returning *GetAdminSsoProvidersResponse
-func (c *ClientWithResponses) GetAdminSsoProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminSsoProvidersResponse, error) {
-	rsp, err := c.GetAdminSsoProviders(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutAdminSettingsProjectsProjectIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAdminSsoProvidersResponse(rsp)
-}
 
-// PostAdminSsoProvidersWithBodyWithResponse request with arbitrary body returning *PostAdminSsoProvidersResponse
-func (c *ClientWithResponses) PostAdminSsoProvidersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminSsoProvidersResponse, error) {
-	rsp, err := c.PostAdminSsoProvidersWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAdminSsoProvidersResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectSettingsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) PostAdminSsoProvidersWithResponse(ctx context.Context, body PostAdminSsoProvidersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminSsoProvidersResponse, error) {
-	rsp, err := c.PostAdminSsoProviders(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAdminSsoProvidersResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// DeleteAdminSsoProvidersSsoProviderIdWithResponse request returning *DeleteAdminSsoProvidersSsoProviderIdResponse
-func (c *ClientWithResponses) DeleteAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminSsoProvidersSsoProviderIdResponse, error) {
-	rsp, err := c.DeleteAdminSsoProvidersSsoProviderId(ctx, ssoProviderId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteAdminSsoProvidersSsoProviderIdResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// GetAdminSsoProvidersSsoProviderIdWithResponse request returning *GetAdminSsoProvidersSsoProviderIdResponse
-func (c *ClientWithResponses) GetAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminSsoProvidersSsoProviderIdResponse, error) {
-	rsp, err := c.GetAdminSsoProvidersSsoProviderId(ctx, ssoProviderId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAdminSsoProvidersSsoProviderIdResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// PutAdminSsoProvidersSsoProviderIdWithBodyWithResponse request with arbitrary body returning *PutAdminSsoProvidersSsoProviderIdResponse
-func (c *ClientWithResponses) PutAdminSsoProvidersSsoProviderIdWithBodyWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminSsoProvidersSsoProviderIdResponse, error) {
-	rsp, err := c.PutAdminSsoProvidersSsoProviderIdWithBody(ctx, ssoProviderId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePutAdminSsoProvidersSsoProviderIdResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutAdminSsoProvidersSsoProviderIdWithResponse(ctx context.Context, ssoProviderId openapi_types.UUID, body PutAdminSsoProvidersSsoProviderIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminSsoProvidersSsoProviderIdResponse, error) {
-	rsp, err := c.PutAdminSsoProvidersSsoProviderId(ctx, ssoProviderId, body, reqEditors...)
+// ParseDeleteAdminSmtpOrganizationsOrganizationIdResponse parses an HTTP response from a DeleteAdminSmtpOrganizationsOrganizationIdWithResponse call
+func ParseDeleteAdminSmtpOrganizationsOrganizationIdResponse(rsp *http.Response) (*DeleteAdminSmtpOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutAdminSsoProvidersSsoProviderIdResponse(rsp)
-}
 
-// GetAdminUsersWithResponse request returning *GetAdminUsersResponse
-func (c *ClientWithResponses) GetAdminUsersWithResponse(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*GetAdminUsersResponse, error) {
-	rsp, err := c.GetAdminUsers(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAdminSmtpOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAdminUsersResponse(rsp)
-}
 
-// DeleteAdminUsersUserIdWithResponse request returning *DeleteAdminUsersUserIdResponse
-func (c *ClientWithResponses) DeleteAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdResponse, error) {
-	rsp, err := c.DeleteAdminUsersUserId(ctx, userId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseDeleteAdminUsersUserIdResponse(rsp)
+
+	return response, nil
 }
 
-// GetAdminUsersUserIdWithResponse request returning *GetAdminUsersUserIdResponse
-func (c *ClientWithResponses) GetAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdResponse, error) {
-	rsp, err := c.GetAdminUsersUserId(ctx, userId, reqEditors...)
+// ParseGetAdminSmtpOrganizationsOrganizationIdResponse parses an HTTP response from a GetAdminSmtpOrganizationsOrganizationIdWithResponse call
+func ParseGetAdminSmtpOrganizationsOrganizationIdResponse(rsp *http.Response) (*GetAdminSmtpOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAdminUsersUserIdResponse(rsp)
-}
 
-// PutAdminUsersUserIdWithBodyWithResponse request with arbitrary body returning *PutAdminUsersUserIdResponse
-func (c *ClientWithResponses) PutAdminUsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdResponse, error) {
-	rsp, err := c.PutAdminUsersUserIdWithBody(ctx, userId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAdminSmtpOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutAdminUsersUserIdResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutAdminUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, body PutAdminUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdResponse, error) {
-	rsp, err := c.PutAdminUsersUserId(ctx, userId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationSMTPConfigSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParsePutAdminUsersUserIdResponse(rsp)
+
+	return response, nil
 }
 
-// GetAdminUsersUserIdFactorsWithResponse request returning *GetAdminUsersUserIdFactorsResponse
-func (c *ClientWithResponses) GetAdminUsersUserIdFactorsWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAdminUsersUserIdFactorsResponse, error) {
-	rsp, err := c.GetAdminUsersUserIdFactors(ctx, userId, reqEditors...)
+// ParsePutAdminSmtpOrganizationsOrganizationIdResponse parses an HTTP response from a PutAdminSmtpOrganizationsOrganizationIdWithResponse call
+func ParsePutAdminSmtpOrganizationsOrganizationIdResponse(rsp *http.Response) (*PutAdminSmtpOrganizationsOrganizationIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAdminUsersUserIdFactorsResponse(rsp)
-}
 
-// DeleteAdminUsersUserIdFactorsFactorIdWithResponse request returning *DeleteAdminUsersUserIdFactorsFactorIdResponse
-func (c *ClientWithResponses) DeleteAdminUsersUserIdFactorsFactorIdWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAdminUsersUserIdFactorsFactorIdResponse, error) {
-	rsp, err := c.DeleteAdminUsersUserIdFactorsFactorId(ctx, userId, factorId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutAdminSmtpOrganizationsOrganizationIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteAdminUsersUserIdFactorsFactorIdResponse(rsp)
-}
 
-// PutAdminUsersUserIdFactorsFactorIdWithBodyWithResponse request with arbitrary body returning *PutAdminUsersUserIdFactorsFactorIdResponse
-func (c *ClientWithResponses) PutAdminUsersUserIdFactorsFactorIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdFactorsFactorIdResponse, error) {
-	rsp, err := c.PutAdminUsersUserIdFactorsFactorIdWithBody(ctx, userId, factorId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationSMTPConfigSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParsePutAdminUsersUserIdFactorsFactorIdResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutAdminUsersUserIdFactorsFactorIdWithResponse(ctx context.Context, userId openapi_types.UUID, factorId openapi_types.UUID, body PutAdminUsersUserIdFactorsFactorIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersUserIdFactorsFactorIdResponse, error) {
-	rsp, err := c.PutAdminUsersUserIdFactorsFactorId(ctx, userId, factorId, body, reqEditors...)
+// ParseDeleteAdminSmtpProjectsProjectIdResponse parses an HTTP response from a DeleteAdminSmtpProjectsProjectIdWithResponse call
+func ParseDeleteAdminSmtpProjectsProjectIdResponse(rsp *http.Response) (*DeleteAdminSmtpProjectsProjectIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutAdminUsersUserIdFactorsFactorIdResponse(rsp)
-}
 
-// PostInviteWithBodyWithResponse request with arbitrary body returning *PostInviteResponse
-func (c *ClientWithResponses) PostInviteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInviteResponse, error) {
-	rsp, err := c.PostInviteWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAdminSmtpProjectsProjectIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostInviteResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostInviteWithResponse(ctx context.Context, body PostInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInviteResponse, error) {
-	rsp, err := c.PostInvite(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParsePostInviteResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetAdminAuditResponse parses an HTTP response from a GetAdminAuditWithResponse call
-func ParseGetAdminAuditResponse(rsp *http.Response) (*GetAdminAuditResponse, error) {
+// ParseGetAdminSmtpProjectsProjectIdResponse parses an HTTP response from a GetAdminSmtpProjectsProjectIdWithResponse call
+func ParseGetAdminSmtpProjectsProjectIdResponse(rsp *http.Response) (*GetAdminSmtpProjectsProjectIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAdminAuditResponse{
+	response := &GetAdminSmtpProjectsProjectIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []struct {
-			CreatedAt *time.Time          `json:"created_at,omitempty"`
-			Id        *openapi_types.UUID `json:"id,omitempty"`
-			IpAddress *string             `json:"ip_address,omitempty"`
-			Payload   *struct {
-				// Action Usually one of these values:
-				// - login
-				// - logout
-				// - invite_accepted
-				// - user_signedup
-				// - user_invited
-				// - user_deleted
-				// - user_modified
-				// - user_recovery_requested
-				// - user_reauthenticate_requested
-				// - user_confirmation_requested
-				// - user_repeated_signup
-				// - user_updated_password
-				// - token_revoked
-				// - token_refreshed
-				// - generate_recovery_codes
-				// - factor_in_progress
-				// - factor_unenrolled
-				// - challenge_created
-				// - verification_attempted
-				// - factor_deleted
-				// - recovery_codes_deleted
-				// - factor_updated
-				// - mfa_code_login
-				Action        *string `json:"action,omitempty"`
-				ActorId       *string `json:"actor_id,omitempty"`
-				ActorName     *string `json:"actor_name,omitempty"`
-				ActorUsername *string `json:"actor_username,omitempty"`
-
-				// ActorViaSso Whether the actor used a SSO protocol (like SAML 2.0 or OIDC) to authenticate.
-				ActorViaSso *bool `json:"actor_via_sso,omitempty"`
-
-				// LogType Usually one of these values:
-				// - account
-				// - team
-				// - token
-				// - user
-				// - factor
-				// - recovery_codes
-				LogType *string                 `json:"log_type,omitempty"`
-				Traits  *map[string]interface{} `json:"traits,omitempty"`
-			} `json:"payload,omitempty"`
-		}
+		var dest ProjectSMTPConfigSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2064,34 +10913,34 @@ func ParseGetAdminAuditResponse(rsp *http.Response) (*GetAdminAuditResponse, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePostAdminGenerateLinkResponse parses an HTTP response from a PostAdminGenerateLinkWithResponse call
-func ParsePostAdminGenerateLinkResponse(rsp *http.Response) (*PostAdminGenerateLinkResponse, error) {
+// ParsePutAdminSmtpProjectsProjectIdResponse parses an HTTP response from a PutAdminSmtpProjectsProjectIdWithResponse call
+func ParsePutAdminSmtpProjectsProjectIdResponse(rsp *http.Response) (*PutAdminSmtpProjectsProjectIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAdminGenerateLinkResponse{
+	response := &PutAdminSmtpProjectsProjectIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			ActionLink           *string                `json:"action_link,omitempty"`
-			EmailOtp             *string                `json:"email_otp,omitempty"`
-			HashedToken          *string                `json:"hashed_token,omitempty"`
-			RedirectTo           *string                `json:"redirect_to,omitempty"`
-			VerificationType     *string                `json:"verification_type,omitempty"`
-			AdditionalProperties map[string]interface{} `json:"-"`
-		}
+		var dest ProjectSMTPConfigSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2119,19 +10968,12 @@ func ParsePostAdminGenerateLinkResponse(rsp *http.Response) (*PostAdminGenerateL
 		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorSchema
+		var dest NotFoundResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ErrorSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	}
 
 	return response, nil
@@ -2376,7 +11218,7 @@ func ParseGetAdminUsersResponse(rsp *http.Response) (*GetAdminUsersResponse, err
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			// Deprecated:
+			// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
 			Aud   *string       `json:"aud,omitempty"`
 			Users *[]UserSchema `json:"users,omitempty"`
 		}
@@ -2686,6 +11528,149 @@ func ParsePutAdminUsersUserIdFactorsFactorIdResponse(rsp *http.Response) (*PutAd
 	return response, nil
 }
 
+// ParseDeleteAdminUsersUserIdRecoveryCodesResponse parses an HTTP response from a DeleteAdminUsersUserIdRecoveryCodesWithResponse call
+func ParseDeleteAdminUsersUserIdRecoveryCodesResponse(rsp *http.Response) (*DeleteAdminUsersUserIdRecoveryCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminUsersUserIdRecoveryCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminUsersUserIdSessionsResponse parses an HTTP response from a GetAdminUsersUserIdSessionsWithResponse call
+func ParseGetAdminUsersUserIdSessionsResponse(rsp *http.Response) (*GetAdminUsersUserIdSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminUsersUserIdSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Sessions *[]SessionSchema `json:"sessions,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminUsersUserIdSessionsSessionIdResponse parses an HTTP response from a DeleteAdminUsersUserIdSessionsSessionIdWithResponse call
+func ParseDeleteAdminUsersUserIdSessionsSessionIdResponse(rsp *http.Response) (*DeleteAdminUsersUserIdSessionsSessionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminUsersUserIdSessionsSessionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForbiddenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostInviteResponse parses an HTTP response from a PostInviteWithResponse call
 func ParsePostInviteResponse(rsp *http.Response) (*PostInviteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// tenantTokenLifetime is how long the tokens signed by WithTenant are valid.
+const tenantTokenLifetime = 5 * time.Minute
+
+// WithBearerToken sends the access token of an admin with the request. The
+// calls are scoped to the organization and project in the token claims.
+func WithBearerToken(token string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+// WithAPIKey sends an organization API key with the request. The calls are
+// scoped to the organization of the key.
+func WithAPIKey(key string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "ApiKey "+key)
+		return nil
+	}
+}
+
+// WithTenant signs a short lived token for the given admin role, such as
+// service_role, with the JWT secret of the server. The token carries the
+// project and organization claims, so the calls act as an admin of that
+// tenant. A nil organization ID scopes the calls to the whole project.
+func WithTenant(secret, role string, projectID, organizationID uuid.UUID) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		now := time.Now()
+		claims := jwt.MapClaims{
+			"role":       role,
+			"iat":        now.Unix(),
+			"exp":        now.Add(tenantTokenLifetime).Unix(),
+			"project_id": projectID.String(),
+		}
+		if organizationID != uuid.Nil {
+			claims["organization_id"] = organizationID.String()
+		}
+
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+		if err != nil {
+			return fmt.Errorf("error signing tenant token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+var apiKeyDescription string
+
+// adminAPIKeyOutput is printed by apikey create. The plain key is only known
+// when the key is created, it is left out when an existing key is printed.
+type adminAPIKeyOutput struct {
+	*models.APIKey
+	Key string `json:"key,omitempty"`
+}
+
+func adminAPIKeyCmd() *cobra.Command {
+	var apiKeyCmd = &cobra.Command{
+		Use:   "apikey",
+		Short: "Manage the API keys of organizations",
+	}
+
+	apiKeyCmd.AddCommand(&adminAPIKeyCreateCmd, &adminAPIKeyRevokeCmd)
+
+	adminAPIKeyCreateCmd.Flags().StringVar(&apiKeyDescription, "description", "", "Description of the new API key")
+
+	return apiKeyCmd
+}
+
+var adminAPIKeyCreateCmd = cobra.Command{
+	Use:   "create",
+	Short: "Create an API key, or print the key of the organization with the same name",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 2 {
+			logrus.Fatal("Not enough arguments to apikey create command. Expected organization_id and name values")
+			return
+		}
+
+		execWithConfigAndArgs(cmd, adminAPIKeyCreate, args)
+	},
+}
+
+var adminAPIKeyRevokeCmd = cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke an API key",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			logrus.Fatal("Not enough arguments to apikey revoke command. Expected key_id value")
+			return
+		}
+
+		execWithConfigAndArgs(cmd, adminAPIKeyRevoke, args)
+	},
+}
+
+func adminAPIKeyCreate(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	organizationID := parseUUIDArg("organization_id", args[0])
+	name := args[1]
+
+	output := adminAPIKeyOutput{}
+	err := db.Transaction(func(tx *storage.Connection) error {
+		organization, terr := models.FindOrganizationByID(tx, organizationID)
+		if terr != nil {
+			return terr
+		}
+
+		output.APIKey, terr = models.FindAPIKeyByName(tx, organization.ID, name)
+		if terr == nil || !models.IsNotFoundError(terr) {
+			return terr
+		}
+
+		if output.APIKey, output.Key, terr = models.NewAPIKey(organization, name, apiKeyDescription); terr != nil {
+			return terr
+		}
+
+		// Keys inherit the client tiers of their organization, like the keys
+		// created through the admin API
+		tier, terr := models.FindEffectiveTier(tx, organization.ID, organization.ProjectID, models.OrganizationRoleAPIKey)
+		if terr != nil {
+			return terr
+		}
+		output.TierModel, output.TierTime, output.TierUsage = tier.TierModel, tier.TierTime, tier.TierUsage
+
+		return tx.Create(output.APIKey)
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to create API key (%s): %+v", name, err)
+	}
+
+	printJSON(output)
+}
+
+func adminAPIKeyRevoke(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	id := parseUUIDArg("key_id", args[0])
+	output := adminDeleteOutput{ID: id}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		apiKey, terr := models.FindAPIKeyByID(tx, id)
+		if terr != nil {
+			if models.IsNotFoundError(terr) {
+				return nil
+			}
+			return terr
+		}
+
+		if terr := tx.Destroy(apiKey); terr != nil {
+			return terr
+		}
+		output.Deleted = true
+		return nil
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to revoke API key (%s): %+v", id, err)
+	}
+
+	printJSON(output)
+}
@@ -1,6 +1,10 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"os"
+
 	"github.com/gofrs/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -25,7 +29,7 @@ func adminCmd() *cobra.Command {
 		Use: "admin",
 	}
 
-	adminCmd.AddCommand(&adminCreateUserCmd, &adminDeleteUserCmd)
+	adminCmd.AddCommand(&adminCreateUserCmd, &adminDeleteUserCmd, adminProjectCmd(), adminOrgCmd(), adminAPIKeyCmd())
 	adminCmd.PersistentFlags().StringVarP(&audience, "aud", "a", "", "Set the new user's audience")
 
 	adminCreateUserCmd.Flags().BoolVar(&autoconfirm, "confirm", false, "Automatically confirm user without sending an email")
@@ -59,10 +63,7 @@ var adminDeleteUserCmd = cobra.Command{
 }
 
 func adminCreateUser(config *conf.GlobalConfiguration, args []string) {
-	db, err := storage.Dial(config)
-	if err != nil {
-		logrus.Fatalf("Error opening database: %+v", err)
-	}
+	db := dialAdmin(config)
 	defer db.Close()
 
 	aud := getAudience(config)
@@ -111,10 +112,7 @@ func adminCreateUser(config *conf.GlobalConfiguration, args []string) {
 }
 
 func adminDeleteUser(config *conf.GlobalConfiguration, args []string) {
-	db, err := storage.Dial(config)
-	if err != nil {
-		logrus.Fatalf("Error opening database: %+v", err)
-	}
+	db := dialAdmin(config)
 	defer db.Close()
 
 	id := uuid.Must(uuid.FromString(args[2]))
@@ -133,3 +131,39 @@ func adminDeleteUser(config *conf.GlobalConfiguration, args []string) {
 
 	logrus.Infof("Removed user: %s", args[0])
 }
+
+// adminDeleteOutput is printed by the delete commands. Deleting something
+// that does not exist succeeds with deleted set to false, so that scripts can
+// run the commands more than once.
+type adminDeleteOutput struct {
+	ID      uuid.UUID `json:"id"`
+	Deleted bool      `json:"deleted"`
+}
+
+// dialAdmin opens a connection for the admin commands. They are not limited
+// to a tenant, so their transactions bypass the row level security policies.
+func dialAdmin(config *conf.GlobalConfiguration) *storage.Connection {
+	db, err := storage.Dial(config)
+	if err != nil {
+		logrus.Fatalf("Error opening database: %+v", err)
+	}
+	return db.WithContext(storage.WithSessionVariables(context.Background(), storage.BypassRLS))
+}
+
+// printJSON writes the result of a tenant command to stdout.
+func printJSON(v interface{}) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		logrus.Fatalf("Error encoding output: %+v", err)
+	}
+}
+
+// parseUUIDArg parses a UUID argument or exits with an error naming it.
+func parseUUIDArg(name, value string) uuid.UUID {
+	id, err := uuid.FromString(value)
+	if err != nil {
+		logrus.Fatalf("%s must be an UUID: %s", name, value)
+	}
+	return id
+}
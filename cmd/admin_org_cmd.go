@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+var orgDescription, orgAdminPassword string
+var orgTier models.OrganizationTier
+
+func adminOrgCmd() *cobra.Command {
+	var orgCmd = &cobra.Command{
+		Use:   "org",
+		Short: "Manage organizations",
+	}
+
+	orgCmd.AddCommand(&adminOrgCreateCmd, &adminOrgListCmd, &adminOrgDeleteCmd, &adminOrgSetTierCmd)
+
+	adminOrgCreateCmd.Flags().StringVar(&orgDescription, "description", "", "Description of the new organization")
+	adminOrgCreateCmd.Flags().StringVar(&orgAdminPassword, "password", "", "Create the admin with this password when no user has the admin email")
+
+	flags := adminOrgSetTierCmd.Flags()
+	flags.StringVar(&orgTier.AdminTierModel, "admin-tier-model", "", "Model tier of the organization admins")
+	flags.StringVar(&orgTier.ClientTierModel, "client-tier-model", "", "Model tier of the organization clients")
+	flags.StringVar(&orgTier.AdminTierTime, "admin-tier-time", "", "Time tier of the organization admins")
+	flags.StringVar(&orgTier.ClientTierTime, "client-tier-time", "", "Time tier of the organization clients")
+	flags.StringVar(&orgTier.AdminTierUsage, "admin-tier-usage", "", "Usage tier of the organization admins")
+	flags.StringVar(&orgTier.ClientTierUsage, "client-tier-usage", "", "Usage tier of the organization clients")
+
+	return orgCmd
+}
+
+var adminOrgCreateCmd = cobra.Command{
+	Use:   "create",
+	Short: "Create an organization, or print the organization of the admin",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 3 {
+			logrus.Fatal("Not enough arguments to org create command. Expected project_id, admin ID or email and name values")
+			return
+		}
+
+		execWithConfigAndArgs(cmd, adminOrgCreate, args)
+	},
+}
+
+var adminOrgListCmd = cobra.Command{
+	Use:   "list",
+	Short: "List the organizations of a project",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			logrus.Fatal("Not enough arguments to org list command. Expected project_id value")
+			return
+		}
+
+		execWithConfigAndArgs(cmd, adminOrgList, args)
+	},
+}
+
+var adminOrgDeleteCmd = cobra.Command{
+	Use:   "delete",
+	Short: "Delete an organization and its users",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			logrus.Fatal("Not enough arguments to org delete command. Expected organization_id value")
+			return
+		}
+
+		execWithConfigAndArgs(cmd, adminOrgDelete, args)
+	},
+}
+
+var adminOrgSetTierCmd = cobra.Command{
+	Use:   "set-tier",
+	Short: "Assign a tier to an organization",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 2 {
+			logrus.Fatal("Not enough arguments to org set-tier command. Expected organization_id and tier values")
+			return
+		}
+
+		execWithConfigAndArgs(cmd, adminOrgSetTier, args)
+	},
+}
+
+// findOrCreateOrgAdmin resolves the owner of a new organization from a user
+// ID or an email of the project. Unknown emails are only created when a
+// password was given.
+func findOrCreateOrgAdmin(tx *storage.Connection, config *conf.GlobalConfiguration, projectID uuid.UUID, admin string) (*models.User, error) {
+	if id, err := uuid.FromString(admin); err == nil {
+		user, err := models.FindUserByID(tx, id)
+		if err != nil {
+			return nil, err
+		}
+		if user.ProjectID != projectID {
+			return nil, fmt.Errorf("user %s does not belong to the project", id)
+		}
+		return user, nil
+	}
+
+	aud := getAudience(config)
+	user, err := models.FindUserByEmailAndAudience(tx, admin, aud, uuid.Nil, projectID)
+	if err == nil || !models.IsNotFoundError(err) || orgAdminPassword == "" {
+		return user, err
+	}
+
+	if user, err = models.NewUser("", admin, orgAdminPassword, aud, nil, uuid.Nil, projectID); err != nil {
+		return nil, err
+	}
+	if err := tx.Create(user, "organization_id", "organization_role"); err != nil {
+		return nil, err
+	}
+	if err := user.Confirm(tx); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func adminOrgCreate(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	projectID := parseUUIDArg("project_id", args[0])
+	name := args[2]
+
+	var organization *models.Organization
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if _, terr := models.FindProjectByID(tx, projectID); terr != nil {
+			return terr
+		}
+
+		owner, terr := findOrCreateOrgAdmin(tx, config, projectID, args[1])
+		if terr != nil {
+			return terr
+		}
+
+		// An admin owns at most one organization, which is returned as is
+		organization, terr = models.FindOrganizationByAdminID(tx, owner.ID)
+		if terr == nil || !models.IsNotFoundError(terr) {
+			return terr
+		}
+		if owner.OrganizationID.Valid {
+			return fmt.Errorf("user %s already belongs to an organization", owner.ID)
+		}
+
+		if organization, terr = models.NewOrganization(projectID, owner.ID, name, orgDescription); terr != nil {
+			return terr
+		}
+		if terr := tx.Create(organization); terr != nil {
+			return terr
+		}
+		if terr := models.SaveOrganizationTier(tx, models.NewOrganizationTier(organization.ID)); terr != nil {
+			return terr
+		}
+		return owner.SetOrganization(tx, organization.ID, models.OrganizationRoleAdmin)
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to create organization (%s): %+v", name, err)
+	}
+
+	printJSON(organization)
+}
+
+func adminOrgList(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	projectID := parseUUIDArg("project_id", args[0])
+
+	var organizations []*models.Organization
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		organizations, terr = models.FindOrganizations(tx, projectID, nil, nil, "")
+		return terr
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to list organizations: %+v", err)
+	}
+
+	printJSON(organizations)
+}
+
+func adminOrgDelete(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	id := parseUUIDArg("organization_id", args[0])
+	output := adminDeleteOutput{ID: id}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		organization, terr := models.FindOrganizationByID(tx, id)
+		if terr != nil {
+			if models.IsNotFoundError(terr) {
+				return nil
+			}
+			return terr
+		}
+
+		if terr := tx.Destroy(organization); terr != nil {
+			return terr
+		}
+		output.Deleted = true
+		return nil
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to delete organization (%s): %+v", id, err)
+	}
+
+	printJSON(output)
+}
+
+func adminOrgSetTier(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	id := parseUUIDArg("organization_id", args[0])
+
+	var tier *models.OrganizationTier
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if _, terr := models.FindOrganizationByID(tx, id); terr != nil {
+			return terr
+		}
+
+		var terr error
+		if tier, terr = models.FindOrganizationTierByOrganizationID(tx, id); terr != nil {
+			return terr
+		}
+		if tier == nil {
+			tier = models.NewOrganizationTier(id)
+		}
+
+		// Values without a flag keep their current value
+		tier.Tier = args[1]
+		for _, v := range []struct {
+			dst *string
+			src string
+		}{
+			{&tier.AdminTierModel, orgTier.AdminTierModel},
+			{&tier.ClientTierModel, orgTier.ClientTierModel},
+			{&tier.AdminTierTime, orgTier.AdminTierTime},
+			{&tier.ClientTierTime, orgTier.ClientTierTime},
+			{&tier.AdminTierUsage, orgTier.AdminTierUsage},
+			{&tier.ClientTierUsage, orgTier.ClientTierUsage},
+		} {
+			if v.src != "" {
+				*v.dst = v.src
+			}
+		}
+
+		return models.SaveOrganizationTier(tx, tier)
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to set the tier of organization (%s): %+v", id, err)
+	}
+
+	printJSON(tier)
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+var projectDescription string
+var projectCascade bool
+
+func adminProjectCmd() *cobra.Command {
+	var projectCmd = &cobra.Command{
+		Use:   "project",
+		Short: "Manage projects",
+	}
+
+	projectCmd.AddCommand(&adminProjectCreateCmd, &adminProjectListCmd, &adminProjectDeleteCmd)
+
+	adminProjectCreateCmd.Flags().StringVar(&projectDescription, "description", "", "Description of the new project")
+	adminProjectDeleteCmd.Flags().BoolVar(&projectCascade, "cascade", false, "Delete the project even if it still has users")
+
+	return projectCmd
+}
+
+var adminProjectCreateCmd = cobra.Command{
+	Use:   "create",
+	Short: "Create a project, or print the project with the same name",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			logrus.Fatal("Not enough arguments to project create command. Expected name value")
+			return
+		}
+
+		execWithConfigAndArgs(cmd, adminProjectCreate, args)
+	},
+}
+
+var adminProjectListCmd = cobra.Command{
+	Use:   "list",
+	Short: "List projects",
+	Run: func(cmd *cobra.Command, args []string) {
+		execWithConfigAndArgs(cmd, adminProjectList, args)
+	},
+}
+
+var adminProjectDeleteCmd = cobra.Command{
+	Use:   "delete",
+	Short: "Delete a project",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			logrus.Fatal("Not enough arguments to project delete command. Expected project_id value")
+			return
+		}
+
+		execWithConfigAndArgs(cmd, adminProjectDelete, args)
+	},
+}
+
+func adminProjectCreate(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	name := args[0]
+
+	var project *models.Project
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		project, terr = models.FindProjectByName(tx, name)
+		if terr == nil || !models.IsNotFoundError(terr) {
+			return terr
+		}
+
+		if project, terr = models.NewProject(name, projectDescription, models.RateLimit{}); terr != nil {
+			return terr
+		}
+		return tx.Create(project)
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to create project (%s): %+v", name, err)
+	}
+
+	printJSON(project)
+}
+
+func adminProjectList(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	var projects []*models.Project
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		projects, terr = models.FindProjects(tx, nil, nil, "")
+		return terr
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to list projects: %+v", err)
+	}
+
+	printJSON(projects)
+}
+
+func adminProjectDelete(config *conf.GlobalConfiguration, args []string) {
+	db := dialAdmin(config)
+	defer db.Close()
+
+	id := parseUUIDArg("project_id", args[0])
+	output := adminDeleteOutput{ID: id}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		project, terr := models.FindProjectByID(tx, id)
+		if terr != nil {
+			if models.IsNotFoundError(terr) {
+				return nil
+			}
+			return terr
+		}
+
+		userCount, terr := models.CountUsersInProject(tx, project.ID)
+		if terr != nil {
+			return terr
+		}
+		if userCount > 0 && !projectCascade {
+			return fmt.Errorf("project still has %d users, use --cascade to delete them with the project", userCount)
+		}
+
+		if terr := tx.Destroy(project); terr != nil {
+			return terr
+		}
+		output.Deleted = true
+		return nil
+	})
+	if err != nil {
+		logrus.Fatalf("Unable to delete project (%s): %+v", id, err)
+	}
+
+	printJSON(output)
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"embed"
 	"fmt"
+	"io/fs"
 	"net/url"
 	"os"
 
@@ -11,10 +12,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/supabase/auth/internal/storage/liquibase"
 )
 
 var EmbeddedMigrations embed.FS
 
+// EmbeddedChangelogs holds the liquibase_migrations directory with the
+// tenant schema, applied after the migrations.
+var EmbeddedChangelogs embed.FS
+
 var migrateCmd = cobra.Command{
 	Use:  "migrate",
 	Long: "Migrate database strucutures. This will create new tables and add missing columns and indexes.",
@@ -106,6 +112,22 @@ func migrate(cmd *cobra.Command, args []string) {
 		log.WithField("count", count).Infof("GoTrue migrations applied successfully")
 	}
 
+	changelogs, err := fs.Sub(EmbeddedChangelogs, "liquibase_migrations")
+	if err != nil {
+		log.Fatalf("%+v", errors.Wrap(err, "reading liquibase changelogs"))
+	}
+	changeSets, err := liquibase.Load(changelogs)
+	if err != nil {
+		log.Fatalf("%+v", errors.Wrap(err, "reading liquibase changelogs"))
+	}
+
+	count, err = liquibase.Apply(db, changeSets)
+	if err != nil {
+		log.Fatalf("%v", errors.Wrap(err, "running liquibase changelogs"))
+	} else {
+		log.WithField("count", count).Infof("Liquibase changelogs applied successfully")
+	}
+
 	log.Debugf("after status")
 
 	if log.Level == logrus.DebugLevel {
@@ -68,6 +68,19 @@ func (a *API) loadUser(w http.ResponseWriter, r *http.Request) (context.Context,
 		return nil, apierrors.NewInternalServerError("Database error loading user").WithInternalError(err)
 	}
 
+	if scope := getAdminScope(ctx); scope != nil {
+		contains, err := scope.contains(db, u)
+		if err != nil {
+			return nil, apierrors.NewInternalServerError("Database error loading organization membership").WithInternalError(err)
+		}
+		if !contains {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeUserNotFound, "User not found")
+		}
+		if scope.outranks(u) {
+			return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "User not allowed to manage a user with a higher organization role")
+		}
+	}
+
 	return withUser(ctx, u), nil
 }
 
@@ -99,6 +112,10 @@ func (a *API) getAdminParams(r *http.Request) (*AdminUserParams, error) {
 		return nil, err
 	}
 
+	if getAdminScope(r.Context()) != nil && params.Role != "" {
+		return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Organization and project admins cannot set the role of a user")
+	}
+
 	return params, nil
 }
 
@@ -107,7 +124,7 @@ func (a *API) adminUsers(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
 	aud := a.requestAud(ctx, r)
-	organization_id := a.requestOrganizationID(ctx, r)
+	organization_id, project_id := a.adminTenant(ctx, r)
 
 	pageParams, err := paginate(r)
 	if err != nil {
@@ -121,7 +138,7 @@ func (a *API) adminUsers(w http.ResponseWriter, r *http.Request) error {
 
 	filter := r.URL.Query().Get("filter")
 
-	users, err := models.FindUsersInAudience(db, aud, pageParams, sortParams, filter, organization_id, uuid.Nil)
+	users, err := models.FindUsersInAudience(db, aud, pageParams, sortParams, filter, organization_id, project_id)
 	if err != nil {
 		return apierrors.NewInternalServerError("Database error finding users").WithInternalError(err)
 	}
@@ -306,7 +323,7 @@ func (a *API) adminUserUpdate(w http.ResponseWriter, r *http.Request) error {
 			}
 		}
 
-		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.UserModifiedAction, "", map[string]interface{}{
+		if terr := models.NewAuditLogEntryForUser(config.AuditLog, r, tx, adminUser, user, models.UserModifiedAction, "", map[string]interface{}{
 			"user_id":    user.ID,
 			"user_email": user.Email,
 			"user_phone": user.Phone,
@@ -330,8 +347,7 @@ func (a *API) adminUserCreate(w http.ResponseWriter, r *http.Request) error {
 	config := a.config
 
 	adminUser := getAdminUser(ctx)
-	project_id := a.requestProjectID(ctx, r)
-	organization_id := a.requestOrganizationID(ctx, r)
+	organization_id, project_id := a.adminTenant(ctx, r)
 	params, err := a.getAdminParams(r)
 	if err != nil {
 		return err
@@ -462,7 +478,7 @@ func (a *API) adminUserCreate(w http.ResponseWriter, r *http.Request) error {
 
 		user.Identities = identities
 
-		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.UserSignedUpAction, "", map[string]interface{}{
+		if terr := models.NewAuditLogEntryForUser(config.AuditLog, r, tx, adminUser, user, models.UserSignedUpAction, "", map[string]interface{}{
 			"user_id":    user.ID,
 			"user_email": user.Email,
 			"user_phone": user.Phone,
@@ -532,7 +548,7 @@ func (a *API) adminUserDelete(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	err := db.Transaction(func(tx *storage.Connection) error {
-		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.UserDeletedAction, "", map[string]interface{}{
+		if terr := models.NewAuditLogEntryForUser(config.AuditLog, r, tx, adminUser, user, models.UserDeletedAction, "", map[string]interface{}{
 			"user_id":    user.ID,
 			"user_email": user.Email,
 			"user_phone": user.Phone,
@@ -593,6 +609,11 @@ func (a *API) adminUserDeleteFactor(w http.ResponseWriter, r *http.Request) erro
 		if terr := tx.Destroy(factor); terr != nil {
 			return apierrors.NewInternalServerError("Database error deleting factor").WithInternalError(terr)
 		}
+		if !factor.IsRecoveryCodeFactor() {
+			if terr := a.deleteUnusableRecoveryCodes(r, tx, getAdminUser(ctx), user); terr != nil {
+				return terr
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -638,7 +659,7 @@ func (a *API) adminUserUpdateFactor(w http.ResponseWriter, r *http.Request) erro
 			}
 		}
 
-		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.UpdateFactorAction, "", map[string]interface{}{
+		if terr := models.NewAuditLogEntryForUser(config.AuditLog, r, tx, adminUser, user, models.UpdateFactorAction, "", map[string]interface{}{
 			"user_id":     user.ID,
 			"factor_id":   factor.ID,
 			"factor_type": factor.FactorType,
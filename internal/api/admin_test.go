@@ -18,6 +18,7 @@ import (
 	"github.com/supabase/auth/internal/api/apierrors"
 	"github.com/supabase/auth/internal/conf"
 	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
 )
 
 type AdminTestSuite struct {
@@ -48,7 +49,7 @@ func (ts *AdminTestSuite) SetupTest() {
 	ts.Config.External.Email.Enabled = true
 
 	// Initialize the database with project, organization, and admin user
-	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+	ts.ProjectID, ts.OrganizationID, ts.User = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
 
 	claims := &AccessTokenClaims{
 		Role:           "supabase_admin",
@@ -805,6 +806,65 @@ func (ts *AdminTestSuite) TestAdminUserDeleteFactor() {
 
 }
 
+func (ts *AdminTestSuite) TestAdminUserDeleteRecoveryCodes() {
+	u, err := models.NewUser("123456789", "test-recovery@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error making new user")
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_role"), "Error creating user")
+
+	f := models.NewRecoveryCodeFactor(u)
+	require.NoError(ts.T(), ts.API.db.Create(f), "Error saving new recovery code factor")
+	_, err = models.GenerateRecoveryCodes(ts.API.db, f, 3)
+	require.NoError(ts.T(), err)
+
+	for _, expectedCode := range []int{http.StatusOK, http.StatusNotFound} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/admin/users/%s/recovery_codes", u.ID), nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.token))
+
+		ts.API.handler.ServeHTTP(w, req)
+		require.Equal(ts.T(), expectedCode, w.Code, w.Body.String())
+	}
+
+	_, err = models.FindRecoveryCodeFactor(ts.API.db, u)
+	require.EqualError(ts.T(), err, models.FactorNotFoundError{}.Error())
+}
+
+func (ts *AdminTestSuite) TestAdminUserSessions() {
+	u, err := models.NewUser("123456789", "test-sessions@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error making new user")
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_role"), "Error creating user")
+
+	s, err := models.NewSession(u.ID, nil)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(s), "Error saving new session")
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/admin/users/%s/sessions", u.ID), nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.token))
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := struct {
+		Sessions []*SessionResponse `json:"sessions"`
+	}{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Sessions, 1)
+	assert.Equal(ts.T(), s.ID, data.Sessions[0].ID)
+	assert.False(ts.T(), data.Sessions[0].Current)
+
+	for _, expectedCode := range []int{http.StatusOK, http.StatusNotFound} {
+		w = httptest.NewRecorder()
+		req = httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/admin/users/%s/sessions/%s", u.ID, s.ID), nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.token))
+
+		ts.API.handler.ServeHTTP(w, req)
+		require.Equal(ts.T(), expectedCode, w.Code, w.Body.String())
+	}
+
+	_, err = models.FindSessionByID(ts.API.db, s.ID, false)
+	require.True(ts.T(), models.IsNotFoundError(err))
+}
+
 // TestAdminUserGetFactor tests API /admin/user/<user_id>/factors/
 func (ts *AdminTestSuite) TestAdminUserGetFactors() {
 	u, err := models.NewUser("123456789", "test-delete@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
@@ -930,3 +990,185 @@ func (ts *AdminTestSuite) TestAdminUserCreateValidationErrors() {
 
 	}
 }
+
+func (ts *AdminTestSuite) tenantAdminToken(u *models.User) string {
+	claims := &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: u.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   u.OrganizationID.UUID,
+		ProjectID:        u.ProjectID,
+		OrganizationRole: u.OrganizationRole,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating tenant admin jwt")
+	return token
+}
+
+// createOtherOrganization creates a second organization in the same project
+// and returns one of its users.
+func (ts *AdminTestSuite) createOtherOrganization() *models.User {
+	owner, err := models.NewUser("", "other-owner@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error making new user")
+	require.NoError(ts.T(), ts.API.db.Create(owner, "organization_id", "organization_role"), "Error creating user")
+
+	organization, err := models.NewOrganization(ts.ProjectID, owner.ID, "other", "")
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		return tx.Create(organization)
+	}))
+	require.NoError(ts.T(), owner.SetOrganization(ts.API.db, organization.ID, models.OrganizationRoleAdmin))
+
+	return owner
+}
+
+// TestAdminUsersOrganizationAdmin tests that organization admins only manage
+// the users of their organization
+func (ts *AdminTestSuite) TestAdminUsersOrganizationAdmin() {
+	other := ts.createOtherOrganization()
+	token := ts.tenantAdminToken(ts.User)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(ts.T(), "1", w.Header().Get("X-Total-Count"))
+
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/admin/users/%s", other.ID), nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	w = httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+
+	var buffer bytes.Buffer
+	require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+		"email": "member@example.com",
+	}))
+	req = httptest.NewRequest(http.MethodPost, "/admin/users", &buffer)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	w = httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := models.User{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	assert.Equal(ts.T(), ts.OrganizationID, data.OrganizationID.UUID)
+	assert.Equal(ts.T(), ts.ProjectID, data.ProjectID)
+
+	// organization admins cannot change the database role of a user
+	require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+		"role": "service_role",
+	}))
+	req = httptest.NewRequest(http.MethodPut, fmt.Sprintf("/admin/users/%s", data.ID), &buffer)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	w = httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+
+	// organization admins cannot use the other admin routes
+	req = httptest.NewRequest(http.MethodGet, "/admin/organizations", nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	w = httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+}
+
+// TestAdminUsersProjectAdmin tests that project admins manage the users of
+// every organization in their project
+func (ts *AdminTestSuite) TestAdminUsersProjectAdmin() {
+	other := ts.createOtherOrganization()
+
+	u, err := models.NewUser("", "project-admin@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error making new user")
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_id"), "Error creating user")
+	require.NoError(ts.T(), ts.API.db.RawQuery("update auth.users set organization_role = ? where id = ?", models.OrganizationRoleProjectAdmin, u.ID).Exec())
+	u.OrganizationRole = models.OrganizationRoleProjectAdmin
+	token := ts.tenantAdminToken(u)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/admin/users/%s", other.ID), nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	// tokens for another project are rejected
+	u.ProjectID = uuid.Must(uuid.NewV4())
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/admin/users/%s", other.ID), nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.tenantAdminToken(u)))
+	w = httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+}
+
+// TestAdminUsersHigherOrganizationRole tests that organization admins and
+// API keys cannot manage users whose organization role outranks their own
+func (ts *AdminTestSuite) TestAdminUsersHigherOrganizationRole() {
+	projectAdmin, err := models.NewUser("", "project-admin@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error making new user")
+	require.NoError(ts.T(), ts.API.db.Create(projectAdmin, "organization_role"), "Error creating user")
+	require.NoError(ts.T(), ts.API.db.RawQuery("update auth.users set organization_role = ? where id = ?", models.OrganizationRoleProjectAdmin, projectAdmin.ID).Exec())
+
+	var key string
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		organization, terr := models.FindOrganizationByID(tx, ts.OrganizationID)
+		if terr != nil {
+			return terr
+		}
+		var apiKey *models.APIKey
+		if apiKey, key, terr = models.NewAPIKey(organization, "admin-test", ""); terr != nil {
+			return terr
+		}
+		return tx.Create(apiKey)
+	}))
+
+	for _, c := range []struct {
+		desc   string
+		header string
+		value  string
+		target *models.User
+	}{
+		{"organization admin on project admin", "Authorization", fmt.Sprintf("Bearer %s", ts.tenantAdminToken(ts.User)), projectAdmin},
+		{"API key on project admin", "apikey", key, projectAdmin},
+		{"API key on organization admin", "apikey", key, ts.User},
+	} {
+		ts.Run(c.desc, func() {
+			var buffer bytes.Buffer
+			require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+				"password": "new-password",
+			}))
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/admin/users/%s", c.target.ID), &buffer)
+			req.Header.Set(c.header, c.value)
+			w := httptest.NewRecorder()
+			ts.API.handler.ServeHTTP(w, req)
+			require.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+
+			req = httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/admin/users/%s", c.target.ID), nil)
+			req.Header.Set(c.header, c.value)
+			w = httptest.NewRecorder()
+			ts.API.handler.ServeHTTP(w, req)
+			require.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+		})
+	}
+
+	_, err = models.FindUserByID(ts.API.db, projectAdmin.ID)
+	require.NoError(ts.T(), err)
+}
+
+// TestAdminUsersClientForbidden tests that regular organization members
+// cannot use the admin routes
+func (ts *AdminTestSuite) TestAdminUsersClientForbidden() {
+	u, err := models.NewUser("", "client@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error making new user")
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_role"), "Error creating user")
+
+	// a forged organization_role claim is checked against the database
+	u.OrganizationRole = models.OrganizationRoleAdmin
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.tenantAdminToken(u)))
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+}
@@ -12,19 +12,23 @@ import (
 
 func (a *API) SignupAnonymously(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	config := a.config
 	db := a.db.WithContext(ctx)
 	aud := a.requestAud(ctx, r)
 
-	if config.DisableSignup {
-		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeSignupDisabled, "Signups not allowed for this instance")
-	}
-
 	params := &SignupParams{}
 	if err := retrieveRequestParams(r, params); err != nil {
 		return err
 	}
 
+	config, err := a.projectConfig(db, params.OrganizationID, params.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	if config.DisableSignup {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeSignupDisabled, "Signups not allowed for this instance")
+	}
+
 	if params.OrganizationID == uuid.Nil {
 		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Organization ID is required")
 	}
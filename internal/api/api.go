@@ -32,6 +32,7 @@ const (
 )
 
 var bearerRegexp = regexp.MustCompile(`^(?:B|b)earer (\S+$)`)
+var apiKeyRegexp = regexp.MustCompile(`^(?i:ApiKey) (\S+$)`)
 
 // API is the main REST API
 type API struct {
@@ -194,6 +195,8 @@ func NewAPIWithVersion(globalConfig *conf.GlobalConfiguration, db *storage.Conne
 	r.Route("/", func(r *router) {
 
 		r.Use(api.isValidExternalHost)
+		r.Use(api.authenticateAPIKey)
+		r.Use(api.limitProjectTier)
 
 		r.Get("/settings", api.Settings)
 
@@ -213,7 +216,11 @@ func NewAPIWithVersion(globalConfig *conf.GlobalConfiguration, db *storage.Conne
 					return err
 				}
 				if params.Email == "" && params.Phone == "" {
-					if !api.config.External.AnonymousUsers.Enabled {
+					config, err := api.projectConfig(api.db.WithContext(r.Context()), params.OrganizationID, params.ProjectID)
+					if err != nil {
+						return err
+					}
+					if !config.External.AnonymousUsers.Enabled {
 						return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeAnonymousProviderDisabled, "Anonymous sign-ins are disabled")
 					}
 					if _, err := api.limitHandler(limitAnonymousSignIns)(w, r); err != nil {
@@ -265,6 +272,8 @@ func NewAPIWithVersion(globalConfig *conf.GlobalConfiguration, db *storage.Conne
 
 		r.With(api.requireAuthentication).Post("/logout", api.Logout)
 
+		r.With(api.limitHandler(api.limiterOpts.Verify)).Post("/invitations/accept", api.AcceptOrganizationInvitation)
+
 		r.With(api.requireAuthentication).Route("/reauthenticate", func(r *router) {
 			r.Get("/", api.Reauthenticate)
 		})
@@ -273,12 +282,45 @@ func NewAPIWithVersion(globalConfig *conf.GlobalConfiguration, db *storage.Conne
 			r.Get("/", api.UserGet)
 			r.With(api.limitHandler(api.limiterOpts.User)).Put("/", api.UserUpdate)
 
+			r.Route("/organizations", func(r *router) {
+				r.Get("/", api.UserOrganizations)
+				r.With(api.limitHandler(api.limiterOpts.User)).Post("/switch", api.UserSwitchOrganization)
+			})
+
+			r.Get("/tier", api.UserTier)
+
 			r.Route("/identities", func(r *router) {
 				r.Use(api.requireManualLinkingEnabled)
 				r.Get("/authorize", api.LinkIdentity)
 				r.Delete("/{identity_id}", api.DeleteIdentity)
 			})
 
+			r.Route("/sessions", func(r *router) {
+				r.Get("/", api.UserSessions)
+				r.Delete("/{session_id}", api.UserSessionDelete)
+			})
+
+			r.Route("/recovery_codes", func(r *router) {
+				r.Use(api.requireNotAnonymous)
+				r.Get("/", api.UserRecoveryCodes)
+				r.With(api.limitHandler(api.limiterOpts.FactorChallenge)).
+					Post("/", api.UserRegenerateRecoveryCodes)
+			})
+
+			r.Route("/passkeys", func(r *router) {
+				r.Use(api.requirePasskeyEnabled)
+				r.Get("/", api.UserPasskeys)
+				r.With(api.limitHandler(api.limiterOpts.FactorChallenge)).
+					Post("/register/options", api.PasskeyRegisterOptions)
+				r.With(api.limitHandler(api.limiterOpts.FactorVerify)).
+					Post("/register", api.PasskeyRegister)
+				r.Route("/{passkey_id}", func(r *router) {
+					r.Use(api.loadPasskey)
+					r.Put("/", api.PasskeyUpdate)
+					r.Delete("/", api.PasskeyDelete)
+				})
+			})
+
 			// OAuth grant management endpoints (only if OAuth server is enabled)
 			if globalConfig.OAuthServer.Enabled {
 				r.Route("/oauth/grants", func(r *router) {
@@ -287,6 +329,9 @@ func NewAPIWithVersion(globalConfig *conf.GlobalConfiguration, db *storage.Conne
 				})
 			}
 		})
+		r.With(api.requirePasskeyEnabled).With(api.limitHandler(api.limiterOpts.FactorChallenge)).
+			With(api.verifyCaptcha).Post("/passkeys/authenticate/options", api.PasskeyAuthenticateOptions)
+
 		r.With(api.requireAuthentication).Route("/factors", func(r *router) {
 			r.Use(api.requireNotAnonymous)
 			r.Post("/", api.EnrollFactor)
@@ -302,53 +347,78 @@ func NewAPIWithVersion(globalConfig *conf.GlobalConfiguration, db *storage.Conne
 			})
 		})
 
-		/*
-			r.Route("/sso", func(r *router) {
-				r.Use(api.requireSAMLEnabled)
-				r.With(api.limitHandler(api.limiterOpts.SSO)).
-					With(api.verifyCaptcha).Post("/", api.SingleSignOn)
+		r.Route("/sso", func(r *router) {
+			r.Use(api.requireSAMLEnabled)
+			r.With(api.limitHandler(api.limiterOpts.SSO)).
+				With(api.verifyCaptcha).Post("/", api.SingleSignOn)
 
-				r.Route("/saml", func(r *router) {
-					r.Get("/metadata", api.SAMLMetadata)
+			r.Route("/saml", func(r *router) {
+				r.Get("/metadata", api.SAMLMetadata)
 
-					r.With(api.limitHandler(api.limiterOpts.SAMLAssertion)).
-						Route("/acs", func(r *router) {
-							r.Post("/", api.SamlAcs)
-						})
+				r.With(api.limitHandler(api.limiterOpts.SAMLAssertion)).
+					Route("/acs", func(r *router) {
+						r.Post("/", api.SamlAcs)
+					})
 
-				})
-			})*/
+			})
+		})
 
 		r.Route("/", func(r *router) {
 			r.Route("/admin", func(r *router) {
-				r.Use(api.requireAdminCredentials)
-				r.Route("/audit", func(r *router) {
-					r.Get("/", api.adminAuditLog)
-				})
-
 				r.Route("/users", func(r *router) {
-					r.Get("/", api.adminUsers)
-					r.Post("/", api.adminUserCreate)
+					// Organization and project admins can manage the users of their own tenant
+					r.Use(api.requireUserAdminCredentials)
+
+					r.With(api.requirePermission(models.PermissionUsersRead)).Get("/", api.adminUsers)
+					r.With(api.requirePermission(models.PermissionUsersWrite)).Post("/", api.adminUserCreate)
 
 					r.Route("/{user_id}", func(r *router) {
 						r.Use(api.loadUser)
 						r.Route("/factors", func(r *router) {
-							r.Get("/", api.adminUserGetFactors)
+							r.With(api.requirePermission(models.PermissionUsersRead)).Get("/", api.adminUserGetFactors)
 							r.Route("/{factor_id}", func(r *router) {
+								r.Use(api.requirePermission(models.PermissionUsersWrite))
 								r.Use(api.loadFactor)
 								r.Delete("/", api.adminUserDeleteFactor)
 								r.Put("/", api.adminUserUpdateFactor)
 							})
 						})
 
-						r.Get("/", api.adminUserGet)
-						r.Put("/", api.adminUserUpdate)
-						r.Delete("/", api.adminUserDelete)
+						r.With(api.requirePermission(models.PermissionUsersWrite)).
+							Delete("/recovery_codes", api.adminUserDeleteRecoveryCodes)
+
+						r.Route("/sessions", func(r *router) {
+							r.With(api.requirePermission(models.PermissionUsersRead)).Get("/", api.adminUserSessions)
+							r.With(api.requirePermission(models.PermissionUsersWrite)).Delete("/{session_id}", api.adminUserSessionDelete)
+						})
+
+						r.With(api.requirePermission(models.PermissionUsersRead)).Get("/", api.adminUserGet)
+						r.With(api.requirePermission(models.PermissionUsersWrite)).Put("/", api.adminUserUpdate)
+						r.With(api.requirePermission(models.PermissionUsersWrite)).Delete("/", api.adminUserDelete)
+					})
+				})
+
+				r.Route("/invitations", func(r *router) {
+					// Organization admins invite users into their own organization
+					r.Use(api.requireUserAdminCredentials)
+					r.Use(api.requirePermission(models.PermissionInvitationsManage))
+
+					r.Get("/", api.adminOrganizationInvitations)
+					r.Post("/", api.adminOrganizationInvitationCreate)
+
+					r.Route("/{invitation_id}", func(r *router) {
+						r.Use(api.loadOrganizationInvitation)
+
+						r.Post("/resend", api.adminOrganizationInvitationResend)
+						r.Delete("/", api.adminOrganizationInvitationDelete)
 					})
 				})
-				/* r.Post("/generate_link", api.adminGenerateLink)/*
 
-				/*r.Route("/sso", func(r *router) {
+				r.Route("/sso", func(r *router) {
+					// Organization admins manage the SSO providers of their own organization
+					r.Use(api.requireUserAdminCredentials)
+					r.Use(api.requirePermission(models.PermissionSSOManage))
+
 					r.Route("/providers", func(r *router) {
 						r.Get("/", api.adminSSOProvidersList)
 						r.Post("/", api.adminSSOProvidersCreate)
@@ -361,29 +431,181 @@ func NewAPIWithVersion(globalConfig *conf.GlobalConfiguration, db *storage.Conne
 							r.Delete("/", api.adminSSOProvidersDelete)
 						})
 					})
-				}) */
+				})
 
-			})
+				// Organization and project admins generate links for users of their own tenant
+				r.With(api.requireUserAdminCredentials).
+					With(api.requirePermission(models.PermissionUsersWrite)).Post("/generate_link", api.adminGenerateLink)
 
-			// Admin only oauth client management endpoints
-			if globalConfig.OAuthServer.Enabled {
-				r.Route("/oauth", func(r *router) {
-					r.Route("/clients", func(r *router) {
-						// Manual client registration
-						r.Post("/", api.oauthServer.AdminOAuthServerClientRegister)
-
-						r.Get("/", api.oauthServer.OAuthServerClientList)
-
-						r.Route("/{client_id}", func(r *router) {
-							r.Use(api.oauthServer.LoadOAuthServerClient)
-							r.Get("/", api.oauthServer.OAuthServerClientGet)
-							r.Put("/", api.oauthServer.OAuthServerClientUpdate)
-							r.Delete("/", api.oauthServer.OAuthServerClientDelete)
-							r.Post("/regenerate_secret", api.oauthServer.OAuthServerClientRegenerateSecret)
+				r.Route("/api_keys", func(r *router) {
+					// Organization and project admins manage the API keys of their own tenant
+					r.Use(api.requireUserAdminCredentials)
+					r.Use(api.rejectAPIKey)
+					r.Use(api.requirePermission(models.PermissionKeysManage))
+
+					r.Get("/", api.adminAPIKeys)
+					r.Post("/", api.adminAPIKeyCreate)
+
+					r.Route("/{key_id}", func(r *router) {
+						r.Use(api.loadAPIKey)
+
+						r.Post("/rotate", api.adminAPIKeyRotate)
+						r.Delete("/", api.adminAPIKeyDelete)
+					})
+				})
+
+				r.Route("/smtp", func(r *router) {
+					// Organization and project admins manage the SMTP servers of their own tenant
+					r.Use(api.requireUserAdminCredentials)
+					r.Use(api.requirePermission(models.PermissionSMTPManage))
+
+					r.Route("/organizations/{organization_id}", func(r *router) {
+						r.Use(api.loadAdminOrganization)
+
+						r.Get("/", api.adminOrganizationSMTPConfigGet)
+						r.Put("/", api.adminOrganizationSMTPConfigUpdate)
+						r.Delete("/", api.adminOrganizationSMTPConfigDelete)
+					})
+
+					r.Route("/projects/{project_id}", func(r *router) {
+						r.Use(api.loadAdminProject)
+
+						r.Get("/", api.adminProjectSMTPConfigGet)
+						r.Put("/", api.adminProjectSMTPConfigUpdate)
+						r.Delete("/", api.adminProjectSMTPConfigDelete)
+					})
+				})
+
+				r.Route("/mfa", func(r *router) {
+					// Organization and project admins manage the MFA policies of their own tenant
+					r.Use(api.requireUserAdminCredentials)
+					r.Use(api.requirePermission(models.PermissionMFAManage))
+
+					r.Route("/organizations/{organization_id}", func(r *router) {
+						r.Use(api.loadAdminOrganization)
+
+						r.Get("/", api.adminOrganizationMFAPolicyGet)
+						r.Put("/", api.adminOrganizationMFAPolicyUpdate)
+						r.Delete("/", api.adminOrganizationMFAPolicyDelete)
+					})
+				})
+
+				r.Route("/settings", func(r *router) {
+					// Project admins manage the settings of their own project
+					r.Use(api.requireUserAdminCredentials)
+					r.Use(api.requirePermission(models.PermissionSettingsManage))
+
+					r.Route("/projects/{project_id}", func(r *router) {
+						r.Use(api.loadAdminProject)
+
+						r.Get("/", api.adminProjectSettingsGet)
+						r.Put("/", api.adminProjectSettingsUpdate)
+						r.Delete("/", api.adminProjectSettingsDelete)
+					})
+				})
+
+				r.Route("/oidc", func(r *router) {
+					// Project admins register the OIDC providers of their own project
+					r.Use(api.requireUserAdminCredentials)
+					r.Use(api.requirePermission(models.PermissionSSOManage))
+
+					r.Route("/projects/{project_id}/providers", func(r *router) {
+						r.Use(api.loadAdminProject)
+
+						r.Get("/", api.adminCustomOIDCProviders)
+						r.Post("/", api.adminCustomOIDCProviderCreate)
+
+						r.Route("/{provider_id}", func(r *router) {
+							r.Use(api.loadCustomOIDCProvider)
+
+							r.Get("/", api.adminCustomOIDCProviderGet)
+							r.Put("/", api.adminCustomOIDCProviderUpdate)
+							r.Delete("/", api.adminCustomOIDCProviderDelete)
 						})
 					})
 				})
-			}
+
+				r.Route("/audit", func(r *router) {
+					// Organization and project admins read the audit log of their own tenant
+					r.Use(api.requireUserAdminCredentials)
+					r.Use(api.requirePermission(models.PermissionAuditRead))
+
+					r.Get("/", api.adminAuditLog)
+				})
+
+				// Admin only oauth client management endpoints
+				if globalConfig.OAuthServer.Enabled {
+					r.Route("/oauth", func(r *router) {
+						// Organization and project admins manage the clients of their own tenant
+						r.Use(api.requireUserAdminCredentials)
+						r.Use(api.requirePermission(models.PermissionOAuthManage))
+						r.Use(api.loadAdminTenant)
+
+						r.Route("/clients", func(r *router) {
+							// Manual client registration
+							r.Post("/", api.oauthServer.AdminOAuthServerClientRegister)
+
+							r.Get("/", api.oauthServer.OAuthServerClientList)
+
+							r.Route("/{client_id}", func(r *router) {
+								r.Use(api.oauthServer.LoadOAuthServerClient)
+								r.Get("/", api.oauthServer.OAuthServerClientGet)
+								r.Put("/", api.oauthServer.OAuthServerClientUpdate)
+								r.Delete("/", api.oauthServer.OAuthServerClientDelete)
+								r.Post("/regenerate_secret", api.oauthServer.OAuthServerClientRegenerateSecret)
+							})
+						})
+					})
+				}
+
+				r.Group(func(r *router) {
+					r.Use(api.requireAdminCredentials)
+
+					r.Route("/organizations", func(r *router) {
+						r.Get("/", api.adminOrganizations)
+						r.Post("/", api.adminOrganizationCreate)
+
+						r.Route("/{organization_id}", func(r *router) {
+							r.Use(api.loadOrganization)
+
+							r.Get("/", api.adminOrganizationGet)
+							r.Put("/", api.adminOrganizationUpdate)
+							r.Delete("/", api.adminOrganizationDelete)
+
+							r.Route("/tier", func(r *router) {
+								r.Get("/", api.adminOrganizationTierGet)
+								r.Put("/", api.adminOrganizationTierUpdate)
+							})
+
+							r.Route("/members", func(r *router) {
+								r.Get("/", api.adminOrganizationMembers)
+								r.Post("/", api.adminOrganizationMemberAdd)
+								r.With(api.loadOrganizationMember).Delete("/{user_id}", api.adminOrganizationMemberDelete)
+							})
+						})
+					})
+
+					r.Route("/projects", func(r *router) {
+						r.Get("/", api.adminProjects)
+						r.Post("/", api.adminProjectCreate)
+
+						r.Route("/{project_id}", func(r *router) {
+							r.Use(api.loadProject)
+
+							r.Get("/", api.adminProjectGet)
+							r.Put("/", api.adminProjectUpdate)
+							r.Delete("/", api.adminProjectDelete)
+							r.Get("/organizations", api.adminProjectOrganizations)
+
+							r.Route("/tiers", func(r *router) {
+								r.Get("/", api.adminProjectTiers)
+								r.Put("/{tier}", api.adminProjectTierUpdate)
+								r.Delete("/{tier}", api.adminProjectTierDelete)
+							})
+						})
+					})
+				})
+			})
 		})
 
 		// OAuth Dynamic Client Registration endpoint (public, rate limited)
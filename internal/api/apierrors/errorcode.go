@@ -101,4 +101,37 @@ const (
 	ErrorCodeOAuthClientNotFound        ErrorCode = "oauth_client_not_found"
 	ErrorCodeOAuthAuthorizationNotFound ErrorCode = "oauth_authorization_not_found"
 	ErrorCodeOAuthConsentNotFound       ErrorCode = "oauth_consent_not_found"
+	ErrorCodeOAuthClientUserNotAllowed  ErrorCode = "oauth_client_user_not_allowed"
+
+	ErrorCodeOrganizationNotFound           ErrorCode = "organization_not_found"
+	ErrorCodeOrganizationMemberNotFound     ErrorCode = "organization_member_not_found"
+	ErrorCodeOrganizationMemberExists       ErrorCode = "organization_member_exists"
+	ErrorCodeOrganizationInvitationNotFound ErrorCode = "organization_invitation_not_found"
+	ErrorCodeOrganizationInvitationExists   ErrorCode = "organization_invitation_exists"
+	ErrorCodeOrganizationInvitationExpired  ErrorCode = "organization_invitation_expired"
+	ErrorCodeProjectNotFound                ErrorCode = "project_not_found"
+	ErrorCodeProjectExists                  ErrorCode = "project_exists"
+	ErrorCodeProjectHasUsers                ErrorCode = "project_has_users"
+	ErrorCodeProjectTierNotFound            ErrorCode = "project_tier_not_found"
+	ErrorCodeProjectSettingsNotFound        ErrorCode = "project_settings_not_found"
+
+	ErrorCodeAPIKeyNotFound     ErrorCode = "api_key_not_found"
+	ErrorCodeInvalidAPIKey      ErrorCode = "invalid_api_key"
+	ErrorCodeSMTPConfigNotFound ErrorCode = "smtp_config_not_found"
+
+	ErrorCodeInsufficientPermissions ErrorCode = "insufficient_permissions"
+
+	ErrorCodeMFAPolicyNotFound     ErrorCode = "mfa_policy_not_found"
+	ErrorCodeMFAEnrollmentRequired ErrorCode = "mfa_enrollment_required"
+
+	ErrorCodeCustomOIDCProviderNotFound ErrorCode = "custom_oidc_provider_not_found"
+	ErrorCodeCustomOIDCProviderExists   ErrorCode = "custom_oidc_provider_exists"
+
+	ErrorCodePasskeyDisabled         ErrorCode = "passkey_disabled"
+	ErrorCodePasskeyNotFound         ErrorCode = "passkey_not_found"
+	ErrorCodePasskeyChallengeExpired ErrorCode = "passkey_challenge_expired"
+	ErrorCodeTooManyPasskeys         ErrorCode = "too_many_passkeys"
+
+	ErrorCodeMFARecoveryCodesDisabled ErrorCode = "mfa_recovery_codes_not_enabled"
+	ErrorCodeMFARecoveryCodesNotFound ErrorCode = "mfa_recovery_codes_not_found"
 )
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type AdminAPIKeyParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	TierModel      string    `json:"tier_model"`
+	TierTime       string    `json:"tier_time"`
+	TierUsage      string    `json:"tier_usage"`
+}
+
+// hasTiers reports whether the params override any of the key tiers.
+func (p *AdminAPIKeyParams) hasTiers() bool {
+	return p.TierModel != "" || p.TierTime != "" || p.TierUsage != ""
+}
+
+type AdminListAPIKeysResponse struct {
+	APIKeys []*models.APIKey `json:"api_keys"`
+}
+
+// AdminAPIKeyResponse is returned when a key is created or rotated. It is the
+// only time the plain key is ever sent.
+type AdminAPIKeyResponse struct {
+	*models.APIKey
+	Key string `json:"key"`
+}
+
+// rejectAPIKey keeps requests authenticated with an API key from managing
+// API keys.
+func (a *API) rejectAPIKey(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	if getCallerAPIKey(ctx) != nil {
+		return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "API keys cannot manage API keys")
+	}
+	return ctx, nil
+}
+
+// loadAPIKey loads the API key in the key_id URL param and its organization.
+// Keys outside of the admin's tenant are reported as not found.
+func (a *API) loadAPIKey(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	keyID, err := uuid.FromString(chi.URLParam(r, "key_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "key_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "api_key_id", keyID)
+
+	// Keys are read in a transaction so that the row level security policies
+	// apply to the admin's tenant.
+	var apiKey *models.APIKey
+	err = db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		apiKey, terr = models.FindAPIKeyByID(tx, keyID)
+		return terr
+	})
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeAPIKeyNotFound, "API key not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading API key").WithInternalError(err)
+	}
+
+	organization, err := a.adminOrganization(ctx, r, apiKey.OrganizationID)
+	if err != nil {
+		if herr, ok := err.(*HTTPError); ok && herr.HTTPStatus < http.StatusInternalServerError {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeAPIKeyNotFound, "API key not found")
+		}
+		return nil, err
+	}
+
+	ctx = withOrganization(ctx, organization)
+	return withAPIKey(ctx, apiKey), nil
+}
+
+// adminAPIKeys lists the API keys of the admin's tenant, optionally restricted
+// to the organization in the organization_id query param.
+func (a *API) adminAPIKeys(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	organizationID, projectID := a.adminTenant(ctx, r)
+	if qp := r.URL.Query().Get("organization_id"); qp != "" {
+		id, err := uuid.FromString(qp)
+		if err != nil {
+			return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "organization_id must be an UUID")
+		}
+
+		organization, err := a.adminOrganization(ctx, r, id)
+		if err != nil {
+			return err
+		}
+		organizationID, projectID = organization.ID, organization.ProjectID
+	}
+
+	pageParams, err := paginate(r)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Pagination Parameters: %v", err).WithInternalError(err)
+	}
+
+	var apiKeys []*models.APIKey
+	err = db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		apiKeys, terr = models.FindAPIKeys(tx, projectID, organizationID, pageParams)
+		return terr
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error finding API keys").WithInternalError(err)
+	}
+	addPaginationHeaders(w, r, pageParams)
+
+	return sendJSON(w, http.StatusOK, AdminListAPIKeysResponse{
+		APIKeys: apiKeys,
+	})
+}
+
+// adminAPIKeyCreate creates an API key for an organization. The key inherits
+// the client tiers of its organization, or the default tier of its project,
+// only service role tokens may choose other tiers.
+func (a *API) adminAPIKeyCreate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+
+	params := &AdminAPIKeyParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	organization, err := a.adminOrganization(ctx, r, params.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	if params.hasTiers() && getAdminScope(ctx) != nil {
+		return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to choose the tiers of an API key")
+	}
+
+	apiKey, key, err := models.NewAPIKey(organization, params.Name, params.Description)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%v", err)
+	}
+
+	tier, err := models.FindEffectiveTier(db, organization.ID, organization.ProjectID, models.OrganizationRoleAPIKey)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading organization tier").WithInternalError(err)
+	}
+	apiKey.TierModel, apiKey.TierTime, apiKey.TierUsage = tier.TierModel, tier.TierTime, tier.TierUsage
+	if params.TierModel != "" {
+		apiKey.TierModel = params.TierModel
+	}
+	if params.TierTime != "" {
+		apiKey.TierTime = params.TierTime
+	}
+	if params.TierUsage != "" {
+		apiKey.TierUsage = params.TierUsage
+	}
+	if err := apiKey.Validate(); err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%v", err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := tx.Create(apiKey); terr != nil {
+			return apierrors.NewInternalServerError("Database error creating API key").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.APIKeyCreatedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"api_key_id":      apiKey.ID,
+			"name":            apiKey.Name,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusCreated, AdminAPIKeyResponse{
+		APIKey: apiKey,
+		Key:    key,
+	})
+}
+
+// adminAPIKeyRotate replaces the key of an API key. The previous key stops
+// working immediately.
+func (a *API) adminAPIKeyRotate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+	apiKey := getAPIKey(ctx)
+
+	var key string
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		if key, terr = apiKey.Rotate(tx); terr != nil {
+			return apierrors.NewInternalServerError("Database error rotating API key").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.APIKeyRotatedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"api_key_id":      apiKey.ID,
+			"name":            apiKey.Name,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, AdminAPIKeyResponse{
+		APIKey: apiKey,
+		Key:    key,
+	})
+}
+
+// adminAPIKeyDelete revokes an API key.
+func (a *API) adminAPIKeyDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+	apiKey := getAPIKey(ctx)
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.APIKeyRevokedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"api_key_id":      apiKey.ID,
+			"name":            apiKey.Name,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(apiKey); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting API key").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
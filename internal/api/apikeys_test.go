@@ -0,0 +1,246 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type APIKeysTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	admin *models.User
+	token string
+}
+
+func TestAPIKeys(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &APIKeysTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *APIKeysTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ts.admin.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating organization admin jwt")
+	ts.token = token
+}
+
+func (ts *APIKeysTestSuite) makeRequest(method, path string, body map[string]interface{}, headers map[string]string) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *APIKeysTestSuite) adminHeaders() map[string]string {
+	return map[string]string{"Authorization": fmt.Sprintf("Bearer %s", ts.token)}
+}
+
+func (ts *APIKeysTestSuite) createKey(name string) *AdminAPIKeyResponse {
+	w := ts.makeRequest(http.MethodPost, "/admin/api_keys", map[string]interface{}{
+		"name": name,
+	}, ts.adminHeaders())
+	require.Equal(ts.T(), http.StatusCreated, w.Code, w.Body.String())
+
+	data := &AdminAPIKeyResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(data))
+	return data
+}
+
+func (ts *APIKeysTestSuite) TestCreateListRotateRevoke() {
+	created := ts.createKey("backend")
+	assert.Equal(ts.T(), ts.OrganizationID, created.OrganizationID)
+	assert.Equal(ts.T(), ts.ProjectID, created.ProjectID)
+	assert.True(ts.T(), models.IsAPIKey(created.Key))
+
+	var stored *models.APIKey
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		var terr error
+		stored, terr = models.FindAPIKeyByID(tx, created.ID)
+		return terr
+	}))
+	assert.Equal(ts.T(), models.HashAPIKey(created.Key), stored.KeyHash)
+	assert.NotEqual(ts.T(), created.Key, stored.KeyHash)
+
+	w := ts.makeRequest(http.MethodGet, "/admin/api_keys", nil, ts.adminHeaders())
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	assert.NotContains(ts.T(), w.Body.String(), created.Key)
+
+	list := AdminListAPIKeysResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&list))
+	require.Len(ts.T(), list.APIKeys, 1)
+	assert.Equal(ts.T(), created.ID, list.APIKeys[0].ID)
+
+	w = ts.makeRequest(http.MethodPost, fmt.Sprintf("/admin/api_keys/%s/rotate", created.ID), nil, ts.adminHeaders())
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	rotated := &AdminAPIKeyResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(rotated))
+	assert.NotEqual(ts.T(), created.Key, rotated.Key)
+
+	err := withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		_, terr := models.FindAPIKeyByKey(tx, created.Key)
+		return terr
+	})
+	assert.True(ts.T(), models.IsNotFoundError(err))
+
+	w = ts.makeRequest(http.MethodDelete, fmt.Sprintf("/admin/api_keys/%s", created.ID), nil, ts.adminHeaders())
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	err = withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		_, terr := models.FindAPIKeyByID(tx, created.ID)
+		return terr
+	})
+	assert.True(ts.T(), models.IsNotFoundError(err))
+
+	entries, err := models.FindAuditLogEntries(ts.API.db, &models.AuditLogFilter{OrganizationID: ts.OrganizationID}, nil)
+	require.NoError(ts.T(), err)
+
+	actions := []string{}
+	for _, entry := range entries {
+		actions = append(actions, entry.Payload["action"].(string))
+	}
+	assert.Contains(ts.T(), actions, string(models.APIKeyCreatedAction))
+	assert.Contains(ts.T(), actions, string(models.APIKeyRotatedAction))
+	assert.Contains(ts.T(), actions, string(models.APIKeyRevokedAction))
+}
+
+func (ts *APIKeysTestSuite) TestOrganizationAdminCannotChooseTiers() {
+	w := ts.makeRequest(http.MethodPost, "/admin/api_keys", map[string]interface{}{
+		"name":       "backend",
+		"tier_model": "high",
+	}, ts.adminHeaders())
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+}
+
+func (ts *APIKeysTestSuite) TestOtherOrganizationKeyNotFound() {
+	other, err := models.NewOrganization(ts.ProjectID, ts.admin.ID, "other", "")
+	require.NoError(ts.T(), err)
+	apiKey, _, err := models.NewAPIKey(other, "other", "")
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		if terr := tx.Create(other); terr != nil {
+			return terr
+		}
+		return tx.Create(apiKey)
+	}))
+
+	w := ts.makeRequest(http.MethodPost, fmt.Sprintf("/admin/api_keys/%s/rotate", apiKey.ID), nil, ts.adminHeaders())
+	assert.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodGet, "/admin/api_keys", nil, ts.adminHeaders())
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	list := AdminListAPIKeysResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&list))
+	assert.Empty(ts.T(), list.APIKeys)
+}
+
+func (ts *APIKeysTestSuite) TestAuthenticateAPIKey() {
+	created := ts.createKey("backend")
+
+	cases := []struct {
+		desc    string
+		headers map[string]string
+		code    int
+	}{
+		{
+			desc:    "Authorization header",
+			headers: map[string]string{"Authorization": "ApiKey " + created.Key},
+			code:    http.StatusOK,
+		},
+		{
+			desc:    "apikey header",
+			headers: map[string]string{"apikey": created.Key},
+			code:    http.StatusOK,
+		},
+		{
+			desc:    "Unknown key",
+			headers: map[string]string{"apikey": models.APIKeyPrefix + "unknown"},
+			code:    http.StatusUnauthorized,
+		},
+	}
+
+	for _, c := range cases {
+		ts.Run(c.desc, func() {
+			w := ts.makeRequest(http.MethodGet, "/admin/users", nil, c.headers)
+			require.Equal(ts.T(), c.code, w.Code, w.Body.String())
+		})
+	}
+
+	// API keys cannot manage API keys
+	w := ts.makeRequest(http.MethodPost, "/admin/api_keys", map[string]interface{}{
+		"name": "nested",
+	}, map[string]string{"apikey": created.Key})
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+}
+
+func (ts *APIKeysTestSuite) TestAPIKeyTenantContext() {
+	created := ts.createKey("backend")
+
+	req := httptest.NewRequest(http.MethodGet, "/settings", nil)
+	req.Header.Set("apikey", created.Key)
+
+	ctx, err := ts.API.authenticateAPIKey(httptest.NewRecorder(), req)
+	require.NoError(ts.T(), err)
+
+	claims := getClaims(ctx)
+	require.NotNil(ts.T(), claims)
+	assert.Equal(ts.T(), ts.OrganizationID, claims.OrganizationID)
+	assert.Equal(ts.T(), ts.ProjectID, claims.ProjectID)
+	assert.Equal(ts.T(), models.OrganizationRoleAPIKey, claims.OrganizationRole)
+	assert.Equal(ts.T(), created.TierModel, claims.TierModel)
+	assert.Equal(ts.T(), created.TierTime, claims.TierTime)
+	assert.Equal(ts.T(), created.TierUsage, claims.TierUsage)
+
+	// JWTs sent in the apikey header are left to the other authenticators
+	req = httptest.NewRequest(http.MethodGet, "/settings", nil)
+	req.Header.Set("apikey", ts.token)
+
+	ctx, err = ts.API.authenticateAPIKey(httptest.NewRecorder(), req)
+	require.NoError(ts.T(), err)
+	assert.Nil(ts.T(), getCallerAPIKey(ctx))
+}
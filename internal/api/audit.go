@@ -2,8 +2,11 @@ package api
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/supabase/auth/internal/api/apierrors"
 	"github.com/supabase/auth/internal/models"
 )
@@ -14,30 +17,63 @@ var filterColumnMap = map[string][]string{
 	"type":   {"log_type"},
 }
 
+// adminAuditLog lists the audit log. Organization and project admins only
+// see the entries of their own tenant.
 func (a *API) adminAuditLog(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
+	query := r.URL.Query()
 
-	// aud := a.requestAud(ctx, r)
 	pageParams, err := paginate(r)
 	if err != nil {
 		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Pagination Parameters: %v", err)
 	}
 
-	var col []string
-	var qval string
-	q := r.URL.Query().Get("query")
-	if q != "" {
-		var exists bool
+	filter := &models.AuditLogFilter{
+		Action: query.Get("action"),
+	}
+
+	if q := query.Get("query"); q != "" {
 		qparts := strings.SplitN(q, ":", 2)
-		col, exists = filterColumnMap[qparts[0]]
+		col, exists := filterColumnMap[qparts[0]]
 		if !exists || len(qparts) < 2 {
 			return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid query scope: %s", q)
 		}
-		qval = qparts[1]
+		filter.Columns = col
+		filter.Value = qparts[1]
+	}
+
+	if filter.OrganizationID, err = auditLogQueryID(query, "organization_id"); err != nil {
+		return err
+	}
+	if filter.ProjectID, err = auditLogQueryID(query, "project_id"); err != nil {
+		return err
+	}
+	if filter.ActorID, err = auditLogQueryID(query, "actor_id"); err != nil {
+		return err
+	}
+	if filter.From, err = auditLogQueryTime(query, "from"); err != nil {
+		return err
+	}
+	if filter.To, err = auditLogQueryTime(query, "to"); err != nil {
+		return err
+	}
+
+	organizationID, projectID := a.adminTenant(ctx, r)
+	if organizationID != uuid.Nil {
+		if filter.OrganizationID != uuid.Nil && filter.OrganizationID != organizationID {
+			return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to read the audit log of another organization")
+		}
+		filter.OrganizationID = organizationID
+	}
+	if projectID != uuid.Nil {
+		if filter.ProjectID != uuid.Nil && filter.ProjectID != projectID {
+			return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to read the audit log of another project")
+		}
+		filter.ProjectID = projectID
 	}
 
-	logs, err := models.FindAuditLogEntries(db, col, qval, pageParams)
+	logs, err := models.FindAuditLogEntries(db, filter, pageParams)
 	if err != nil {
 		return apierrors.NewInternalServerError("Error searching for audit logs").WithInternalError(err)
 	}
@@ -46,3 +82,29 @@ func (a *API) adminAuditLog(w http.ResponseWriter, r *http.Request) error {
 
 	return sendJSON(w, http.StatusOK, logs)
 }
+
+func auditLogQueryID(query url.Values, key string) (uuid.UUID, error) {
+	qp := query.Get(key)
+	if qp == "" {
+		return uuid.Nil, nil
+	}
+
+	id, err := uuid.FromString(qp)
+	if err != nil {
+		return uuid.Nil, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%s must be an UUID", key)
+	}
+	return id, nil
+}
+
+func auditLogQueryTime(query url.Values, key string) (*time.Time, error) {
+	qp := query.Get(key)
+	if qp == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, qp)
+	if err != nil {
+		return nil, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%s must be an RFC 3339 timestamp", key)
+	}
+	return &t, nil
+}
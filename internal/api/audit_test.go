@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	jwt "github.com/golang-jwt/jwt/v5"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/supabase/auth/internal/conf"
 	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
 )
 
 type AuditTestSuite struct {
@@ -22,6 +24,7 @@ type AuditTestSuite struct {
 	Config *conf.GlobalConfiguration
 
 	token          string
+	admin          *models.User
 	OrganizationID uuid.UUID
 	ProjectID      uuid.UUID
 }
@@ -41,7 +44,7 @@ func TestAudit(t *testing.T) {
 
 func (ts *AuditTestSuite) SetupTest() {
 	// Initialize the database with project, organization, and admin user
-	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
 
 	ts.token = ts.makeSuperAdmin("")
 
@@ -129,6 +132,82 @@ func (ts *AuditTestSuite) TestAuditFilters() {
 	}
 }
 
+func (ts *AuditTestSuite) TestAuditTenantFilters() {
+	ts.prepareDeleteEvent()
+
+	before := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	queries := map[string]int{
+		"/admin/audit?action=user_deleted":                           1,
+		"/admin/audit?action=login":                                  0,
+		"/admin/audit?from=" + before:                                1,
+		"/admin/audit?to=" + before:                                  0,
+		"/admin/audit?organization_id=" + ts.OrganizationID.String(): 1,
+		"/admin/audit?actor_id=" + uuid.Nil.String() + "&project_id=" + ts.ProjectID.String(): 1,
+	}
+
+	for q, count := range queries {
+		logs := ts.getAuditLog(q, ts.token, http.StatusOK)
+		require.Len(ts.T(), logs, count, q)
+		for _, l := range logs {
+			assert.Equal(ts.T(), ts.OrganizationID, l.OrganizationID.UUID)
+			assert.Equal(ts.T(), ts.ProjectID, l.ProjectID.UUID)
+		}
+	}
+
+	ts.getAuditLog("/admin/audit?from=yesterday", ts.token, http.StatusBadRequest)
+}
+
+func (ts *AuditTestSuite) TestAuditOrganizationAdminScope() {
+	ts.prepareDeleteEvent()
+
+	owner, err := models.NewUser("", "owner@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(owner, "organization_id", "organization_role"))
+
+	other, err := models.NewOrganization(ts.ProjectID, owner.ID, "Other", "")
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		return tx.Create(other)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/organizations", nil)
+	require.NoError(ts.T(), models.NewAuditLogEntry(ts.Config.AuditLog, req, ts.API.db, owner, models.OrganizationModifiedAction, "", map[string]interface{}{
+		"organization_id": other.ID,
+	}))
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ts.admin.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err)
+
+	logs := ts.getAuditLog("/admin/audit", token, http.StatusOK)
+	require.Len(ts.T(), logs, 1)
+	assert.Equal(ts.T(), "user_deleted", logs[0].Payload["action"])
+
+	ts.getAuditLog("/admin/audit?organization_id="+other.ID.String(), token, http.StatusForbidden)
+}
+
+func (ts *AuditTestSuite) getAuditLog(path, token string, status int) []models.AuditLogEntry {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	ts.API.handler.ServeHTTP(w, req)
+	require.Equal(ts.T(), status, w.Code, w.Body.String())
+
+	logs := []models.AuditLogEntry{}
+	if status == http.StatusOK {
+		require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&logs))
+	}
+	return logs
+}
+
 func (ts *AuditTestSuite) prepareDeleteEvent() {
 	// DELETE USER
 	u, err := models.NewUser("12345678", "test-delete@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
 	jwt "github.com/golang-jwt/jwt/v5"
@@ -13,6 +15,7 @@ import (
 	"github.com/supabase/auth/internal/api/shared"
 	"github.com/supabase/auth/internal/conf"
 	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
 	"github.com/supabase/auth/internal/storage"
 )
 
@@ -32,7 +35,54 @@ func (a *API) requireAuthentication(w http.ResponseWriter, r *http.Request) (con
 	if err != nil {
 		return ctx, err
 	}
-	return ctx, err
+	ctx = a.withClaimsSessionVariables(ctx)
+
+	if err := a.enforceMFAPolicy(ctx, r); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// mfaFactorRequestRegexp matches the paths that challenge or verify a factor.
+var mfaFactorRequestRegexp = regexp.MustCompile(`^/factors/[^/]+/(challenge|verify)$`)
+
+// isMFAEnrollmentRequest reports whether the request is one a member who has
+// to enroll a factor can still make: reading the user, enrolling,
+// challenging and verifying a factor, and signing out.
+func isMFAEnrollmentRequest(r *http.Request) bool {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodGet:
+		return path == "/user"
+	case http.MethodPost:
+		return path == "/logout" || path == "/factors" || mfaFactorRequestRegexp.MatchString(path)
+	}
+	return false
+}
+
+// enforceMFAPolicy limits the sessions of members whose grace period to
+// enroll a factor required by the MFA policy of their organization is over
+// to the requests that enroll one.
+func (a *API) enforceMFAPolicy(ctx context.Context, r *http.Request) error {
+	claims := getClaims(ctx)
+	user := getUser(ctx)
+	if user == nil || claims.OrganizationID == uuid.Nil || isMFAEnrollmentRequest(r) {
+		return nil
+	}
+
+	db := a.db.WithContext(ctx)
+	policy, err := models.FindOrganizationMFAPolicy(db, claims.OrganizationID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil
+		}
+		return apierrors.NewInternalServerError("Database error loading MFA policy").WithInternalError(err)
+	}
+
+	if policy.EnrollmentRequired(user, time.Now()) {
+		return apierrors.NewForbiddenError(apierrors.ErrorCodeMFAEnrollmentRequired, "The organization requires enrolling one of these MFA factors: %s", strings.Join(policy.GetRequiredFactorTypes(), ", "))
+	}
+	return nil
 }
 
 func (a *API) requireNotAnonymous(w http.ResponseWriter, r *http.Request) (context.Context, error) {
@@ -55,12 +105,89 @@ func (a *API) requireAdmin(ctx context.Context) (context.Context, error) {
 
 	if slices.Contains(adminRoles, claims.Role) {
 		// successful authentication
+		ctx = storage.WithSessionVariables(ctx, storage.BypassRLS)
 		return withAdminUser(ctx, &models.User{Role: claims.Role, Email: storage.NullString(claims.Role)}), nil
 	}
 
 	return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "User not allowed").WithInternalMessage("this token needs to have one of the following roles: %v", strings.Join(adminRoles, ", "))
 }
 
+// extractAPIKey returns the API key sent as "Authorization: ApiKey <key>" or
+// in the apikey header. Values of the apikey header that are not API keys,
+// such as the anon JWT sent by client libraries, are ignored.
+func extractAPIKey(r *http.Request) string {
+	if matches := apiKeyRegexp.FindStringSubmatch(r.Header.Get("Authorization")); len(matches) == 2 {
+		return matches[1]
+	}
+	if key := r.Header.Get("apikey"); models.IsAPIKey(key) {
+		return key
+	}
+	return ""
+}
+
+// authenticateAPIKey authenticates requests that present an API key. The key
+// is turned into claims with its organization, project and tiers, so that the
+// rest of the request sees the same tenant and tier context as with a user
+// JWT. Requests without an API key are passed on unchanged.
+func (a *API) authenticateAPIKey(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+
+	key := extractAPIKey(r)
+	if key == "" {
+		return ctx, nil
+	}
+
+	// The tenant of the caller is only known once the key is found, so the
+	// lookup itself bypasses the row level security policies.
+	db := a.db.WithContext(storage.WithSessionVariables(ctx, storage.BypassRLS))
+
+	var apiKey *models.APIKey
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		apiKey, terr = models.FindAPIKeyByKey(tx, key)
+		return terr
+	})
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewHTTPError(http.StatusUnauthorized, apierrors.ErrorCodeInvalidAPIKey, "Invalid API key")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading API key").WithInternalError(err)
+	}
+
+	observability.LogEntrySetField(r, "api_key_id", apiKey.ID)
+
+	permissions, err := models.FindPermissionsByOrganizationRole(db, models.OrganizationRoleAPIKey)
+	if err != nil {
+		return nil, apierrors.NewInternalServerError("Database error loading permissions").WithInternalError(err)
+	}
+
+	token := &jwt.Token{
+		Valid: true,
+		Claims: &AccessTokenClaims{
+			Role:             "authenticated",
+			OrganizationID:   apiKey.OrganizationID,
+			ProjectID:        apiKey.ProjectID,
+			OrganizationRole: models.OrganizationRoleAPIKey,
+			TierModel:        apiKey.TierModel,
+			TierTime:         apiKey.TierTime,
+			TierUsage:        apiKey.TierUsage,
+			Permissions:      permissions,
+		},
+	}
+
+	ctx = withToken(ctx, token)
+	ctx = withCallerAPIKey(ctx, apiKey)
+	ctx = storage.WithSessionVariables(ctx, &storage.SessionVariables{
+		OrganizationID:   apiKey.OrganizationID,
+		ProjectID:        apiKey.ProjectID,
+		OrganizationRole: models.OrganizationRoleAPIKey,
+	})
+	return shared.WithTenant(ctx, &shared.Tenant{
+		OrganizationID: apiKey.OrganizationID,
+		ProjectID:      apiKey.ProjectID,
+	}), nil
+}
+
 func (a *API) extractBearerToken(r *http.Request) (string, error) {
 	authHeader := r.Header.Get("Authorization")
 	matches := bearerRegexp.FindStringSubmatch(authHeader)
@@ -75,6 +202,11 @@ func (a *API) parseJWTClaims(bearer string, r *http.Request) (context.Context, e
 	ctx := r.Context()
 	config := a.config
 
+	// The token was already verified for the tier rate limit
+	if token := getParsedToken(ctx); token != nil && token.Raw == bearer {
+		return withToken(ctx, token), nil
+	}
+
 	p := jwt.NewParser(jwt.WithValidMethods(config.JWT.ValidMethods))
 	token, err := p.ParseWithClaims(bearer, &AccessTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if kid, ok := token.Header["kid"]; ok {
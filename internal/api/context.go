@@ -20,23 +20,34 @@ func (c contextKey) String() string {
 const (
 	externalProviderTypeKey          = contextKey("external_provider_type")
 	externalProviderEmailOptionalKey = contextKey("external_provider_allow_no_email")
-
-	tokenKey            = contextKey("jwt")
-	inviteTokenKey      = contextKey("invite_token")
-	signatureKey        = contextKey("signature")
-	targetUserKey       = contextKey("target_user")
-	factorKey           = contextKey("factor")
-	sessionKey          = contextKey("session")
-	externalReferrerKey = contextKey("external_referrer")
-	functionHooksKey    = contextKey("function_hooks")
-	adminUserKey        = contextKey("admin_user")
-	oauthTokenKey       = contextKey("oauth_token") // for OAuth1.0, also known as request token
-	oauthVerifierKey    = contextKey("oauth_verifier")
-	ssoProviderKey      = contextKey("sso_provider")
-	externalHostKey     = contextKey("external_host")
-	flowStateKey        = contextKey("flow_state_id")
-	organizationID      = contextKey("organization_id")
-	projectID           = contextKey("project_id")
+	externalProviderProjectIDKey     = contextKey("external_provider_project_id")
+
+	tokenKey                  = contextKey("jwt")
+	parsedTokenKey            = contextKey("parsed_jwt")
+	inviteTokenKey            = contextKey("invite_token")
+	signatureKey              = contextKey("signature")
+	targetUserKey             = contextKey("target_user")
+	factorKey                 = contextKey("factor")
+	sessionKey                = contextKey("session")
+	externalReferrerKey       = contextKey("external_referrer")
+	functionHooksKey          = contextKey("function_hooks")
+	adminUserKey              = contextKey("admin_user")
+	oauthTokenKey             = contextKey("oauth_token") // for OAuth1.0, also known as request token
+	oauthVerifierKey          = contextKey("oauth_verifier")
+	ssoProviderKey            = contextKey("sso_provider")
+	externalHostKey           = contextKey("external_host")
+	flowStateKey              = contextKey("flow_state_id")
+	organizationID            = contextKey("organization_id")
+	projectID                 = contextKey("project_id")
+	organizationKey           = contextKey("organization")
+	projectKey                = contextKey("project")
+	adminScopeKey             = contextKey("admin_scope")
+	organizationMemberKey     = contextKey("organization_member")
+	organizationInvitationKey = contextKey("organization_invitation")
+	apiKeyKey                 = contextKey("api_key")
+	callerAPIKeyKey           = contextKey("caller_api_key")
+	customOIDCProviderKey     = contextKey("custom_oidc_provider")
+	passkeyKey                = contextKey("passkey")
 )
 
 // withToken adds the JWT token to the context.
@@ -54,6 +65,23 @@ func getToken(ctx context.Context) *jwt.Token {
 	return obj.(*jwt.Token)
 }
 
+// withParsedToken adds a JWT token that was verified before the
+// authentication of the request, such as by the tier rate limit.
+func withParsedToken(ctx context.Context, token *jwt.Token) context.Context {
+	return context.WithValue(ctx, parsedTokenKey, token)
+}
+
+// getParsedToken reads the JWT token verified before the authentication of
+// the request.
+func getParsedToken(ctx context.Context) *jwt.Token {
+	obj := ctx.Value(parsedTokenKey)
+	if obj == nil {
+		return nil
+	}
+
+	return obj.(*jwt.Token)
+}
+
 func getClaims(ctx context.Context) *AccessTokenClaims {
 	token := getToken(ctx)
 	if token == nil {
@@ -164,6 +192,92 @@ func getProjectID(ctx context.Context) uuid.UUID {
 	return obj.(uuid.UUID)
 }
 
+// withOrganization adds the organization to the context.
+func withOrganization(ctx context.Context, o *models.Organization) context.Context {
+	return context.WithValue(ctx, organizationKey, o)
+}
+
+// getOrganization reads the organization from the context.
+func getOrganization(ctx context.Context) *models.Organization {
+	obj := ctx.Value(organizationKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*models.Organization)
+}
+
+// withOrganizationMember adds the organization member to the context.
+func withOrganizationMember(ctx context.Context, m *models.OrganizationMember) context.Context {
+	return context.WithValue(ctx, organizationMemberKey, m)
+}
+
+// getOrganizationMember reads the organization member from the context.
+func getOrganizationMember(ctx context.Context) *models.OrganizationMember {
+	obj := ctx.Value(organizationMemberKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*models.OrganizationMember)
+}
+
+// withOrganizationInvitation adds the organization invitation to the context.
+func withOrganizationInvitation(ctx context.Context, i *models.OrganizationInvitation) context.Context {
+	return context.WithValue(ctx, organizationInvitationKey, i)
+}
+
+// getOrganizationInvitation reads the organization invitation from the context.
+func getOrganizationInvitation(ctx context.Context) *models.OrganizationInvitation {
+	obj := ctx.Value(organizationInvitationKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*models.OrganizationInvitation)
+}
+
+// withAPIKey adds the API key to the context.
+func withAPIKey(ctx context.Context, k *models.APIKey) context.Context {
+	return context.WithValue(ctx, apiKeyKey, k)
+}
+
+// getAPIKey reads the API key from the context.
+func getAPIKey(ctx context.Context) *models.APIKey {
+	obj := ctx.Value(apiKeyKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*models.APIKey)
+}
+
+// withCallerAPIKey adds the API key that authenticated the request to the
+// context.
+func withCallerAPIKey(ctx context.Context, k *models.APIKey) context.Context {
+	return context.WithValue(ctx, callerAPIKeyKey, k)
+}
+
+// getCallerAPIKey reads the API key that authenticated the request from the
+// context.
+func getCallerAPIKey(ctx context.Context) *models.APIKey {
+	obj := ctx.Value(callerAPIKeyKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*models.APIKey)
+}
+
+// withProject adds the project to the context.
+func withProject(ctx context.Context, p *models.Project) context.Context {
+	return context.WithValue(ctx, projectKey, p)
+}
+
+// getProject reads the project from the context.
+func getProject(ctx context.Context) *models.Project {
+	obj := ctx.Value(projectKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*models.Project)
+}
+
 func getInviteToken(ctx context.Context) string {
 	obj := ctx.Value(inviteTokenKey)
 	if obj == nil {
@@ -178,6 +292,22 @@ func withExternalProviderType(ctx context.Context, id string, emailOptional bool
 	return context.WithValue(context.WithValue(ctx, externalProviderTypeKey, id), externalProviderEmailOptionalKey, emailOptional)
 }
 
+// withExternalProviderProjectID adds the project whose custom OIDC providers
+// are looked up to the context.
+func withExternalProviderProjectID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, externalProviderProjectIDKey, id)
+}
+
+// getExternalProviderProjectID reads the project whose custom OIDC providers
+// are looked up from the context.
+func getExternalProviderProjectID(ctx context.Context) uuid.UUID {
+	obj := ctx.Value(externalProviderProjectIDKey)
+	if obj == nil {
+		return uuid.Nil
+	}
+	return obj.(uuid.UUID)
+}
+
 // getExternalProviderType returns the provider type and whether user data without email address should be allowed.
 func getExternalProviderType(ctx context.Context) (string, bool) {
 	idValue := ctx.Value(externalProviderTypeKey)
@@ -223,6 +353,22 @@ func getAdminUser(ctx context.Context) *models.User {
 	return obj.(*models.User)
 }
 
+// withAdminScope adds the tenant that an organization or project admin is
+// allowed to manage to the context.
+func withAdminScope(ctx context.Context, scope *adminScope) context.Context {
+	return context.WithValue(ctx, adminScopeKey, scope)
+}
+
+// getAdminScope reads the admin scope from the context. It is nil for
+// service role tokens, which are not limited to a tenant.
+func getAdminScope(ctx context.Context) *adminScope {
+	obj := ctx.Value(adminScopeKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*adminScope)
+}
+
 // withRequestToken adds the request token to the context
 func withRequestToken(ctx context.Context, token string) context.Context {
 	return context.WithValue(ctx, oauthTokenKey, token)
@@ -273,3 +419,31 @@ func getExternalHost(ctx context.Context) *url.URL {
 	}
 	return obj.(*url.URL)
 }
+
+// withCustomOIDCProvider adds the custom OIDC provider to the context.
+func withCustomOIDCProvider(ctx context.Context, p *models.CustomOIDCProvider) context.Context {
+	return context.WithValue(ctx, customOIDCProviderKey, p)
+}
+
+// getCustomOIDCProvider reads the custom OIDC provider from the context.
+func getCustomOIDCProvider(ctx context.Context) *models.CustomOIDCProvider {
+	obj := ctx.Value(customOIDCProviderKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*models.CustomOIDCProvider)
+}
+
+// withPasskey adds the passkey to the context.
+func withPasskey(ctx context.Context, p *models.Passkey) context.Context {
+	return context.WithValue(ctx, passkeyKey, p)
+}
+
+// getPasskey reads the passkey from the context.
+func getPasskey(ctx context.Context) *models.Passkey {
+	obj := ctx.Value(passkeyKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*models.Passkey)
+}
@@ -0,0 +1,277 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/api/provider"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// AdminCustomOIDCProviderParams configure a custom OIDC provider of a
+// project. The client secret is kept when omitted on update.
+type AdminCustomOIDCProviderParams struct {
+	Slug          string            `json:"slug"`
+	Issuer        string            `json:"issuer"`
+	DiscoveryURL  string            `json:"discovery_url"`
+	ClientID      string            `json:"client_id"`
+	ClientSecret  string            `json:"client_secret"`
+	Scopes        []string          `json:"scopes"`
+	ClaimMappings map[string]string `json:"claim_mappings"`
+	Enabled       *bool             `json:"enabled"`
+}
+
+// customOIDCProvider returns the custom OIDC provider named custom:<slug>
+// of the project in the context.
+func (a *API) customOIDCProvider(ctx context.Context, name string, scopes string) (provider.Provider, conf.OAuthProviderConfiguration, error) {
+	db := a.db.WithContext(ctx)
+	slug := strings.TrimPrefix(name, provider.CustomOIDCPrefix)
+
+	var pConfig conf.OAuthProviderConfiguration
+	projectID := getExternalProviderProjectID(ctx)
+	if projectID == uuid.Nil {
+		return nil, pConfig, fmt.Errorf("Provider %s could not be found", name)
+	}
+
+	p, err := models.FindCustomOIDCProviderBySlug(db, projectID, slug)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, pConfig, fmt.Errorf("Provider %s could not be found", name)
+		}
+		return nil, pConfig, err
+	}
+
+	secret, err := p.GetClientSecret(a.config.Security.DBEncryption)
+	if err != nil {
+		return nil, pConfig, err
+	}
+
+	pConfig = conf.OAuthProviderConfiguration{
+		ClientID:    []string{p.ClientID},
+		Secret:      secret,
+		RedirectURI: strings.TrimSuffix(a.config.API.ExternalURL, "/") + "/callback",
+		URL:         p.Issuer,
+		Enabled:     p.Enabled,
+	}
+	oidcProvider, err := provider.NewCustomOIDCProvider(ctx, provider.CustomOIDCConfiguration{
+		OAuthProviderConfiguration: pConfig,
+		DiscoveryURL:               p.DiscoveryURL.String(),
+		Scopes:                     p.GetScopes(),
+		ClaimMappings:              p.GetClaimMappings(),
+		AllowPrivateNetworks:       a.config.External.CustomOIDCAllowPrivateNetworks,
+	}, scopes)
+	return oidcProvider, pConfig, err
+}
+
+func (a *API) loadCustomOIDCProvider(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	project := getProject(ctx)
+
+	providerID, err := uuid.FromString(chi.URLParam(r, "provider_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "provider_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "provider_id", providerID)
+
+	p, err := models.FindCustomOIDCProviderByID(db, project.ID, providerID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeCustomOIDCProviderNotFound, "Custom OIDC provider not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading custom OIDC provider").WithInternalError(err)
+	}
+
+	return withCustomOIDCProvider(ctx, p), nil
+}
+
+// apply sets the parameters on the provider and validates it.
+func (params *AdminCustomOIDCProviderParams) apply(p *models.CustomOIDCProvider, dbEncryption conf.DatabaseEncryptionConfiguration) error {
+	p.Slug = params.Slug
+	p.Issuer = params.Issuer
+	p.DiscoveryURL = storage.NullString(params.DiscoveryURL)
+	p.ClientID = params.ClientID
+	p.SetScopes(params.Scopes)
+	p.ClaimMappings = models.JSONMap{}
+	for claim, source := range params.ClaimMappings {
+		p.ClaimMappings[claim] = source
+	}
+	if params.Enabled != nil {
+		p.Enabled = *params.Enabled
+	}
+	if params.ClientSecret != "" {
+		p.ClientSecret = params.ClientSecret
+	}
+
+	if err := p.Validate(); err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%v", err)
+	}
+	if params.ClientSecret != "" {
+		if err := p.SetClientSecret(params.ClientSecret, dbEncryption); err != nil {
+			return apierrors.NewInternalServerError("Error encrypting client secret").WithInternalError(err)
+		}
+	}
+	return nil
+}
+
+// checkCustomOIDCProviderSlug fails when another provider of the project
+// uses the slug.
+func checkCustomOIDCProviderSlug(tx *storage.Connection, p *models.CustomOIDCProvider) error {
+	existing, err := models.FindCustomOIDCProviderBySlug(tx, p.ProjectID, p.Slug)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil
+		}
+		return apierrors.NewInternalServerError("Database error loading custom OIDC provider").WithInternalError(err)
+	}
+	if existing.ID != p.ID {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeCustomOIDCProviderExists, "A custom OIDC provider with this slug already exists")
+	}
+	return nil
+}
+
+// adminCustomOIDCProviders lists the custom OIDC providers of a project.
+func (a *API) adminCustomOIDCProviders(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	project := getProject(ctx)
+
+	providers, err := models.FindCustomOIDCProviders(db, project.ID)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading custom OIDC providers").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{
+		"providers": providers,
+	})
+}
+
+// adminCustomOIDCProviderCreate registers a custom OIDC provider for a
+// project.
+func (a *API) adminCustomOIDCProviderCreate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+
+	params := &AdminCustomOIDCProviderParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	p := models.NewCustomOIDCProvider(project.ID, params.Slug)
+	if err := params.apply(p, a.config.Security.DBEncryption); err != nil {
+		return err
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := checkCustomOIDCProviderSlug(tx, p); terr != nil {
+			return terr
+		}
+
+		if terr := tx.Create(p); terr != nil {
+			return apierrors.NewInternalServerError("Database error creating custom OIDC provider").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.CustomOIDCProviderCreatedAction, "", map[string]interface{}{
+			"project_id":  project.ID,
+			"provider_id": p.ID,
+			"provider":    p.Name(),
+			"issuer":      p.Issuer,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusCreated, p)
+}
+
+// adminCustomOIDCProviderGet responds with a custom OIDC provider.
+func (a *API) adminCustomOIDCProviderGet(w http.ResponseWriter, r *http.Request) error {
+	return sendJSON(w, http.StatusOK, getCustomOIDCProvider(r.Context()))
+}
+
+// adminCustomOIDCProviderUpdate replaces the configuration of a custom OIDC
+// provider.
+func (a *API) adminCustomOIDCProviderUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	p := getCustomOIDCProvider(ctx)
+
+	params := &AdminCustomOIDCProviderParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	if err := params.apply(p, a.config.Security.DBEncryption); err != nil {
+		return err
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := checkCustomOIDCProviderSlug(tx, p); terr != nil {
+			return terr
+		}
+
+		if terr := tx.Update(p); terr != nil {
+			return apierrors.NewInternalServerError("Database error updating custom OIDC provider").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.CustomOIDCProviderModifiedAction, "", map[string]interface{}{
+			"project_id":  p.ProjectID,
+			"provider_id": p.ID,
+			"provider":    p.Name(),
+			"issuer":      p.Issuer,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, p)
+}
+
+// adminCustomOIDCProviderDelete removes a custom OIDC provider. Identities
+// created through it are kept.
+func (a *API) adminCustomOIDCProviderDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	p := getCustomOIDCProvider(ctx)
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.CustomOIDCProviderDeletedAction, "", map[string]interface{}{
+			"project_id":  p.ProjectID,
+			"provider_id": p.ID,
+			"provider":    p.Name(),
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(p); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting custom OIDC provider").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
@@ -0,0 +1,211 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+)
+
+type CustomOIDCProvidersTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	admin *models.User
+	token string
+}
+
+func TestCustomOIDCProviders(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &CustomOIDCProvidersTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *CustomOIDCProvidersTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		Role:           "supabase_admin",
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating admin jwt")
+	ts.token = token
+}
+
+func (ts *CustomOIDCProvidersTestSuite) makeRequest(method, path, token string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *CustomOIDCProvidersTestSuite) TestCustomOIDCProviders() {
+	path := fmt.Sprintf("/admin/oidc/projects/%s/providers", ts.ProjectID)
+
+	w := ts.makeRequest(http.MethodPost, path, ts.token, map[string]interface{}{
+		"slug":           "okta",
+		"issuer":         "https://example.okta.com",
+		"client_id":      "client",
+		"client_secret":  "secret",
+		"scopes":         []string{"email", "profile"},
+		"claim_mappings": map[string]string{"email": "upn"},
+	})
+	require.Equal(ts.T(), http.StatusCreated, w.Code, w.Body.String())
+
+	data := map[string]interface{}{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	assert.Equal(ts.T(), "custom:okta", data["name"])
+	assert.Equal(ts.T(), []interface{}{"email", "profile"}, data["scopes"])
+	assert.Equal(ts.T(), true, data["enabled"])
+	assert.NotContains(ts.T(), data, "client_secret")
+
+	providerPath := fmt.Sprintf("%s/%s", path, data["id"])
+
+	// Slugs are unique per project
+	w = ts.makeRequest(http.MethodPost, path, ts.token, map[string]interface{}{
+		"slug":          "okta",
+		"issuer":        "https://other.okta.com",
+		"client_id":     "client",
+		"client_secret": "secret",
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code, w.Body.String())
+
+	// The client secret is kept when omitted
+	w = ts.makeRequest(http.MethodPut, providerPath, ts.token, map[string]interface{}{
+		"slug":      "okta",
+		"issuer":    "https://example.okta.com",
+		"client_id": "client",
+		"enabled":   false,
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	p, err := models.FindCustomOIDCProviderBySlug(ts.API.db, ts.ProjectID, "okta")
+	require.NoError(ts.T(), err)
+	assert.False(ts.T(), p.Enabled)
+	assert.Empty(ts.T(), p.GetScopes())
+	secret, err := p.GetClientSecret(ts.Config.Security.DBEncryption)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), "secret", secret)
+
+	w = ts.makeRequest(http.MethodGet, path, ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), "custom:okta")
+
+	w = ts.makeRequest(http.MethodDelete, providerPath, ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodGet, providerPath, ts.token, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodeCustomOIDCProviderNotFound)
+}
+
+func (ts *CustomOIDCProvidersTestSuite) TestInvalidCustomOIDCProvider() {
+	path := fmt.Sprintf("/admin/oidc/projects/%s/providers", ts.ProjectID)
+
+	for _, body := range []map[string]interface{}{
+		{"slug": "Okta", "issuer": "https://example.okta.com", "client_id": "client", "client_secret": "secret"},
+		{"slug": "okta", "issuer": "http://example.okta.com", "client_id": "client", "client_secret": "secret"},
+		{"slug": "okta", "issuer": "https://example.okta.com", "discovery_url": "ftp://example.okta.com", "client_id": "client", "client_secret": "secret"},
+		{"slug": "okta", "issuer": "https://example.okta.com", "client_secret": "secret"},
+		{"slug": "okta", "issuer": "https://example.okta.com", "client_id": "client"},
+		{"slug": "okta", "issuer": "https://example.okta.com", "client_id": "client", "client_secret": "secret", "claim_mappings": map[string]string{"email": ""}},
+	} {
+		w := ts.makeRequest(http.MethodPost, path, ts.token, body)
+		assert.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+	}
+}
+
+func (ts *CustomOIDCProvidersTestSuite) TestOrganizationAdminScope() {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ts.admin.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating organization admin jwt")
+
+	w := ts.makeRequest(http.MethodGet, fmt.Sprintf("/admin/oidc/projects/%s/providers", ts.ProjectID), token, nil)
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+}
+
+func (ts *CustomOIDCProvidersTestSuite) TestAuthorizeCustomOIDCProvider() {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(ts.T(), json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/keys",
+		}))
+	}))
+	defer server.Close()
+
+	// The test server listens on a loopback address
+	ts.Config.External.CustomOIDCAllowPrivateNetworks = true
+	defer func() {
+		ts.Config.External.CustomOIDCAllowPrivateNetworks = false
+	}()
+
+	// Saved directly as the admin endpoints only accept https issuers
+	p := models.NewCustomOIDCProvider(ts.ProjectID, "acme")
+	p.Issuer = server.URL
+	p.ClientID = "client"
+	p.SetScopes([]string{"email"})
+	require.NoError(ts.T(), p.SetClientSecret("secret", ts.Config.Security.DBEncryption))
+	require.NoError(ts.T(), ts.API.db.Create(p))
+
+	w := ts.makeRequest(http.MethodGet, fmt.Sprintf("/authorize?provider=custom:acme&organization_id=%s", ts.OrganizationID), "", nil)
+	require.Equal(ts.T(), http.StatusFound, w.Code, w.Body.String())
+
+	u, err := url.Parse(w.Header().Get("Location"))
+	require.NoError(ts.T(), err, "redirect url parse failed")
+	assert.Equal(ts.T(), server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(ts.T(), "openid email", u.Query().Get("scope"))
+	assert.Equal(ts.T(), "client", u.Query().Get("client_id"))
+
+	// Providers of other projects are not found
+	w = ts.makeRequest(http.MethodGet, fmt.Sprintf("/authorize?provider=custom:acme&project_id=%s", uuid.Must(uuid.NewV4())), "", nil)
+	assert.NotEqual(ts.T(), http.StatusFound, w.Code, w.Body.String())
+
+	p.Enabled = false
+	require.NoError(ts.T(), ts.API.db.Update(p))
+
+	w = ts.makeRequest(http.MethodGet, fmt.Sprintf("/authorize?provider=custom:acme&project_id=%s", ts.ProjectID), "", nil)
+	assert.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+}
@@ -52,7 +52,6 @@ func (a *API) ExternalProviderRedirect(w http.ResponseWriter, r *http.Request) e
 func (a *API) GetExternalProviderRedirectURL(w http.ResponseWriter, r *http.Request, linkingTargetUser *models.User) (string, error) {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
-	config := a.config
 
 	query := r.URL.Query()
 	providerType := query.Get("provider")
@@ -60,10 +59,41 @@ func (a *API) GetExternalProviderRedirectURL(w http.ResponseWriter, r *http.Requ
 	codeChallenge := query.Get("code_challenge")
 	codeChallengeMethod := query.Get("code_challenge_method")
 
+	organization := query.Get("organization_id")
+	organization_id, err := uuid.FromString(organization)
+	if err == nil {
+		query.Del("organization_id")
+	}
+	project := query.Get("project_id")
+	project_id, err2 := uuid.FromString(project)
+	if err2 == nil {
+		query.Del("project_id")
+	}
+
+	if err != nil && err2 != nil {
+		return "", apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid organization_id or project_id")
+	}
+
+	config, err := a.projectConfig(db, organization_id, project_id)
+	if err != nil {
+		return "", err
+	}
+
+	if provider.IsCustomOIDCProvider(providerType) {
+		providerProjectID, err := a.resolveProjectID(db, organization_id, project_id)
+		if err != nil {
+			return "", err
+		}
+		ctx = withExternalProviderProjectID(ctx, providerProjectID)
+	}
+
 	p, pConfig, err := a.Provider(ctx, providerType, scopes)
 	if err != nil {
 		return "", apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Unsupported provider: %+v", err).WithInternalError(err)
 	}
+	if !models.IsProviderEnabled(&config.External, providerType) {
+		return "", apierrors.NewBadRequestError(apierrors.ErrorCodeProviderDisabled, "Unsupported provider: provider is not enabled for this project")
+	}
 
 	inviteToken := query.Get("invite_token")
 	if inviteToken != "" {
@@ -86,21 +116,6 @@ func (a *API) GetExternalProviderRedirectURL(w http.ResponseWriter, r *http.Requ
 	flowType := getFlowFromChallenge(codeChallenge)
 
 	flowStateID := ""
-	organization := query.Get("organization_id")
-	organization_id, err := uuid.FromString(organization)
-	if err == nil {
-		query.Del("organization_id")
-	}
-	project := query.Get("project_id")
-	project_id, err2 := uuid.FromString(project)
-	if err2 == nil {
-		query.Del("project_id")
-	}
-
-	if err != nil && err2 != nil {
-		return "", apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid organization_id or project_id")
-	}
-
 	if isPKCEFlow(flowType) {
 		flowState, err := generateFlowState(db, providerType, models.OAuth, codeChallengeMethod, codeChallenge, nil, organization_id, project_id)
 		if err != nil {
@@ -334,7 +349,10 @@ func (a *API) internalExternalProviderCallback(w http.ResponseWriter, r *http.Re
 func (a *API) createAccountFromExternalIdentity(tx *storage.Connection, r *http.Request, userData *provider.UserProvidedData, providerType string, emailOptional bool, organization_id uuid.UUID, project_id uuid.UUID) (models.AccountLinkingDecision, *models.User, error) {
 	ctx := r.Context()
 	aud := a.requestAud(ctx, r)
-	config := a.config
+	config, terr := a.projectConfig(tx, organization_id, project_id)
+	if terr != nil {
+		return 0, nil, terr
+	}
 
 	var user *models.User
 	var identity *models.Identity
@@ -623,6 +641,25 @@ func (a *API) loadExternalState(ctx context.Context, r *http.Request, db *storag
 	if claims.ProjectID != uuid.Nil {
 		ctx = withProjectID(ctx, claims.ProjectID)
 	}
+	if provider.IsCustomOIDCProvider(claims.Provider) {
+		// The PKCE flow keeps the project in the flow state only
+		organizationID, projectID := claims.OrganizationID, claims.ProjectID
+		if claims.FlowStateID != "" {
+			flowState, err := models.FindFlowStateByID(db, claims.FlowStateID)
+			if err != nil {
+				if models.IsNotFoundError(err) {
+					return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeFlowStateNotFound, "Flow state not found")
+				}
+				return nil, apierrors.NewInternalServerError("Database error loading flow state").WithInternalError(err)
+			}
+			organizationID, projectID = flowState.OrganizationID.UUID, flowState.ProjectID
+		}
+		providerProjectID, err := a.resolveProjectID(db, organizationID, projectID)
+		if err != nil {
+			return nil, err
+		}
+		ctx = withExternalProviderProjectID(ctx, providerProjectID)
+	}
 	ctx = withExternalProviderType(ctx, claims.Provider, claims.EmailOptional)
 	return withSignature(ctx, state), nil
 }
@@ -636,6 +673,10 @@ func (a *API) Provider(ctx context.Context, name string, scopes string) (provide
 	var p provider.Provider
 	var pConfig conf.OAuthProviderConfiguration
 
+	if provider.IsCustomOIDCProvider(name) {
+		return a.customOIDCProvider(ctx, name, scopes)
+	}
+
 	switch name {
 	case "apple":
 		pConfig = config.External.Apple
@@ -67,6 +67,21 @@ func (a *API) requestProjectID(ctx context.Context, r *http.Request) uuid.UUID {
 
 type RequestParams interface {
 	AdminUserParams |
+		AdminOrganizationParams |
+		AdminOrganizationTierParams |
+		AdminProjectTierParams |
+		AdminOrganizationMemberParams |
+		SwitchOrganizationParams |
+		AdminOrganizationInvitationParams |
+		AdminAPIKeyParams |
+		AdminSMTPConfigParams |
+		AdminMFAPolicyParams |
+		AdminProjectSettingsParams |
+		AdminCustomOIDCProviderParams |
+		PasskeyParams |
+		UpdatePasskeyParams |
+		AcceptOrganizationInvitationParams |
+		AdminProjectParams |
 		CreateSSOProviderParams |
 		EnrollFactorParams |
 		GenerateLinkParams |
@@ -86,6 +101,7 @@ type RequestParams interface {
 		VerifyFactorParams |
 		VerifyParams |
 		adminUserUpdateFactorParams |
+		adminProjectDeleteParams |
 		adminUserDeleteParams |
 		security.GotrueRequest |
 		ChallengeFactorParams |
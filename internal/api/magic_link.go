@@ -45,7 +45,18 @@ func (p *MagicLinkParams) Validate(a *API) error {
 func (a *API) MagicLink(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
-	config := a.config
+
+	params := &MagicLinkParams{}
+	jsonDecoder := json.NewDecoder(r.Body)
+	err := jsonDecoder.Decode(params)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeBadJSON, "Could not read verification params: %v", err).WithInternalError(err)
+	}
+
+	config, err := a.projectConfig(db, params.OrganizationID, params.ProjectID)
+	if err != nil {
+		return err
+	}
 
 	if !config.External.Email.Enabled {
 		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeEmailProviderDisabled, "Email logins are disabled")
@@ -55,13 +66,6 @@ func (a *API) MagicLink(w http.ResponseWriter, r *http.Request) error {
 		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeEmailProviderDisabled, "Login with magic link is disabled")
 	}
 
-	params := &MagicLinkParams{}
-	jsonDecoder := json.NewDecoder(r.Body)
-	err := jsonDecoder.Decode(params)
-	if err != nil {
-		return apierrors.NewBadRequestError(apierrors.ErrorCodeBadJSON, "Could not read verification params: %v", err).WithInternalError(err)
-	}
-
 	if err := params.Validate(a); err != nil {
 		return err
 	}
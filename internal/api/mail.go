@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"regexp"
 	"strings"
@@ -37,6 +38,7 @@ type GenerateLinkParams struct {
 	Data           map[string]interface{} `json:"data"`
 	RedirectTo     string                 `json:"redirect_to"`
 	OrganizationID uuid.UUID              `json:"organization_id"`
+	ProjectID      uuid.UUID              `json:"project_id"`
 }
 
 type GenerateLinkResponse struct {
@@ -48,7 +50,8 @@ type GenerateLinkResponse struct {
 	RedirectTo       string `json:"redirect_to"`
 }
 
-//lint:ignore U1000 This function is kept for API completeness and future use
+// adminGenerateLink generates an email action link for a user of the given
+// organization or project, creating the user for signup and invite links.
 func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
@@ -66,8 +69,8 @@ func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	if params.OrganizationID == uuid.Nil {
-		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Organization ID is required")
+	if err := a.generateLinkTenant(ctx, r, params); err != nil {
+		return err
 	}
 
 	referrer := utilities.GetReferrer(r, config)
@@ -76,7 +79,7 @@ func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	aud := a.requestAud(ctx, r)
-	user, err := models.FindUserByEmailAndAudience(db, params.Email, aud, params.OrganizationID, uuid.Nil)
+	user, err := models.FindUserByEmailAndAudience(db, params.Email, aud, params.OrganizationID, params.ProjectID)
 	if err != nil {
 		if models.IsNotFoundError(err) {
 			switch params.Type {
@@ -109,11 +112,13 @@ func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 	switch {
 	case params.Type == mail.SignupVerification && user == nil:
 		signupParams := &SignupParams{
-			Email:    params.Email,
-			Password: params.Password,
-			Data:     params.Data,
-			Provider: "email",
-			Aud:      aud,
+			Email:          params.Email,
+			Password:       params.Password,
+			Data:           params.Data,
+			Provider:       "email",
+			Aud:            aud,
+			OrganizationID: params.OrganizationID,
+			ProjectID:      params.ProjectID,
 		}
 
 		if err := a.validateSignupParams(ctx, signupParams); err != nil {
@@ -130,10 +135,12 @@ func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 
 	case params.Type == mail.InviteVerification && user == nil:
 		signupParams := &SignupParams{
-			Email:    params.Email,
-			Data:     params.Data,
-			Provider: "email",
-			Aud:      aud,
+			Email:          params.Email,
+			Data:           params.Data,
+			Provider:       "email",
+			Aud:            aud,
+			OrganizationID: params.OrganizationID,
+			ProjectID:      params.ProjectID,
 		}
 
 		inviteUser, err = signupParams.ToUserModel(false /* <- isSSOUser */)
@@ -185,7 +192,7 @@ func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 				}
 				user.Identities = []models.Identity{*identity}
 			}
-			if terr = models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.UserInvitedAction, "", map[string]interface{}{
+			if terr = models.NewAuditLogEntryForUser(config.AuditLog, r, tx, adminUser, user, models.UserInvitedAction, "", map[string]interface{}{
 				"user_id":    user.ID,
 				"user_email": user.Email,
 			}); terr != nil {
@@ -251,7 +258,7 @@ func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 			if terr != nil {
 				return terr
 			}
-			if duplicateUser, terr := models.IsDuplicatedEmail(tx, params.NewEmail, user.Aud, user, config.Experimental.ProvidersWithOwnLinkingDomain, params.OrganizationID, uuid.Nil); terr != nil {
+			if duplicateUser, terr := models.IsDuplicatedEmail(tx, params.NewEmail, user.Aud, user, config.Experimental.ProvidersWithOwnLinkingDomain, params.OrganizationID, params.ProjectID); terr != nil {
 				return apierrors.NewInternalServerError("Database error checking email").WithInternalError(terr)
 			} else if duplicateUser != nil {
 				return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeEmailExists, DuplicateEmailMsg)
@@ -292,6 +299,14 @@ func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 			return terr
 		}
 
+		if terr = models.NewAuditLogEntryForUser(config.AuditLog, r, tx, adminUser, user, models.GenerateLinkAction, "", map[string]interface{}{
+			"user_id":    user.ID,
+			"user_email": user.Email,
+			"link_type":  params.Type,
+		}); terr != nil {
+			return terr
+		}
+
 		externalURL := getExternalHost(ctx)
 		url, terr = mailer.GetEmailActionLink(user, params.Type, referrer, externalURL)
 		if terr != nil {
@@ -320,6 +335,44 @@ func (a *API) adminGenerateLink(w http.ResponseWriter, r *http.Request) error {
 	return sendJSON(w, http.StatusOK, resp)
 }
 
+// generateLinkTenant resolves the organization and project a link is generated
+// in. The organization is optional as users can belong to a project only.
+func (a *API) generateLinkTenant(ctx context.Context, r *http.Request, params *GenerateLinkParams) error {
+	db := a.db.WithContext(ctx)
+	scopeOrganizationID, scopeProjectID := a.adminTenant(ctx, r)
+
+	if params.OrganizationID != uuid.Nil || scopeOrganizationID != uuid.Nil {
+		organization, err := a.adminOrganization(ctx, r, params.OrganizationID)
+		if err != nil {
+			return err
+		}
+		if params.ProjectID != uuid.Nil && params.ProjectID != organization.ProjectID {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+		}
+		params.OrganizationID = organization.ID
+		params.ProjectID = organization.ProjectID
+		return nil
+	}
+
+	if scopeProjectID != uuid.Nil {
+		if params.ProjectID != uuid.Nil && params.ProjectID != scopeProjectID {
+			return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to manage another project")
+		}
+		params.ProjectID = scopeProjectID
+	}
+	if params.ProjectID == uuid.Nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Organization ID or Project ID is required")
+	}
+
+	if _, err := models.FindProjectByID(db, params.ProjectID); err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeProjectNotFound, "Project not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading project").WithInternalError(err)
+	}
+	return nil
+}
+
 func (a *API) sendConfirmation(r *http.Request, tx *storage.Connection, u *models.User, flowType models.FlowType) error {
 	var err error
 
@@ -399,6 +452,32 @@ func (a *API) sendInvite(r *http.Request, tx *storage.Connection, u *models.User
 	return nil
 }
 
+// sendOrganizationInvite sends the invitation email with the plain token. The
+// user is the invitee, which may not exist in the database yet.
+func (a *API) sendOrganizationInvite(r *http.Request, tx *storage.Connection, u *models.User, invitation *models.OrganizationInvitation, organization *models.Organization, token string) error {
+	err := a.sendEmail(r, tx, u, sendEmailParams{
+		emailActionType:     mail.OrganizationInviteVerification,
+		otp:                 token,
+		tokenHashWithPrefix: invitation.TokenHash,
+		organization:        organization,
+		organizationRole:    invitation.Role,
+	})
+	if err != nil {
+		if errors.Is(err, EmailRateLimitExceeded) {
+			return apierrors.NewTooManyRequestsError(apierrors.ErrorCodeOverEmailSendRateLimit, "%s", EmailRateLimitExceeded.Error())
+		} else if herr, ok := err.(*HTTPError); ok {
+			return herr
+		}
+		return apierrors.NewInternalServerError("Error sending organization invitation email").WithInternalError(err)
+	}
+
+	if err := invitation.MarkSent(tx); err != nil {
+		return apierrors.NewInternalServerError("Error inviting user").WithInternalError(errors.Wrap(err, "Database error updating organization invitation"))
+	}
+
+	return nil
+}
+
 func (a *API) sendPasswordRecovery(r *http.Request, tx *storage.Connection, u *models.User, flowType models.FlowType) error {
 	config := a.config
 	otpLength := config.Mailer.OtpLength
@@ -759,6 +838,8 @@ type sendEmailParams struct {
 	oldPhone            string
 	provider            string
 	factorType          string
+	organization        *models.Organization
+	organizationRole    string
 }
 
 func (a *API) sendEmail(r *http.Request, tx *storage.Connection, u *models.User, params sendEmailParams) error {
@@ -863,6 +944,10 @@ func (a *API) sendEmail(r *http.Request, tx *storage.Connection, u *models.User,
 			emailData.Provider = params.provider
 		case mail.MFAFactorEnrolledNotification, mail.MFAFactorUnenrolledNotification:
 			emailData.FactorType = params.factorType
+		case mail.OrganizationInviteVerification:
+			emailData.OrganizationID = params.organization.ID.String()
+			emailData.OrganizationName = params.organization.Name
+			emailData.OrganizationRole = params.organizationRole
 		}
 
 		input := v0hooks.SendEmailInput{
@@ -873,8 +958,12 @@ func (a *API) sendEmail(r *http.Request, tx *storage.Connection, u *models.User,
 		return a.hooksMgr.InvokeHook(tx, r, &input, &output)
 	}
 
+	r, err := a.withTenantSMTPConfig(r, tx, u, params.organization)
+	if err != nil {
+		return err
+	}
+
 	mr := a.Mailer()
-	var err error
 	switch params.emailActionType {
 	case mail.SignupVerification:
 		err = mr.ConfirmationMail(r, u, otp, referrerURL, externalURL)
@@ -888,6 +977,8 @@ func (a *API) sendEmail(r *http.Request, tx *storage.Connection, u *models.User,
 		err = mr.InviteMail(r, u, otp, referrerURL, externalURL)
 	case mail.EmailChangeVerification:
 		err = mr.EmailChangeMail(r, u, params.otpNew, otp, referrerURL, externalURL)
+	case mail.OrganizationInviteVerification:
+		err = mr.OrganizationInviteMail(r, u, params.organization, params.organizationRole, otp, referrerURL)
 	case mail.PasswordChangedNotification:
 		err = mr.PasswordChangedNotificationMail(r, u)
 	case mail.EmailChangedNotification:
@@ -918,3 +1009,25 @@ func (a *API) sendEmail(r *http.Request, tx *storage.Connection, u *models.User,
 		return err
 	}
 }
+
+// withTenantSMTPConfig attaches the SMTP configuration of the user's
+// organization or project to the request, so that the mail is sent through
+// the tenant's server. Organization invitations use the inviting
+// organization, as the invitee may not belong to it yet.
+func (a *API) withTenantSMTPConfig(r *http.Request, tx *storage.Connection, u *models.User, organization *models.Organization) (*http.Request, error) {
+	config := a.config
+
+	organizationID, projectID := u.OrganizationID.UUID, u.ProjectID
+	if organization != nil {
+		organizationID, projectID = organization.ID, organization.ProjectID
+	}
+
+	smtp, err := models.FindSMTPConfiguration(tx, organizationID, projectID, config.SMTP, config.Security.DBEncryption)
+	if err != nil {
+		return nil, apierrors.NewInternalServerError("Error loading SMTP configuration").WithInternalError(err)
+	}
+	if smtp == nil {
+		return r, nil
+	}
+	return r.WithContext(mail.WithSMTPConfig(r.Context(), smtp)), nil
+}
@@ -1,6 +1,12 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/supabase/auth/internal/conf"
@@ -8,9 +14,11 @@ import (
 
 	"github.com/gobwas/glob"
 	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/crypto"
 )
 
 type MailTestSuite struct {
@@ -19,6 +27,8 @@ type MailTestSuite struct {
 	Config         *conf.GlobalConfiguration
 	OrganizationID uuid.UUID
 	ProjectID      uuid.UUID
+
+	admin *models.User
 }
 
 func TestMail(t *testing.T) {
@@ -36,7 +46,7 @@ func TestMail(t *testing.T) {
 
 func (ts *MailTestSuite) SetupTest() {
 	ts.Config.Mailer.SecureEmailChangeEnabled = true
-	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
 
 	// Create User
 	u, err := models.NewUser("12345678", "test@example.com", "password", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
@@ -87,172 +97,231 @@ func (ts *MailTestSuite) TestValidateEmail() {
 	}
 }
 
-// func (ts *MailTestSuite) TestGenerateLink() {
-// 	// create admin jwt
-// 	claims := &AccessTokenClaims{
-// 		Role: "supabase_admin",
-// 	}
-// 	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(ts.Config.JWT.Secret))
-// 	require.NoError(ts.T(), err, "Error generating admin jwt")
-
-// 	ts.setURIAllowListMap("http://localhost:8000/**")
-// 	// create test cases
-// 	cases := []struct {
-// 		Desc             string
-// 		Body             GenerateLinkParams
-// 		ExpectedCode     int
-// 		ExpectedResponse map[string]interface{}
-// 	}{
-// 		{
-// 			Desc: "Generate signup link for new user",
-// 			Body: GenerateLinkParams{
-// 				Email:          "new_user@example.com",
-// 				Password:       "secret123",
-// 				Type:           "signup",
-// 				OrganizationID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000")),
-// 			},
-// 			ExpectedCode: http.StatusOK,
-// 			ExpectedResponse: map[string]interface{}{
-// 				"redirect_to": ts.Config.SiteURL,
-// 			},
-// 		},
-// 		{
-// 			Desc: "Generate signup link for existing user",
-// 			Body: GenerateLinkParams{
-// 				Email:          "test@example.com",
-// 				Password:       "secret123",
-// 				OrganizationID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000")),
-// 				Type:           "signup",
-// 			},
-// 			ExpectedCode: http.StatusOK,
-// 			ExpectedResponse: map[string]interface{}{
-// 				"redirect_to": ts.Config.SiteURL,
-// 			},
-// 		},
-// 		{
-// 			Desc: "Generate signup link with custom redirect url",
-// 			Body: GenerateLinkParams{
-// 				Email:          "test@example.com",
-// 				Password:       "secret123",
-// 				OrganizationID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000")),
-// 				Type:           "signup",
-// 				RedirectTo:     "http://localhost:8000/welcome",
-// 			},
-// 			ExpectedCode: http.StatusOK,
-// 			ExpectedResponse: map[string]interface{}{
-// 				"redirect_to": "http://localhost:8000/welcome",
-// 			},
-// 		},
-// 		{
-// 			Desc: "Generate magic link",
-// 			Body: GenerateLinkParams{
-// 				Email:          "test@example.com",
-// 				OrganizationID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000")),
-// 				Type:           "magiclink",
-// 			},
-// 			ExpectedCode: http.StatusOK,
-// 			ExpectedResponse: map[string]interface{}{
-// 				"redirect_to": ts.Config.SiteURL,
-// 			},
-// 		},
-// 		{
-// 			Desc: "Generate invite link",
-// 			Body: GenerateLinkParams{
-// 				Email:          "test@example.com",
-// 				OrganizationID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000")),
-// 				Type:           "invite",
-// 			},
-// 			ExpectedCode: http.StatusOK,
-// 			ExpectedResponse: map[string]interface{}{
-// 				"redirect_to": ts.Config.SiteURL,
-// 			},
-// 		},
-// 		{
-// 			Desc: "Generate recovery link",
-// 			Body: GenerateLinkParams{
-// 				Email:          "test@example.com",
-// 				OrganizationID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000")),
-// 				Type:           "recovery",
-// 			},
-// 			ExpectedCode: http.StatusOK,
-// 			ExpectedResponse: map[string]interface{}{
-// 				"redirect_to": ts.Config.SiteURL,
-// 			},
-// 		},
-// 		{
-// 			Desc: "Generate email change link",
-// 			Body: GenerateLinkParams{
-// 				Email:          "test@example.com",
-// 				NewEmail:       "new@example.com",
-// 				OrganizationID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000")),
-// 				Type:           "email_change_current",
-// 			},
-// 			ExpectedCode: http.StatusOK,
-// 			ExpectedResponse: map[string]interface{}{
-// 				"redirect_to": ts.Config.SiteURL,
-// 			},
-// 		},
-// 		{
-// 			Desc: "Generate email change link",
-// 			Body: GenerateLinkParams{
-// 				Email:          "test@example.com",
-// 				OrganizationID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000")),
-// 				NewEmail:       "new@example.com",
-// 				Type:           "email_change_new",
-// 			},
-// 			ExpectedCode: http.StatusOK,
-// 			ExpectedResponse: map[string]interface{}{
-// 				"redirect_to": ts.Config.SiteURL,
-// 			},
-// 		},
-// 	}
-
-// 	customDomainUrl, err := url.ParseRequestURI("https://example.gotrue.com")
-// 	require.NoError(ts.T(), err)
-
-// 	originalHosts := ts.API.config.Mailer.ExternalHosts
-// 	ts.API.config.Mailer.ExternalHosts = []string{
-// 		"example.gotrue.com",
-// 	}
-
-// 	for _, c := range cases {
-// 		ts.Run(c.Desc, func() {
-// 			var buffer bytes.Buffer
-// 			require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(c.Body))
-// 			req := httptest.NewRequest(http.MethodPost, customDomainUrl.String()+"/admin/generate_link", &buffer)
-// 			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-// 			w := httptest.NewRecorder()
-
-// 			ts.API.handler.ServeHTTP(w, req)
-
-// 			require.Equal(ts.T(), c.ExpectedCode, w.Code)
-
-// 			data := make(map[string]interface{})
-// 			require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
-
-// 			require.Contains(ts.T(), data, "action_link")
-// 			require.Contains(ts.T(), data, "email_otp")
-// 			require.Contains(ts.T(), data, "hashed_token")
-// 			require.Contains(ts.T(), data, "redirect_to")
-// 			require.Equal(ts.T(), c.Body.Type, data["verification_type"])
-
-// 			// check if redirect_to is correct
-// 			require.Equal(ts.T(), c.ExpectedResponse["redirect_to"], data["redirect_to"])
-
-// 			// check if hashed_token matches hash function of email and the raw otp
-// 			require.Equal(ts.T(), crypto.GenerateTokenHash(c.Body.Email, data["email_otp"].(string)), data["hashed_token"])
-
-// 			// check if the host used in the email link matches the initial request host
-// 			u, err := url.ParseRequestURI(data["action_link"].(string))
-// 			require.NoError(ts.T(), err)
-// 			require.Equal(ts.T(), req.Host, u.Host)
-// 		})
-// 	}
-
-// 	ts.API.config.Mailer.ExternalHosts = originalHosts
-// }
-
-//lint:ignore U1000 This function is kept for API completeness and future use
+func (ts *MailTestSuite) TestGenerateLink() {
+	// create admin jwt
+	claims := &AccessTokenClaims{
+		Role:      "supabase_admin",
+		ProjectID: ts.ProjectID,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating admin jwt")
+
+	ts.setURIAllowListMap("http://localhost:8000/**")
+	// create test cases
+	cases := []struct {
+		Desc             string
+		Body             GenerateLinkParams
+		ExpectedCode     int
+		ExpectedResponse map[string]interface{}
+	}{
+		{
+			Desc: "Generate signup link for new user",
+			Body: GenerateLinkParams{
+				Email:          "new_user@example.com",
+				Password:       "secret123",
+				Type:           "signup",
+				OrganizationID: ts.OrganizationID,
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: map[string]interface{}{
+				"redirect_to": ts.Config.SiteURL,
+			},
+		},
+		{
+			Desc: "Generate signup link for existing user",
+			Body: GenerateLinkParams{
+				Email:          "test@example.com",
+				Password:       "secret123",
+				OrganizationID: ts.OrganizationID,
+				Type:           "signup",
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: map[string]interface{}{
+				"redirect_to": ts.Config.SiteURL,
+			},
+		},
+		{
+			Desc: "Generate signup link with custom redirect url",
+			Body: GenerateLinkParams{
+				Email:          "test@example.com",
+				Password:       "secret123",
+				OrganizationID: ts.OrganizationID,
+				Type:           "signup",
+				RedirectTo:     "http://localhost:8000/welcome",
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: map[string]interface{}{
+				"redirect_to": "http://localhost:8000/welcome",
+			},
+		},
+		{
+			Desc: "Generate magic link",
+			Body: GenerateLinkParams{
+				Email:          "test@example.com",
+				OrganizationID: ts.OrganizationID,
+				Type:           "magiclink",
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: map[string]interface{}{
+				"redirect_to": ts.Config.SiteURL,
+			},
+		},
+		{
+			Desc: "Generate invite link",
+			Body: GenerateLinkParams{
+				Email:          "test@example.com",
+				OrganizationID: ts.OrganizationID,
+				Type:           "invite",
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: map[string]interface{}{
+				"redirect_to": ts.Config.SiteURL,
+			},
+		},
+		{
+			Desc: "Generate recovery link",
+			Body: GenerateLinkParams{
+				Email:          "test@example.com",
+				OrganizationID: ts.OrganizationID,
+				Type:           "recovery",
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: map[string]interface{}{
+				"redirect_to": ts.Config.SiteURL,
+			},
+		},
+		{
+			Desc: "Generate email change link",
+			Body: GenerateLinkParams{
+				Email:          "test@example.com",
+				NewEmail:       "new@example.com",
+				OrganizationID: ts.OrganizationID,
+				Type:           "email_change_current",
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: map[string]interface{}{
+				"redirect_to": ts.Config.SiteURL,
+			},
+		},
+		{
+			Desc: "Generate email change link",
+			Body: GenerateLinkParams{
+				Email:          "test@example.com",
+				OrganizationID: ts.OrganizationID,
+				NewEmail:       "new@example.com",
+				Type:           "email_change_new",
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: map[string]interface{}{
+				"redirect_to": ts.Config.SiteURL,
+			},
+		},
+	}
+
+	customDomainUrl, err := url.ParseRequestURI("https://example.gotrue.com")
+	require.NoError(ts.T(), err)
+
+	originalHosts := ts.API.config.Mailer.ExternalHosts
+	ts.API.config.Mailer.ExternalHosts = []string{
+		"example.gotrue.com",
+	}
+
+	for _, c := range cases {
+		ts.Run(c.Desc, func() {
+			var buffer bytes.Buffer
+			require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(c.Body))
+			req := httptest.NewRequest(http.MethodPost, customDomainUrl.String()+"/admin/generate_link", &buffer)
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			w := httptest.NewRecorder()
+
+			ts.API.handler.ServeHTTP(w, req)
+
+			require.Equal(ts.T(), c.ExpectedCode, w.Code)
+
+			data := make(map[string]interface{})
+			require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+
+			require.Contains(ts.T(), data, "action_link")
+			require.Contains(ts.T(), data, "email_otp")
+			require.Contains(ts.T(), data, "hashed_token")
+			require.Contains(ts.T(), data, "redirect_to")
+			require.Equal(ts.T(), c.Body.Type, data["verification_type"])
+
+			// check if redirect_to is correct
+			require.Equal(ts.T(), c.ExpectedResponse["redirect_to"], data["redirect_to"])
+
+			// check if hashed_token matches hash function of email and the raw otp
+			require.Equal(ts.T(), crypto.GenerateTokenHash(c.Body.Email, data["email_otp"].(string)), data["hashed_token"])
+
+			// check if the host used in the email link matches the initial request host
+			u, err := url.ParseRequestURI(data["action_link"].(string))
+			require.NoError(ts.T(), err)
+			require.Equal(ts.T(), req.Host, u.Host)
+		})
+	}
+
+	ts.API.config.Mailer.ExternalHosts = originalHosts
+}
+
+func (ts *MailTestSuite) TestGenerateLinkTenant() {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ts.admin.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating organization admin jwt")
+
+	generateLink := func(body GenerateLinkParams) *httptest.ResponseRecorder {
+		var buffer bytes.Buffer
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+		req := httptest.NewRequest(http.MethodPost, "/admin/generate_link", &buffer)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+		ts.API.handler.ServeHTTP(w, req)
+		return w
+	}
+
+	// organization admins invite into their own organization
+	w := generateLink(GenerateLinkParams{
+		Email: "invited@example.com",
+		Type:  "invite",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	user, err := models.FindUserByEmailAndAudience(ts.API.db, "invited@example.com", ts.Config.JWT.Aud, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NotNil(ts.T(), user.InvitedAt)
+
+	logs, err := models.FindAuditLogEntries(ts.API.db, &models.AuditLogFilter{
+		OrganizationID: ts.OrganizationID,
+		Action:         string(models.GenerateLinkAction),
+	}, nil)
+	require.NoError(ts.T(), err)
+	require.Len(ts.T(), logs, 1)
+	traits, ok := logs[0].Payload["traits"].(map[string]interface{})
+	require.True(ts.T(), ok)
+	require.Equal(ts.T(), "invite", traits["link_type"])
+
+	w = generateLink(GenerateLinkParams{
+		Email:          "other@example.com",
+		Type:           "invite",
+		OrganizationID: uuid.Must(uuid.NewV4()),
+	})
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+
+	// users of another project cannot be found
+	w = generateLink(GenerateLinkParams{
+		Email:     "test@example.com",
+		Type:      "recovery",
+		ProjectID: uuid.Must(uuid.NewV4()),
+	})
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+}
+
 func (ts *MailTestSuite) setURIAllowListMap(uris ...string) {
 	for _, uri := range uris {
 		g := glob.MustCompile(uri, '.', '/')
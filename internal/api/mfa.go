@@ -137,6 +137,10 @@ func validateFactors(db *storage.Connection, user *models.User, newFactorName st
 	numVerifiedFactors := 0
 
 	for _, factor := range user.Factors {
+		if factor.IsRecoveryCodeFactor() {
+			factorCount--
+			continue
+		}
 		if factor.FriendlyName == newFactorName {
 			return apierrors.NewUnprocessableEntityError(
 				apierrors.ErrorCodeMFAFactorNameConflict,
@@ -617,6 +621,12 @@ func (a *API) ChallengeFactor(w http.ResponseWriter, r *http.Request) error {
 			return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFAWebAuthnVerifyDisabled, "MFA verification is disabled for WebAuthn")
 		}
 		return a.challengeWebAuthnFactor(w, r)
+	case models.RecoveryCode:
+		if !config.MFA.RecoveryCodes.Enabled {
+			return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFARecoveryCodesDisabled, "MFA recovery codes are disabled")
+		}
+		// Recovery codes are challenged like TOTP, nothing is sent to the user
+		return a.challengeTOTPFactor(w, r)
 	default:
 		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "factor_type needs to be totp, phone, or webauthn")
 	}
@@ -729,6 +739,11 @@ func (a *API) verifyTOTPFactor(w http.ResponseWriter, r *http.Request, params *V
 		if terr != nil {
 			return terr
 		}
+		if verified {
+			if token.RecoveryCodes, terr = a.enrollRecoveryCodes(r, tx, user); terr != nil {
+				return terr
+			}
+		}
 		if terr = models.InvalidateSessionsWithAALLessThan(tx, user.ID, models.AAL2.String()); terr != nil {
 			return apierrors.NewInternalServerError("Failed to update sessions. %s", terr)
 		}
@@ -869,6 +884,11 @@ func (a *API) verifyPhoneFactor(w http.ResponseWriter, r *http.Request, params *
 		if terr != nil {
 			return terr
 		}
+		if verified {
+			if token.RecoveryCodes, terr = a.enrollRecoveryCodes(r, tx, user); terr != nil {
+				return terr
+			}
+		}
 		if terr = models.InvalidateSessionsWithAALLessThan(tx, user.ID, models.AAL2.String()); terr != nil {
 			return apierrors.NewInternalServerError("Failed to update sessions. %s", terr)
 		}
@@ -989,6 +1009,11 @@ func (a *API) verifyWebAuthnFactor(w http.ResponseWriter, r *http.Request, param
 		if terr != nil {
 			return terr
 		}
+		if verified {
+			if token.RecoveryCodes, terr = a.enrollRecoveryCodes(r, tx, user); terr != nil {
+				return terr
+			}
+		}
 		if terr = models.InvalidateSessionsWithAALLessThan(tx, user.ID, models.AAL2.String()); terr != nil {
 			return apierrors.NewInternalServerError("Failed to update session").WithInternalError(terr)
 		}
@@ -1016,6 +1041,101 @@ func (a *API) verifyWebAuthnFactor(w http.ResponseWriter, r *http.Request, param
 	return sendJSON(w, http.StatusOK, token)
 }
 
+func (a *API) verifyRecoveryCodeFactor(w http.ResponseWriter, r *http.Request, params *VerifyFactorParams) error {
+	ctx := r.Context()
+	config := a.config
+	user := getUser(ctx)
+	factor := getFactor(ctx)
+	db := a.db.WithContext(ctx)
+
+	challenge, err := a.validateChallenge(r, db, factor, params.ChallengeID)
+	if err != nil {
+		return err
+	}
+
+	recoveryCode, err := models.FindUnusedRecoveryCode(db, factor, params.Code)
+	if err != nil && !models.IsNotFoundError(err) {
+		return apierrors.NewInternalServerError("Database error verifying recovery code").WithInternalError(err)
+	}
+	valid := recoveryCode != nil
+
+	if config.Hook.MFAVerificationAttempt.Enabled {
+		input := v0hooks.MFAVerificationAttemptInput{
+			UserID:     user.ID,
+			FactorID:   factor.ID,
+			FactorType: factor.FactorType,
+			Valid:      valid,
+		}
+
+		output := v0hooks.MFAVerificationAttemptOutput{}
+		err := a.hooksMgr.InvokeHook(nil, r, &input, &output)
+		if err != nil {
+			return err
+		}
+
+		if output.Decision == v0hooks.HookRejection {
+			if err := models.Logout(db, user.ID); err != nil {
+				return err
+			}
+
+			if output.Message == "" {
+				output.Message = v0hooks.DefaultMFAHookRejectionMessage
+			}
+
+			return apierrors.NewForbiddenError(apierrors.ErrorCodeMFAVerificationRejected, "%s", output.Message)
+		}
+	}
+	if !valid {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFAVerificationFailed, "Invalid recovery code entered")
+	}
+
+	var token *AccessTokenResponse
+	err = db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		if terr = models.NewAuditLogEntry(config.AuditLog, r, tx, user, models.VerifyFactorAction, r.RemoteAddr, map[string]interface{}{
+			"factor_id":    factor.ID,
+			"challenge_id": challenge.ID,
+			"factor_type":  factor.FactorType,
+		}); terr != nil {
+			return terr
+		}
+		if terr = challenge.Verify(tx); terr != nil {
+			return terr
+		}
+		if terr = recoveryCode.Use(tx); terr != nil {
+			if models.IsNotFoundError(terr) {
+				// Used by a concurrent request since it was found
+				return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFAVerificationFailed, "Invalid recovery code entered")
+			}
+			return terr
+		}
+		user, terr = models.FindUserByID(tx, user.ID)
+		if terr != nil {
+			return terr
+		}
+
+		token, terr = a.updateMFASessionAndClaims(r, tx, user, models.MFARecovery, models.GrantParams{
+			FactorID: &factor.ID,
+		})
+		if terr != nil {
+			return terr
+		}
+		if terr = models.InvalidateSessionsWithAALLessThan(tx, user.ID, models.AAL2.String()); terr != nil {
+			return apierrors.NewInternalServerError("Failed to update sessions. %s", terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	metering.RecordLogin(metering.LoginTypeMFA, user.ID, &metering.LoginData{
+		Provider: metering.ProviderMFARecovery,
+	})
+
+	return sendJSON(w, http.StatusOK, token)
+}
+
 func (a *API) VerifyFactor(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	factor := getFactor(ctx)
@@ -1046,6 +1166,11 @@ func (a *API) VerifyFactor(w http.ResponseWriter, r *http.Request) error {
 			return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFAWebAuthnEnrollDisabled, "MFA verification is disabled for WebAuthn")
 		}
 		return a.verifyWebAuthnFactor(w, r, params)
+	case models.RecoveryCode:
+		if !config.MFA.RecoveryCodes.Enabled {
+			return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFARecoveryCodesDisabled, "MFA recovery codes are disabled")
+		}
+		return a.verifyRecoveryCodeFactor(w, r, params)
 	default:
 		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "factor_type needs to be totp, phone, or webauthn")
 	}
@@ -1086,6 +1211,11 @@ func (a *API) UnenrollFactor(w http.ResponseWriter, r *http.Request) error {
 		if terr = factor.DowngradeSessionsToAAL1(tx); terr != nil {
 			return terr
 		}
+		if !factor.IsRecoveryCodeFactor() {
+			if terr = a.deleteUnusableRecoveryCodes(r, tx, user, user); terr != nil {
+				return terr
+			}
+		}
 		return nil
 	})
 	if err != nil {
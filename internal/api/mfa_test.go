@@ -724,6 +724,84 @@ func (ts *MFATestSuite) TestMFAFollowedByPasswordSignIn() {
 	require.True(ts.T(), session.IsAAL2())
 }
 
+func (ts *MFATestSuite) TestRecoveryCodes() {
+	ts.Config.MFA.RecoveryCodes.Enabled = true
+	ts.Config.MFA.RecoveryCodes.Count = 3
+
+	signUpResp := signUp(ts, "recovery@example.com", "test123")
+	w := performEnrollAndVerify(ts, signUpResp.Token, true)
+	verifyResp := AccessTokenResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&verifyResp))
+	require.Len(ts.T(), verifyResp.RecoveryCodes, 3)
+
+	user, err := models.FindUserByID(ts.API.db, signUpResp.User.ID)
+	require.NoError(ts.T(), err)
+	factor, err := models.FindRecoveryCodeFactor(ts.API.db, user)
+	require.NoError(ts.T(), err)
+
+	// A code found by two requests at once is only used by one of them
+	stale, err := models.FindUnusedRecoveryCode(ts.API.db, factor, verifyResp.RecoveryCodes[2])
+	require.NoError(ts.T(), err)
+	racing, err := models.FindUnusedRecoveryCode(ts.API.db, factor, verifyResp.RecoveryCodes[2])
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), racing.Use(ts.API.db))
+	require.True(ts.T(), models.IsNotFoundError(stale.Use(ts.API.db)))
+
+	// A new AAL1 session reaches AAL2 with a recovery code, once
+	r, err := models.GrantAuthenticatedUser(ts.API.db, user, models.GrantParams{})
+	require.NoError(ts.T(), err)
+	token := ts.generateAAL1Token(user, r.SessionId)
+
+	for _, expectedCode := range []int{http.StatusOK, http.StatusUnprocessableEntity} {
+		w = performChallengeFlow(ts, factor.ID, token)
+		challengeResp := ChallengeFactorResponse{}
+		require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&challengeResp))
+
+		var buffer bytes.Buffer
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+			"challenge_id": challengeResp.ID,
+			"code":         strings.ToUpper(verifyResp.RecoveryCodes[0]),
+		}))
+		w = ServeAuthenticatedRequest(ts, http.MethodPost, fmt.Sprintf("/factors/%s/verify", factor.ID), token, buffer)
+		require.Equal(ts.T(), expectedCode, w.Code, w.Body.String())
+	}
+
+	session, err := models.FindSessionByID(ts.API.db, *r.SessionId, false)
+	require.NoError(ts.T(), err)
+	require.True(ts.T(), session.IsAAL2())
+
+	var buffer bytes.Buffer
+	w = ServeAuthenticatedRequest(ts, http.MethodGet, "/user/recovery_codes", verifyResp.Token, buffer)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	statusResp := RecoveryCodesStatusResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&statusResp))
+	require.Equal(ts.T(), 1, statusResp.Remaining)
+
+	// Regenerating requires AAL2
+	w = ServeAuthenticatedRequest(ts, http.MethodPost, "/user/recovery_codes", ts.generateAAL1Token(user, r.SessionId), buffer)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+
+	w = ServeAuthenticatedRequest(ts, http.MethodPost, "/user/recovery_codes", verifyResp.Token, buffer)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	regenerateResp := RecoveryCodesResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&regenerateResp))
+	require.Len(ts.T(), regenerateResp.RecoveryCodes, 3)
+
+	_, err = models.FindUnusedRecoveryCode(ts.API.db, factor, verifyResp.RecoveryCodes[1])
+	require.True(ts.T(), models.IsNotFoundError(err))
+
+	// Unenrolling the last factor removes the recovery codes
+	require.NoError(ts.T(), ts.API.db.Load(user, "Factors"))
+	for _, f := range user.Factors {
+		if f.FactorType == models.TOTP {
+			w = ServeAuthenticatedRequest(ts, http.MethodDelete, fmt.Sprintf("/factors/%s", f.ID), verifyResp.Token, buffer)
+			require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+		}
+	}
+	_, err = models.FindRecoveryCodeFactor(ts.API.db, user)
+	require.True(ts.T(), models.IsNotFoundError(err))
+}
+
 func (ts *MFATestSuite) TestChallengeWebAuthnFactor() {
 	factor := models.NewWebAuthnFactor(ts.TestUser, "WebAuthnfactor")
 	validWebAuthnConfiguration := &WebAuthnParams{
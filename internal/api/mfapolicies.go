@@ -0,0 +1,121 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type AdminMFAPolicyParams struct {
+	RequiredFactorTypes []string `json:"required_factor_types"`
+
+	// EnrollmentGracePeriod is how many seconds members have to enroll a
+	// factor before their sessions are limited to enrolling one.
+	EnrollmentGracePeriod int    `json:"enrollment_grace_period"`
+	MinimumAAL            string `json:"minimum_aal"`
+}
+
+// adminOrganizationMFAPolicyGet responds with the MFA policy of an
+// organization.
+func (a *API) adminOrganizationMFAPolicyGet(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	organization := getOrganization(ctx)
+
+	policy, err := models.FindOrganizationMFAPolicy(db, organization.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeMFAPolicyNotFound, "MFA policy not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading MFA policy").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, policy)
+}
+
+// adminOrganizationMFAPolicyUpdate creates or replaces the MFA policy of an
+// organization. The grace period of existing members starts when the policy
+// is first created, updates do not restart it.
+func (a *API) adminOrganizationMFAPolicyUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+
+	params := &AdminMFAPolicyParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	policy := &models.OrganizationMFAPolicy{
+		OrganizationID:        organization.ID,
+		EnrollmentGracePeriod: params.EnrollmentGracePeriod,
+		MinimumAAL:            params.MinimumAAL,
+	}
+	policy.SetRequiredFactorTypes(params.RequiredFactorTypes)
+	if policy.MinimumAAL == "" {
+		policy.MinimumAAL = models.AAL1.String()
+	}
+
+	if err := policy.Validate(); err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%v", err)
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.SaveOrganizationMFAPolicy(tx, policy); terr != nil {
+			return apierrors.NewInternalServerError("Database error saving MFA policy").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.MFAPolicyModifiedAction, "", map[string]interface{}{
+			"organization_id":         organization.ID,
+			"required_factor_types":   policy.GetRequiredFactorTypes(),
+			"enrollment_grace_period": policy.EnrollmentGracePeriod,
+			"minimum_aal":             policy.MinimumAAL,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, policy)
+}
+
+// adminOrganizationMFAPolicyDelete removes the MFA policy of an organization,
+// MFA is opt-in for its members again.
+func (a *API) adminOrganizationMFAPolicyDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+
+	policy, err := models.FindOrganizationMFAPolicy(db, organization.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeMFAPolicyNotFound, "MFA policy not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading MFA policy").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.MFAPolicyDeletedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := models.DeleteOrganizationMFAPolicy(tx, policy.OrganizationID); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting MFA policy").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
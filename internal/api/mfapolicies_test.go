@@ -0,0 +1,181 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+)
+
+type MFAPoliciesTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	admin *models.User
+	token string
+}
+
+func TestMFAPolicies(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &MFAPoliciesTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *MFAPoliciesTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+	ts.token = ts.makeToken(ts.admin, models.OrganizationRoleAdmin)
+}
+
+func (ts *MFAPoliciesTestSuite) makeToken(user *models.User, organizationRole string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: user.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: organizationRole,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating jwt")
+	return token
+}
+
+func (ts *MFAPoliciesTestSuite) makeRequest(method, path, token string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *MFAPoliciesTestSuite) createMember(email string) *models.User {
+	u, err := models.NewUser("", email, "password", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error making new user")
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_role"), "Error creating user")
+	return u
+}
+
+func (ts *MFAPoliciesTestSuite) TestOrganizationMFAPolicy() {
+	path := fmt.Sprintf("/admin/mfa/organizations/%s", ts.OrganizationID)
+
+	w := ts.makeRequest(http.MethodGet, path, ts.token, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodPut, path, ts.token, map[string]interface{}{
+		"required_factor_types":   []string{models.TOTP, models.WebAuthn},
+		"enrollment_grace_period": 3600,
+		"minimum_aal":             "aal2",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := map[string]interface{}{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	assert.Equal(ts.T(), []interface{}{models.TOTP, models.WebAuthn}, data["required_factor_types"])
+	assert.Equal(ts.T(), float64(3600), data["enrollment_grace_period"])
+
+	policy, err := models.FindOrganizationMFAPolicy(ts.API.db, ts.OrganizationID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), "aal2", policy.MinimumAAL)
+
+	w = ts.makeRequest(http.MethodDelete, path, ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	_, err = models.FindOrganizationMFAPolicy(ts.API.db, ts.OrganizationID)
+	assert.True(ts.T(), models.IsNotFoundError(err))
+}
+
+func (ts *MFAPoliciesTestSuite) TestInvalidMFAPolicy() {
+	path := fmt.Sprintf("/admin/mfa/organizations/%s", ts.OrganizationID)
+
+	for _, body := range []map[string]interface{}{
+		{"required_factor_types": []string{}},
+		{"required_factor_types": []string{"sms"}},
+		{"required_factor_types": []string{models.TOTP}, "enrollment_grace_period": -1},
+		{"required_factor_types": []string{models.TOTP}, "minimum_aal": "aal3"},
+	} {
+		w := ts.makeRequest(http.MethodPut, path, ts.token, body)
+		assert.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+	}
+}
+
+func (ts *MFAPoliciesTestSuite) TestOrganizationAdminScope() {
+	w := ts.makeRequest(http.MethodGet, fmt.Sprintf("/admin/mfa/organizations/%s", uuid.Must(uuid.NewV4())), ts.token, nil)
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+
+	member := ts.createMember("member@example.com")
+	w = ts.makeRequest(http.MethodGet, fmt.Sprintf("/admin/mfa/organizations/%s", ts.OrganizationID), ts.makeToken(member, models.OrganizationRoleClient), nil)
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+}
+
+func (ts *MFAPoliciesTestSuite) TestEnrollmentRequired() {
+	member := ts.createMember("member@example.com")
+	token := ts.makeToken(member, models.OrganizationRoleClient)
+
+	// Members can use their session as usual during the grace period
+	require.NoError(ts.T(), models.SaveOrganizationMFAPolicy(ts.API.db, &models.OrganizationMFAPolicy{
+		OrganizationID:        ts.OrganizationID,
+		RequiredFactorTypes:   models.TOTP,
+		EnrollmentGracePeriod: 3600,
+		MinimumAAL:            "aal1",
+	}))
+
+	w := ts.makeRequest(http.MethodGet, "/user/tier", token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	// Once it is over their session is limited to enrolling a factor
+	require.NoError(ts.T(), models.SaveOrganizationMFAPolicy(ts.API.db, &models.OrganizationMFAPolicy{
+		OrganizationID:      ts.OrganizationID,
+		RequiredFactorTypes: models.TOTP,
+		MinimumAAL:          "aal1",
+	}))
+
+	w = ts.makeRequest(http.MethodGet, "/user/tier", token, nil)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+
+	var httpErr HTTPError
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&httpErr))
+	assert.Equal(ts.T(), apierrors.ErrorCodeMFAEnrollmentRequired, httpErr.ErrorCode)
+
+	w = ts.makeRequest(http.MethodGet, "/user", token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	f := models.NewTOTPFactor(member, "totp")
+	require.NoError(ts.T(), f.SetSecret("secretkey", ts.Config.Security.DBEncryption.Encrypt, ts.Config.Security.DBEncryption.EncryptionKeyID, ts.Config.Security.DBEncryption.EncryptionKey))
+	require.NoError(ts.T(), ts.API.db.Create(f), "Error saving new test factor")
+
+	w = ts.makeRequest(http.MethodPost, fmt.Sprintf("/factors/%s/challenge", f.ID), token, map[string]interface{}{})
+	assert.NotContains(ts.T(), w.Body.String(), apierrors.ErrorCodeMFAEnrollmentRequired)
+
+	require.NoError(ts.T(), f.UpdateStatus(ts.API.db, models.FactorStateVerified))
+
+	w = ts.makeRequest(http.MethodGet, "/user/tier", token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+}
@@ -195,6 +195,11 @@ func (s *Server) OAuthServerGetAuthorization(w http.ResponseWriter, r *http.Requ
 		return err
 	}
 
+	// Users of other tenants must never be handed to the client
+	if err := s.validateClientTenant(r, db, authorization, user); err != nil {
+		return err
+	}
+
 	// Set user_id if not already set
 	if authorization.UserID == nil {
 		// Use transaction to atomically set user and check for auto-approve
@@ -297,6 +302,10 @@ func (s *Server) OAuthServerConsent(w http.ResponseWriter, r *http.Request) erro
 		return err
 	}
 
+	if err := s.validateClientTenant(r, db, authorization, user); err != nil {
+		return err
+	}
+
 	// Process consent in transaction
 	var redirectURL string
 	err = db.Transaction(func(tx *storage.Connection) error {
@@ -435,6 +444,27 @@ func (s *Server) validateAuthorizationOwnership(r *http.Request, authorization *
 	return nil
 }
 
+// validateClientTenant checks if the user belongs to the project, or the
+// organization, of the client the authorization was requested by
+func (s *Server) validateClientTenant(r *http.Request, db *storage.Connection, authorization *models.OAuthServerAuthorization, user *models.User) error {
+	if authorization.Client == nil {
+		return apierrors.NewNotFoundError(apierrors.ErrorCodeOAuthClientNotFound, "OAuth client not found")
+	}
+
+	allowed, err := authorization.Client.AllowsUser(db, user)
+	if err != nil {
+		return apierrors.NewInternalServerError("error checking user tenant").WithInternalError(err)
+	}
+	if !allowed {
+		observability.GetLogEntry(r).Entry.
+			WithField("request_user_id", user.ID).
+			WithField("client_id", authorization.ClientID).
+			Warn("user does not belong to the tenant of the client")
+		return apierrors.NewForbiddenError(apierrors.ErrorCodeOAuthClientUserNotAllowed, "user is not allowed to authorize this client")
+	}
+	return nil
+}
+
 // validateBasicAuthorizeParams validates only client_id and redirect_uri (needed before we can redirect errors)
 func (s *Server) validateBasicAuthorizeParams(params *AuthorizeParams) (*AuthorizeParams, error) {
 	if params.ClientID == "" {
@@ -30,6 +30,9 @@ type OAuthServerClientResponse struct {
 	ClientSecret string `json:"client_secret,omitempty"` // only returned on registration
 	ClientType   string `json:"client_type"`
 
+	OrganizationID string `json:"organization_id,omitempty"`
+	ProjectID      string `json:"project_id,omitempty"`
+
 	RedirectURIs            []string `json:"redirect_uris,omitempty"`
 	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method,omitempty"`
 	GrantTypes              []string `json:"grant_types,omitempty"`
@@ -81,6 +84,13 @@ func oauthServerClientToResponse(client *models.OAuthServerClient) *OAuthServerC
 		UpdatedAt:        client.UpdatedAt,
 	}
 
+	if client.ProjectID != uuid.Nil {
+		response.ProjectID = client.ProjectID.String()
+	}
+	if client.OrganizationID.Valid {
+		response.OrganizationID = client.OrganizationID.UUID.String()
+	}
+
 	return response
 }
 
@@ -109,6 +119,14 @@ func (s *Server) LoadOAuthServerClient(w http.ResponseWriter, r *http.Request) (
 		return nil, apierrors.NewInternalServerError("Error loading OAuth client").WithInternalError(err)
 	}
 
+	// Clients of other tenants are not visible to organization and project admins
+	if tenant := shared.GetTenant(ctx); tenant != nil {
+		if (tenant.ProjectID != uuid.Nil && client.ProjectID != tenant.ProjectID) ||
+			(tenant.OrganizationID != uuid.Nil && client.OrganizationID.UUID != tenant.OrganizationID) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOAuthClientNotFound, "OAuth client not found")
+		}
+	}
+
 	ctx = shared.WithOAuthServerClient(ctx, client)
 	return ctx, nil
 }
@@ -125,6 +143,10 @@ func (s *Server) AdminOAuthServerClientRegister(w http.ResponseWriter, r *http.R
 	// Force registration type to manual for admin endpoint
 	params.RegistrationType = "manual"
 
+	if err := s.resolveClientTenant(ctx, &params); err != nil {
+		return err
+	}
+
 	client, plaintextSecret, err := s.registerOAuthServerClient(ctx, &params)
 	if err != nil {
 		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%s", err.Error())
@@ -154,6 +176,10 @@ func (s *Server) OAuthServerClientDynamicRegister(w http.ResponseWriter, r *http
 
 	params.RegistrationType = "dynamic"
 
+	if err := s.resolveClientTenant(ctx, &params); err != nil {
+		return err
+	}
+
 	client, plaintextSecret, err := s.registerOAuthServerClient(ctx, &params)
 	if err != nil {
 		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%s", err.Error())
@@ -243,8 +269,18 @@ func (s *Server) OAuthServerClientList(w http.ResponseWriter, r *http.Request) e
 	db := s.db.WithContext(ctx)
 
 	// TODO(cemal) :: Add pagination, check the `/admin/users` endpoint for reference
+	query := db.Q().Where("deleted_at is null")
+	if tenant := shared.GetTenant(ctx); tenant != nil {
+		if tenant.ProjectID != uuid.Nil {
+			query = query.Where("project_id = ?", tenant.ProjectID)
+		}
+		if tenant.OrganizationID != uuid.Nil {
+			query = query.Where("organization_id = ?", tenant.OrganizationID)
+		}
+	}
+
 	var clients []models.OAuthServerClient
-	if err := db.Q().Where("deleted_at is null").Order("created_at desc").All(&clients); err != nil {
+	if err := query.Order("created_at desc").All(&clients); err != nil {
 		return apierrors.NewInternalServerError("Error listing OAuth clients").WithInternalError(err)
 	}
 
@@ -394,6 +430,12 @@ func (s *Server) handleAuthorizationCodeGrant(ctx context.Context, w http.Respon
 		return apierrors.NewOAuthError("access_denied", "User is banned")
 	}
 
+	if allowed, err := client.AllowsUser(db, user); err != nil {
+		return apierrors.NewInternalServerError("Error checking user tenant").WithInternalError(err)
+	} else if !allowed {
+		return apierrors.NewOAuthError("access_denied", "User is not allowed to use this client")
+	}
+
 	// Exchange the authorization code for tokens
 	var tokenResponse *tokens.AccessTokenResponse
 	var grantParams models.GrantParams
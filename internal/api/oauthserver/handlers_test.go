@@ -71,6 +71,7 @@ func (ts *OAuthClientTestSuite) createTestOAuthClient() (*models.OAuthServerClie
 	params := &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"https://example.com/callback", "http://localhost:3000/callback"},
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "dynamic",
 	}
 
@@ -89,6 +90,7 @@ func (ts *OAuthClientTestSuite) TestAdminOAuthServerClientRegisterHandler() {
 	payload := OAuthServerClientRegisterParams{
 		ClientName:   "Test Admin Client",
 		RedirectURIs: []string{"https://example.com/callback"},
+		ProjectID:    ts.ProjectID,
 	}
 
 	body, err := json.Marshal(payload)
@@ -123,6 +125,7 @@ func (ts *OAuthClientTestSuite) TestOAuthServerClientDynamicRegisterHandler() {
 	payload := OAuthServerClientRegisterParams{
 		ClientName:   "Test Dynamic Client",
 		RedirectURIs: []string{"https://app.example.com/callback"},
+		ProjectID:    ts.ProjectID,
 		ClientURI:    "https://app.example.com",
 	}
 
@@ -157,6 +160,7 @@ func (ts *OAuthClientTestSuite) TestOAuthServerClientDynamicRegisterDisabled() {
 	payload := OAuthServerClientRegisterParams{
 		ClientName:   "Test Client",
 		RedirectURIs: []string{"https://example.com/callback"},
+		ProjectID:    ts.ProjectID,
 	}
 
 	body, err := json.Marshal(payload)
@@ -253,6 +257,69 @@ func (ts *OAuthClientTestSuite) TestOAuthServerClientListHandler() {
 	}
 }
 
+func (ts *OAuthClientTestSuite) TestOAuthServerClientTenantScoping() {
+	projectClient, _ := ts.createTestOAuthClient()
+
+	tenant := &shared.Tenant{OrganizationID: ts.OrganizationID, ProjectID: ts.ProjectID}
+
+	// Organization admins register clients in their own organization
+	payload := OAuthServerClientRegisterParams{
+		ClientName:   "Organization Client",
+		RedirectURIs: []string{"https://example.com/callback"},
+	}
+	body, err := json.Marshal(payload)
+	require.NoError(ts.T(), err)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/oauth/clients", bytes.NewReader(body))
+	req = req.WithContext(shared.WithTenant(req.Context(), tenant))
+	w := httptest.NewRecorder()
+	require.NoError(ts.T(), ts.Server.AdminOAuthServerClientRegister(w, req))
+	require.Equal(ts.T(), http.StatusCreated, w.Code)
+
+	var created OAuthServerClientResponse
+	require.NoError(ts.T(), json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(ts.T(), ts.OrganizationID.String(), created.OrganizationID)
+	assert.Equal(ts.T(), ts.ProjectID.String(), created.ProjectID)
+
+	// ... and not in any other one
+	payload.OrganizationID = uuid.Must(uuid.NewV4())
+	body, err = json.Marshal(payload)
+	require.NoError(ts.T(), err)
+
+	req = httptest.NewRequest(http.MethodPost, "/admin/oauth/clients", bytes.NewReader(body))
+	req = req.WithContext(shared.WithTenant(req.Context(), tenant))
+	err = ts.Server.AdminOAuthServerClientRegister(httptest.NewRecorder(), req)
+	require.Error(ts.T(), err)
+	assert.Contains(ts.T(), err.Error(), "Not allowed to manage another organization")
+
+	// Only the clients of the organization are listed
+	req = httptest.NewRequest(http.MethodGet, "/admin/oauth/clients", nil)
+	req = req.WithContext(shared.WithTenant(req.Context(), tenant))
+	w = httptest.NewRecorder()
+	require.NoError(ts.T(), ts.Server.OAuthServerClientList(w, req))
+
+	var response OAuthServerClientListResponse
+	require.NoError(ts.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(ts.T(), response.Clients, 1)
+	assert.Equal(ts.T(), created.ClientID, response.Clients[0].ClientID)
+	assert.NotEqual(ts.T(), projectClient.ID.String(), response.Clients[0].ClientID)
+}
+
+func (ts *OAuthClientTestSuite) TestValidateClientTenant() {
+	client, _ := ts.createTestOAuthClient()
+	user := ts.createTestUser("tenant@example.com")
+	authorization := &models.OAuthServerAuthorization{ClientID: client.ID, Client: client}
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth/authorizations/test", nil)
+	require.NoError(ts.T(), ts.Server.validateClientTenant(req, ts.DB, authorization, user))
+
+	// Users of another project must never be handed to the client
+	client.ProjectID = uuid.Must(uuid.NewV4())
+	err := ts.Server.validateClientTenant(req, ts.DB, authorization, user)
+	require.Error(ts.T(), err)
+	assert.Contains(ts.T(), err.Error(), "user is not allowed to authorize this client")
+}
+
 func (ts *OAuthClientTestSuite) TestOAuthServerClientUpdateHandler() {
 	// Create a test client first
 	client, _ := ts.createTestOAuthClient()
@@ -426,6 +493,7 @@ func (ts *OAuthClientTestSuite) TestHandlerValidation() {
 	payload := OAuthServerClientRegisterParams{
 		ClientName:   "Test Client",
 		RedirectURIs: []string{"invalid-uri"}, // Invalid URI
+		ProjectID:    ts.ProjectID,
 	}
 
 	body, err := json.Marshal(payload)
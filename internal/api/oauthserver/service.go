@@ -14,7 +14,9 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/api/shared"
 	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
 	"github.com/supabase/auth/internal/utilities"
 )
 
@@ -112,6 +114,10 @@ type OAuthServerClientRegisterParams struct {
 	ClientURI  string   `json:"client_uri,omitempty"`
 	LogoURI    string   `json:"logo_uri,omitempty"`
 
+	// Tenant of the client, the project is derived from the organization when omitted
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	ProjectID      uuid.UUID `json:"project_id,omitempty"`
+
 	// Internal field
 	RegistrationType string `json:"-"`
 }
@@ -167,6 +173,63 @@ func (p *OAuthServerClientRegisterParams) validate() error {
 		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%s", err.Error())
 	}
 
+	if p.ProjectID == uuid.Nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "project_id is required")
+	}
+
+	return nil
+}
+
+// resolveClientTenant fills in the tenant of a client being registered. Admins
+// limited to an organization or a project can only register clients in their
+// own tenant, and an organization has to belong to the project of the client.
+func (s *Server) resolveClientTenant(ctx context.Context, params *OAuthServerClientRegisterParams) error {
+	if tenant := shared.GetTenant(ctx); tenant != nil {
+		if tenant.OrganizationID != uuid.Nil {
+			if params.OrganizationID != uuid.Nil && params.OrganizationID != tenant.OrganizationID {
+				return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to manage another organization")
+			}
+			params.OrganizationID = tenant.OrganizationID
+		}
+		if tenant.ProjectID != uuid.Nil {
+			if params.ProjectID != uuid.Nil && params.ProjectID != tenant.ProjectID {
+				return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to manage another project")
+			}
+			params.ProjectID = tenant.ProjectID
+		}
+	}
+
+	db := s.db.WithContext(ctx)
+
+	if params.OrganizationID != uuid.Nil {
+		// Read in a transaction so that the row level security policies apply
+		// to the admin's tenant.
+		var organization *models.Organization
+		err := db.Transaction(func(tx *storage.Connection) error {
+			var terr error
+			organization, terr = models.FindOrganizationByID(tx, params.OrganizationID)
+			return terr
+		})
+		if err != nil {
+			if models.IsNotFoundError(err) {
+				return apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+			}
+			return apierrors.NewInternalServerError("Error loading organization").WithInternalError(err)
+		}
+		if params.ProjectID == uuid.Nil {
+			params.ProjectID = organization.ProjectID
+		} else if params.ProjectID != organization.ProjectID {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+		}
+	} else if params.ProjectID != uuid.Nil {
+		if _, err := models.FindProjectByID(db, params.ProjectID); err != nil {
+			if models.IsNotFoundError(err) {
+				return apierrors.NewNotFoundError(apierrors.ErrorCodeProjectNotFound, "Project not found")
+			}
+			return apierrors.NewInternalServerError("Error loading project").WithInternalError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -257,6 +320,11 @@ func (s *Server) registerOAuthServerClient(ctx context.Context, params *OAuthSer
 		ClientName:       utilities.StringPtr(params.ClientName),
 		ClientURI:        utilities.StringPtr(params.ClientURI),
 		LogoURI:          utilities.StringPtr(params.LogoURI),
+		ProjectID:        params.ProjectID,
+		OrganizationID: uuid.NullUUID{
+			UUID:  params.OrganizationID,
+			Valid: params.OrganizationID != uuid.Nil,
+		},
 	}
 
 	client.SetRedirectURIs(params.RedirectURIs)
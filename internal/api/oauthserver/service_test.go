@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -20,9 +21,10 @@ const serviceTestConfig = "../../../hack/test.env"
 // OAuthServiceTestSuite runs tests for OAuth service layer functionality
 type OAuthServiceTestSuite struct {
 	suite.Suite
-	Server *Server
-	Config *conf.GlobalConfiguration
-	DB     *storage.Connection
+	Server    *Server
+	Config    *conf.GlobalConfiguration
+	DB        *storage.Connection
+	ProjectID uuid.UUID
 }
 
 func TestOAuthService(t *testing.T) {
@@ -53,9 +55,7 @@ func TestOAuthService(t *testing.T) {
 }
 
 func (ts *OAuthServiceTestSuite) SetupTest() {
-	if ts.DB != nil {
-		models.TruncateAll(ts.DB)
-	}
+	ts.ProjectID, _, _ = models.InitializeTestDatabase(ts.T(), ts.DB, ts.Config)
 	// Enable OAuth server and dynamic client registration for tests
 	ts.Config.OAuthServer.Enabled = true
 	ts.Config.OAuthServer.AllowDynamicRegistration = true
@@ -66,6 +66,7 @@ func (ts *OAuthServiceTestSuite) createTestOAuthClient() (*models.OAuthServerCli
 	params := &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"https://example.com/callback", "http://localhost:3000/callback"},
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "dynamic",
 	}
 
@@ -85,6 +86,7 @@ func (ts *OAuthServiceTestSuite) TestOAuthServerClientServiceMethods() {
 	params := &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"https://example.com/callback"},
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "dynamic",
 	}
 
@@ -153,6 +155,7 @@ func (ts *OAuthServiceTestSuite) TestValidationEdgeCases() {
 	params := &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{}, // Empty array
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "dynamic",
 	}
 
@@ -165,6 +168,7 @@ func (ts *OAuthServiceTestSuite) TestValidationEdgeCases() {
 	params = &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"invalid-uri"}, // Invalid URI
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "dynamic",
 	}
 
@@ -176,6 +180,7 @@ func (ts *OAuthServiceTestSuite) TestValidationEdgeCases() {
 	params = &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     make([]string, 11), // Too many URIs
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "dynamic",
 	}
 
@@ -192,6 +197,7 @@ func (ts *OAuthServiceTestSuite) TestValidationEdgeCases() {
 	params = &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"https://example.com/callback"},
+		ProjectID:        ts.ProjectID,
 		GrantTypes:       []string{"invalid_grant_type"},
 		RegistrationType: "dynamic",
 	}
@@ -204,6 +210,7 @@ func (ts *OAuthServiceTestSuite) TestValidationEdgeCases() {
 	params = &OAuthServerClientRegisterParams{
 		ClientName:       string(make([]byte, 1025)), // Too long
 		RedirectURIs:     []string{"https://example.com/callback"},
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "dynamic",
 	}
 
@@ -215,6 +222,7 @@ func (ts *OAuthServiceTestSuite) TestValidationEdgeCases() {
 	params = &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"https://example.com/callback"},
+		ProjectID:        ts.ProjectID,
 		ClientURI:        "not-a-valid-url",
 		RegistrationType: "dynamic",
 	}
@@ -227,6 +235,7 @@ func (ts *OAuthServiceTestSuite) TestValidationEdgeCases() {
 	params = &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"https://example.com/callback"},
+		ProjectID:        ts.ProjectID,
 		LogoURI:          "not-a-valid-url",
 		RegistrationType: "dynamic",
 	}
@@ -239,6 +248,7 @@ func (ts *OAuthServiceTestSuite) TestValidationEdgeCases() {
 	params = &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"https://example.com/callback"},
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "invalid",
 	}
 
@@ -322,6 +332,7 @@ func (ts *OAuthServiceTestSuite) TestGrantTypeDefaults() {
 	params := &OAuthServerClientRegisterParams{
 		ClientName:       "Test Client",
 		RedirectURIs:     []string{"https://example.com/callback"},
+		ProjectID:        ts.ProjectID,
 		RegistrationType: "dynamic",
 		// GrantTypes not specified
 	}
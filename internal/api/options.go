@@ -34,6 +34,7 @@ func WithTokenService(service *tokens.Service) Option {
 type LimiterOptions struct {
 	Email ratelimit.Limiter
 	Phone ratelimit.Limiter
+	Tiers *TierLimiters
 
 	Signups             *limiter.Limiter
 	AnonymousSignIns    *limiter.Limiter
@@ -59,6 +60,7 @@ func NewLimiterOptions(gc *conf.GlobalConfiguration) *LimiterOptions {
 
 	o.Email = ratelimit.New(gc.RateLimitEmailSent)
 	o.Phone = ratelimit.New(gc.RateLimitSmsSent)
+	o.Tiers = NewTierLimiters()
 
 	o.AnonymousSignIns = tollbooth.NewLimiter(gc.RateLimitAnonymousUsers/(60*60),
 		&limiter.ExpirableOptions{
@@ -0,0 +1,394 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type AdminOrganizationParams struct {
+	ProjectID   uuid.UUID                    `json:"project_id"`
+	AdminID     uuid.UUID                    `json:"admin_id"`
+	Name        *string                      `json:"name"`
+	Description *string                      `json:"description"`
+	Tier        *AdminOrganizationTierParams `json:"tier,omitempty"`
+}
+
+type AdminOrganizationTierParams struct {
+	Tier            string `json:"tier"`
+	AdminTierModel  string `json:"admin_tier_model"`
+	ClientTierModel string `json:"client_tier_model"`
+	AdminTierTime   string `json:"admin_tier_time"`
+	ClientTierTime  string `json:"client_tier_time"`
+	AdminTierUsage  string `json:"admin_tier_usage"`
+	ClientTierUsage string `json:"client_tier_usage"`
+}
+
+type AdminListOrganizationsResponse struct {
+	Organizations []*models.Organization `json:"organizations"`
+}
+
+// apply overrides the tier values that were provided in the request.
+func (p *AdminOrganizationTierParams) apply(tier *models.OrganizationTier) error {
+	if p.Tier != "" {
+		tier.Tier = p.Tier
+	}
+	if p.AdminTierModel != "" {
+		tier.AdminTierModel = p.AdminTierModel
+	}
+	if p.ClientTierModel != "" {
+		tier.ClientTierModel = p.ClientTierModel
+	}
+	if p.AdminTierTime != "" {
+		tier.AdminTierTime = p.AdminTierTime
+	}
+	if p.ClientTierTime != "" {
+		tier.ClientTierTime = p.ClientTierTime
+	}
+	if p.AdminTierUsage != "" {
+		tier.AdminTierUsage = p.AdminTierUsage
+	}
+	if p.ClientTierUsage != "" {
+		tier.ClientTierUsage = p.ClientTierUsage
+	}
+
+	if err := tier.Validate(); err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%s", err.Error())
+	}
+	return nil
+}
+
+// loadOrganization loads the organization in the organization_id URL param.
+// Admins whose token is bound to a project can only load organizations of
+// that project.
+func (a *API) loadOrganization(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	organizationID, err := uuid.FromString(chi.URLParam(r, "organization_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "organization_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "organization_id", organizationID)
+
+	var organization *models.Organization
+	err = db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		organization, terr = models.FindOrganizationByID(tx, organizationID)
+		return terr
+	})
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading organization").WithInternalError(err)
+	}
+
+	if projectID := a.requestProjectID(ctx, r); projectID != uuid.Nil && organization.ProjectID != projectID {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+	}
+
+	return withOrganization(ctx, organization), nil
+}
+
+// adminOrganizations responds with a list of organizations, restricted to the
+// project in the project_id query param or in the admin's token.
+func (a *API) adminOrganizations(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	projectID := a.requestProjectID(ctx, r)
+	if qp := r.URL.Query().Get("project_id"); qp != "" {
+		id, err := uuid.FromString(qp)
+		if err != nil {
+			return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "project_id must be an UUID")
+		}
+		if projectID != uuid.Nil && id != projectID {
+			return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to list organizations of another project")
+		}
+		projectID = id
+	}
+
+	pageParams, err := paginate(r)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Pagination Parameters: %v", err).WithInternalError(err)
+	}
+
+	sortParams, err := sort(r, map[string]bool{models.CreatedAt: true}, []models.SortField{{Name: models.CreatedAt, Dir: models.Descending}})
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Sort Parameters: %v", err)
+	}
+
+	filter := r.URL.Query().Get("filter")
+
+	var organizations []*models.Organization
+	err = db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		organizations, terr = models.FindOrganizations(tx, projectID, pageParams, sortParams, filter)
+		return terr
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error finding organizations").WithInternalError(err)
+	}
+	addPaginationHeaders(w, r, pageParams)
+
+	return sendJSON(w, http.StatusOK, AdminListOrganizationsResponse{
+		Organizations: organizations,
+	})
+}
+
+// adminOrganizationCreate creates a new organization owned by an existing
+// user of the same project. The owner becomes an admin of the organization.
+func (a *API) adminOrganizationCreate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+
+	params := &AdminOrganizationParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	projectID := a.requestProjectID(ctx, r)
+	if params.ProjectID != uuid.Nil {
+		if projectID != uuid.Nil && params.ProjectID != projectID {
+			return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to create organizations in another project")
+		}
+		projectID = params.ProjectID
+	}
+	if projectID == uuid.Nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "project_id is required")
+	}
+
+	if params.Name == nil || *params.Name == "" {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "name is required")
+	}
+
+	if params.AdminID == uuid.Nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "admin_id is required")
+	}
+
+	owner, err := models.FindUserByID(db, params.AdminID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeUserNotFound, "User not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading user").WithInternalError(err)
+	}
+	if owner.ProjectID != projectID {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "admin_id must belong to the organization's project")
+	}
+	if owner.OrganizationID.Valid {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeValidationFailed, "User already belongs to an organization")
+	}
+
+	var description string
+	if params.Description != nil {
+		description = *params.Description
+	}
+
+	organization, err := models.NewOrganization(projectID, owner.ID, *params.Name, description)
+	if err != nil {
+		return apierrors.NewInternalServerError("Error creating organization").WithInternalError(err)
+	}
+
+	tier := models.NewOrganizationTier(organization.ID)
+	if params.Tier != nil {
+		if err := params.Tier.apply(tier); err != nil {
+			return err
+		}
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := tx.Create(organization); terr != nil {
+			return terr
+		}
+
+		if terr := models.SaveOrganizationTier(tx, tier); terr != nil {
+			return terr
+		}
+
+		if terr := owner.SetOrganization(tx, organization.ID, models.OrganizationRoleAdmin); terr != nil {
+			return terr
+		}
+
+		return models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationCreatedAction, "", map[string]interface{}{
+			"organization_id":   organization.ID,
+			"organization_name": organization.Name,
+			"project_id":        organization.ProjectID,
+			"admin_id":          organization.AdminID,
+		})
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error creating organization").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusCreated, organization)
+}
+
+// adminOrganizationGet returns a single organization.
+func (a *API) adminOrganizationGet(w http.ResponseWriter, r *http.Request) error {
+	organization := getOrganization(r.Context())
+
+	return sendJSON(w, http.StatusOK, organization)
+}
+
+// adminOrganizationUpdate updates the name or description of an organization.
+func (a *API) adminOrganizationUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+
+	params := &AdminOrganizationParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	if params.ProjectID != uuid.Nil && params.ProjectID != organization.ProjectID {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Organizations cannot be moved to another project")
+	}
+
+	if params.Name != nil && *params.Name == "" {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "name cannot be empty")
+	}
+
+	// The prevent_admin_fkey_change trigger makes the admin of an
+	// organization immutable.
+	if params.AdminID != uuid.Nil && params.AdminID != organization.AdminID {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeValidationFailed, "The admin of an organization cannot be changed")
+	}
+
+	name := organization.Name
+	if params.Name != nil {
+		name = *params.Name
+	}
+	description := organization.Description.String()
+	if params.Description != nil {
+		description = *params.Description
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		changed, terr := organization.UpdateInfo(tx, name, description)
+		if terr != nil || len(changed) == 0 {
+			return terr
+		}
+
+		return models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationModifiedAction, "", map[string]interface{}{
+			"organization_id":   organization.ID,
+			"organization_name": organization.Name,
+			"project_id":        organization.ProjectID,
+			"admin_id":          organization.AdminID,
+			"changed":           changed,
+		})
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Error updating organization").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, organization)
+}
+
+// adminOrganizationDelete deletes an organization. Its tier and all of its
+// users are removed with it.
+func (a *API) adminOrganizationDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationDeletedAction, "", map[string]interface{}{
+			"organization_id":   organization.ID,
+			"organization_name": organization.Name,
+			"project_id":        organization.ProjectID,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(organization); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting organization").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// adminOrganizationTierGet returns the tier assigned to an organization.
+func (a *API) adminOrganizationTierGet(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	organization := getOrganization(ctx)
+
+	tier, err := models.FindOrganizationTierByOrganizationID(db, organization.ID)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading organization tier").WithInternalError(err)
+	}
+	if tier == nil {
+		tier = models.NewOrganizationTier(organization.ID)
+	}
+
+	return sendJSON(w, http.StatusOK, tier)
+}
+
+// adminOrganizationTierUpdate assigns a tier to an organization. Values that
+// are not provided keep their current value.
+func (a *API) adminOrganizationTierUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+
+	params := &AdminOrganizationTierParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	var tier *models.OrganizationTier
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		tier, terr = models.FindOrganizationTierByOrganizationID(tx, organization.ID)
+		if terr != nil {
+			return apierrors.NewInternalServerError("Database error loading organization tier").WithInternalError(terr)
+		}
+		if tier == nil {
+			tier = models.NewOrganizationTier(organization.ID)
+		}
+
+		if terr := params.apply(tier); terr != nil {
+			return terr
+		}
+
+		if terr := models.SaveOrganizationTier(tx, tier); terr != nil {
+			return apierrors.NewInternalServerError("Database error updating organization tier").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationTierModifiedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"tier":            tier.Tier,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, tier)
+}
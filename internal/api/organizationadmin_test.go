@@ -0,0 +1,247 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type OrganizationAdminTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	token string
+}
+
+func TestOrganizationAdmin(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &OrganizationAdminTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *OrganizationAdminTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	claims := &AccessTokenClaims{
+		Role:      "supabase_admin",
+		ProjectID: ts.ProjectID,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating admin jwt")
+	ts.token = token
+}
+
+func (ts *OrganizationAdminTestSuite) makeRequest(method, path string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.token))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *OrganizationAdminTestSuite) createProjectUser(email string) *models.User {
+	u, err := models.NewUser("", email, "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error making new user")
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_id", "organization_role"), "Error creating user")
+	return u
+}
+
+func (ts *OrganizationAdminTestSuite) TestAdminOrganizationsUnauthorized() {
+	req := httptest.NewRequest(http.MethodGet, "/admin/organizations", nil)
+	w := httptest.NewRecorder()
+
+	ts.API.handler.ServeHTTP(w, req)
+	assert.Equal(ts.T(), http.StatusUnauthorized, w.Code)
+}
+
+func (ts *OrganizationAdminTestSuite) TestAdminOrganizationsList() {
+	w := ts.makeRequest(http.MethodGet, "/admin/organizations", nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	assert.Equal(ts.T(), "</admin/organizations?page=1>; rel=\"last\"", w.Header().Get("Link"))
+	assert.Equal(ts.T(), "1", w.Header().Get("X-Total-Count"))
+
+	data := AdminListOrganizationsResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Organizations, 1)
+	assert.Equal(ts.T(), ts.OrganizationID, data.Organizations[0].ID)
+}
+
+func (ts *OrganizationAdminTestSuite) TestAdminOrganizationsListOtherProject() {
+	w := ts.makeRequest(http.MethodGet, "/admin/organizations?project_id="+uuid.Must(uuid.NewV4()).String(), nil)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+}
+
+func (ts *OrganizationAdminTestSuite) TestAdminOrganizationCreate() {
+	owner := ts.createProjectUser("owner@example.com")
+
+	w := ts.makeRequest(http.MethodPost, "/admin/organizations", map[string]interface{}{
+		"name":        "Acme",
+		"description": "Acme Corp",
+		"admin_id":    owner.ID,
+		"tier": map[string]interface{}{
+			"tier":             "pro",
+			"admin_tier_model": "high",
+		},
+	})
+	require.Equal(ts.T(), http.StatusCreated, w.Code, w.Body.String())
+
+	organization := models.Organization{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&organization))
+	assert.Equal(ts.T(), "Acme", organization.Name)
+	assert.Equal(ts.T(), ts.ProjectID, organization.ProjectID)
+	assert.Equal(ts.T(), owner.ID, organization.AdminID)
+
+	u, err := models.FindUserByID(ts.API.db, owner.ID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), organization.ID, u.OrganizationID.UUID)
+	assert.Equal(ts.T(), "admin", u.OrganizationRole)
+
+	tier, err := models.FindOrganizationTierByOrganizationID(ts.API.db, organization.ID)
+	require.NoError(ts.T(), err)
+	require.NotNil(ts.T(), tier)
+	assert.Equal(ts.T(), "pro", tier.Tier)
+	assert.Equal(ts.T(), "high", tier.AdminTierModel)
+	assert.Equal(ts.T(), "low", tier.ClientTierModel)
+
+	logs, err := models.FindAuditLogEntries(ts.API.db, &models.AuditLogFilter{Action: string(models.OrganizationCreatedAction)}, nil)
+	require.NoError(ts.T(), err)
+	require.Len(ts.T(), logs, 1)
+}
+
+func (ts *OrganizationAdminTestSuite) TestAdminOrganizationCreateValidation() {
+	owner := ts.createProjectUser("owner@example.com")
+
+	cases := []struct {
+		desc string
+		body map[string]interface{}
+		code int
+	}{
+		{
+			desc: "Missing name",
+			body: map[string]interface{}{"admin_id": owner.ID},
+			code: http.StatusBadRequest,
+		},
+		{
+			desc: "Missing admin",
+			body: map[string]interface{}{"name": "Acme"},
+			code: http.StatusBadRequest,
+		},
+		{
+			desc: "Unknown admin",
+			body: map[string]interface{}{"name": "Acme", "admin_id": uuid.Must(uuid.NewV4())},
+			code: http.StatusNotFound,
+		},
+		{
+			desc: "Invalid tier",
+			body: map[string]interface{}{"name": "Acme", "admin_id": owner.ID, "tier": map[string]interface{}{"admin_tier_time": "forever"}},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, c := range cases {
+		ts.Run(c.desc, func() {
+			w := ts.makeRequest(http.MethodPost, "/admin/organizations", c.body)
+			require.Equal(ts.T(), c.code, w.Code, w.Body.String())
+		})
+	}
+}
+
+func (ts *OrganizationAdminTestSuite) TestAdminOrganizationGetUpdateDelete() {
+	path := "/admin/organizations/" + ts.OrganizationID.String()
+
+	w := ts.makeRequest(http.MethodGet, path, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	w = ts.makeRequest(http.MethodPut, path, map[string]interface{}{
+		"name": "Renamed",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	var organization *models.Organization
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		var terr error
+		organization, terr = models.FindOrganizationByID(tx, ts.OrganizationID)
+		return terr
+	}))
+	assert.Equal(ts.T(), "Renamed", organization.Name)
+
+	// Updates that change nothing are not audited
+	w = ts.makeRequest(http.MethodPut, path, map[string]interface{}{
+		"name": "Renamed",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	logs := []models.AuditLogEntry{}
+	require.NoError(ts.T(), ts.API.db.Q().Where("payload->>'action' = ?", models.OrganizationModifiedAction).All(&logs))
+	require.Len(ts.T(), logs, 1)
+	traits, ok := logs[0].Payload["traits"].(map[string]interface{})
+	require.True(ts.T(), ok)
+	assert.Equal(ts.T(), []interface{}{"name"}, traits["changed"])
+
+	w = ts.makeRequest(http.MethodDelete, path, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	err := withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		_, terr := models.FindOrganizationByID(tx, ts.OrganizationID)
+		return terr
+	})
+	require.True(ts.T(), models.IsNotFoundError(err))
+}
+
+func (ts *OrganizationAdminTestSuite) TestAdminOrganizationTier() {
+	path := "/admin/organizations/" + ts.OrganizationID.String() + "/tier"
+
+	w := ts.makeRequest(http.MethodGet, path, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	w = ts.makeRequest(http.MethodPut, path, map[string]interface{}{
+		"tier":              "enterprise",
+		"client_tier_usage": "high",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	tier := models.OrganizationTier{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&tier))
+	assert.Equal(ts.T(), "enterprise", tier.Tier)
+	assert.Equal(ts.T(), "high", tier.ClientTierUsage)
+
+	w = ts.makeRequest(http.MethodPut, path, map[string]interface{}{
+		"client_tier_usage": "unlimited",
+	})
+	require.Equal(ts.T(), http.StatusBadRequest, w.Code)
+}
+
+func (ts *OrganizationAdminTestSuite) TestAdminOrganizationNotFound() {
+	w := ts.makeRequest(http.MethodGet, "/admin/organizations/"+uuid.Must(uuid.NewV4()).String(), nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+}
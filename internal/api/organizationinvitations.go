@@ -0,0 +1,447 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/fatih/structs"
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/api/provider"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/storage"
+	"github.com/supabase/auth/internal/tokens"
+)
+
+// organizationInvitationExpiry is how long an invitation can be accepted
+// after it was created or resent.
+const organizationInvitationExpiry = 7 * 24 * time.Hour
+
+type AdminOrganizationInvitationParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+}
+
+type AcceptOrganizationInvitationParams struct {
+	Token    string                 `json:"token"`
+	Password string                 `json:"password"`
+	Data     map[string]interface{} `json:"data"`
+}
+
+type AdminListOrganizationInvitationsResponse struct {
+	Invitations []*models.OrganizationInvitation `json:"invitations"`
+}
+
+// loadOrganizationInvitation loads the invitation in the invitation_id URL
+// param and its organization. Invitations outside of the admin's tenant are
+// reported as not found.
+func (a *API) loadOrganizationInvitation(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	invitationID, err := uuid.FromString(chi.URLParam(r, "invitation_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "invitation_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "invitation_id", invitationID)
+
+	invitation, err := models.FindOrganizationInvitationByID(db, invitationID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationInvitationNotFound, "Organization invitation not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading organization invitation").WithInternalError(err)
+	}
+
+	organization, err := a.adminOrganization(ctx, r, invitation.OrganizationID)
+	if err != nil {
+		if herr, ok := err.(*HTTPError); ok && herr.HTTPStatus < http.StatusInternalServerError {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationInvitationNotFound, "Organization invitation not found")
+		}
+		return nil, err
+	}
+
+	ctx = withOrganization(ctx, organization)
+	return withOrganizationInvitation(ctx, invitation), nil
+}
+
+// adminOrganizationInvitations lists the pending invitations of an
+// organization.
+func (a *API) adminOrganizationInvitations(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	var organizationID uuid.UUID
+	if qp := r.URL.Query().Get("organization_id"); qp != "" {
+		id, err := uuid.FromString(qp)
+		if err != nil {
+			return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "organization_id must be an UUID")
+		}
+		organizationID = id
+	}
+
+	organization, err := a.adminOrganization(ctx, r, organizationID)
+	if err != nil {
+		return err
+	}
+
+	pageParams, err := paginate(r)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Pagination Parameters: %v", err).WithInternalError(err)
+	}
+
+	invitations, err := models.FindPendingOrganizationInvitations(db, organization.ID, pageParams)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error finding organization invitations").WithInternalError(err)
+	}
+	addPaginationHeaders(w, r, pageParams)
+
+	return sendJSON(w, http.StatusOK, AdminListOrganizationInvitationsResponse{
+		Invitations: invitations,
+	})
+}
+
+// adminOrganizationInvitationCreate invites an email address into an
+// organization with the given role and sends the invitation email.
+func (a *API) adminOrganizationInvitationCreate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+
+	params := &AdminOrganizationInvitationParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	organization, err := a.adminOrganization(ctx, r, params.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	params.Email, err = a.validateEmail(params.Email)
+	if err != nil {
+		return err
+	}
+
+	if params.Role == "" {
+		params.Role = models.OrganizationRoleClient
+	}
+	if !slices.Contains(models.OrganizationInvitationRoles, params.Role) {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid invitation role %q", params.Role)
+	}
+
+	invitee, err := a.findInvitee(db, params.Email, a.requestAud(ctx, r), organization)
+	if err != nil {
+		return err
+	}
+	if invitee != nil {
+		if _, err := invitee.FindOrganizationMembership(db, organization.ID); err == nil {
+			return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeOrganizationMemberExists, "User is already a member of the organization")
+		} else if !models.IsNotFoundError(err) {
+			return apierrors.NewInternalServerError("Database error loading organization membership").WithInternalError(err)
+		}
+	} else {
+		invitee = &models.User{
+			Email:     storage.NullString(params.Email),
+			ProjectID: organization.ProjectID,
+		}
+	}
+
+	if _, err := models.FindPendingOrganizationInvitation(db, organization.ID, params.Email); err == nil {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeOrganizationInvitationExists, "A pending invitation already exists for this email address")
+	} else if !models.IsNotFoundError(err) {
+		return apierrors.NewInternalServerError("Database error loading organization invitation").WithInternalError(err)
+	}
+
+	// API keys act as admins but are not users that can be referenced
+	var inviterID uuid.UUID
+	if adminUser != nil && getCallerAPIKey(ctx) == nil {
+		inviterID = adminUser.ID
+	}
+
+	invitation, token, err := models.NewOrganizationInvitation(organization.ID, params.Email, params.Role, inviterID, organizationInvitationExpiry)
+	if err != nil {
+		return apierrors.NewInternalServerError("Error creating organization invitation").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := tx.Create(invitation); terr != nil {
+			return apierrors.NewInternalServerError("Database error creating organization invitation").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationInvitationCreatedAction, "", map[string]interface{}{
+			"organization_id":   organization.ID,
+			"invitation_id":     invitation.ID,
+			"email":             invitation.Email,
+			"organization_role": invitation.Role,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		return a.sendOrganizationInvite(r, tx, invitee, invitation, organization, token)
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusCreated, invitation)
+}
+
+// adminOrganizationInvitationResend issues a new token for a pending
+// invitation, extends its expiry and sends the invitation email again.
+func (a *API) adminOrganizationInvitationResend(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+	invitation := getOrganizationInvitation(ctx)
+
+	if invitation.IsAccepted() {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeValidationFailed, "Invitation has already been accepted")
+	}
+
+	invitee, err := a.findInvitee(db, invitation.Email, a.requestAud(ctx, r), organization)
+	if err != nil {
+		return err
+	}
+	if invitee == nil {
+		invitee = &models.User{
+			Email:     storage.NullString(invitation.Email),
+			ProjectID: organization.ProjectID,
+		}
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		token, terr := invitation.Renew(tx, organizationInvitationExpiry)
+		if terr != nil {
+			return apierrors.NewInternalServerError("Database error renewing organization invitation").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationInvitationResentAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"invitation_id":   invitation.ID,
+			"email":           invitation.Email,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		return a.sendOrganizationInvite(r, tx, invitee, invitation, organization, token)
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, invitation)
+}
+
+// adminOrganizationInvitationDelete revokes a pending invitation.
+func (a *API) adminOrganizationInvitationDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+	invitation := getOrganizationInvitation(ctx)
+
+	if invitation.IsAccepted() {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeValidationFailed, "Invitation has already been accepted")
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationInvitationRevokedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"invitation_id":   invitation.ID,
+			"email":           invitation.Email,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(invitation); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting organization invitation").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// AcceptOrganizationInvitation accepts an invitation with the token from the
+// invitation email. An existing user with the invited email joins the
+// organization, otherwise a new user is created in it. Since the token proves
+// ownership of the email address, the user is signed in.
+func (a *API) AcceptOrganizationInvitation(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+
+	params := &AcceptOrganizationInvitationParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	if params.Token == "" {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "token is required")
+	}
+
+	invitation, err := models.FindOrganizationInvitationByToken(db, params.Token)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationInvitationNotFound, "Organization invitation not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading organization invitation").WithInternalError(err)
+	}
+	if invitation.IsAccepted() {
+		return apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationInvitationNotFound, "Organization invitation not found")
+	}
+	if invitation.IsExpired() {
+		return apierrors.NewForbiddenError(apierrors.ErrorCodeOrganizationInvitationExpired, "Organization invitation has expired")
+	}
+
+	organization, err := a.findOrganization(ctx, invitation.OrganizationID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationInvitationNotFound, "Organization invitation not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading organization").WithInternalError(err)
+	}
+
+	aud := a.requestAud(ctx, r)
+	user, err := a.findInvitee(db, invitation.Email, aud, organization)
+	if err != nil {
+		return err
+	}
+
+	isCreate := user == nil
+	if isCreate {
+		if params.Password != "" {
+			if err := a.checkPasswordStrength(ctx, params.Password); err != nil {
+				return err
+			}
+		}
+
+		signupParams := SignupParams{
+			Email:          invitation.Email,
+			Password:       params.Password,
+			Data:           params.Data,
+			Aud:            aud,
+			Provider:       "email",
+			OrganizationID: organization.ID,
+			ProjectID:      organization.ProjectID,
+		}
+		user, err = signupParams.ToUserModel(false /* <- isSSOUser */)
+		if err != nil {
+			return err
+		}
+		user.OrganizationRole = invitation.Role
+
+		if err := a.triggerBeforeUserCreated(r, db, user); err != nil {
+			return err
+		}
+	} else if user.IsBanned() {
+		return apierrors.NewForbiddenError(apierrors.ErrorCodeUserBanned, "User is banned")
+	}
+
+	var token *tokens.AccessTokenResponse
+	err = db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		if isCreate {
+			if user, terr = a.signupNewUser(tx, user); terr != nil {
+				return terr
+			}
+			identity, terr := a.createNewIdentity(tx, user, "email", structs.Map(provider.Claims{
+				Subject: user.ID.String(),
+				Email:   user.GetEmail(),
+			}))
+			if terr != nil {
+				return terr
+			}
+			user.Identities = []models.Identity{*identity}
+		} else if terr := a.joinOrganization(tx, user, organization, invitation.Role); terr != nil {
+			return terr
+		}
+
+		if !user.IsConfirmed() {
+			if terr := user.Confirm(tx); terr != nil {
+				return apierrors.NewInternalServerError("Database error confirming user").WithInternalError(terr)
+			}
+		}
+
+		if terr := invitation.Accept(tx); terr != nil {
+			return apierrors.NewInternalServerError("Database error accepting organization invitation").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, user, models.OrganizationInvitationAcceptedAction, "", map[string]interface{}{
+			"organization_id":   organization.ID,
+			"invitation_id":     invitation.ID,
+			"organization_role": invitation.Role,
+		}); terr != nil {
+			return terr
+		}
+
+		token, terr = a.issueRefreshToken(r, tx, user, models.Invite, models.GrantParams{})
+		return terr
+	})
+	if err != nil {
+		return err
+	}
+
+	if isCreate {
+		if err := a.triggerAfterUserCreated(r, db, user); err != nil {
+			return err
+		}
+	}
+
+	return sendJSON(w, http.StatusOK, token)
+}
+
+// findInvitee finds the user of the project with the invited email address,
+// preferring the one whose own organization is the inviting one. It returns
+// nil when there is no such user.
+func (a *API) findInvitee(tx *storage.Connection, email, aud string, organization *models.Organization) (*models.User, error) {
+	user, err := models.FindUserByEmailAndAudience(tx, email, aud, organization.ID, organization.ProjectID)
+	if err != nil && models.IsNotFoundError(err) {
+		user, err = models.FindUserByEmailAndAudience(tx, email, aud, uuid.Nil, organization.ProjectID)
+	}
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, apierrors.NewInternalServerError("Database error finding user").WithInternalError(err)
+	}
+	return user, nil
+}
+
+// joinOrganization adds an existing user to the organization. Users without
+// an organization get it as their own organization, other users become
+// members of it.
+func (a *API) joinOrganization(tx *storage.Connection, user *models.User, organization *models.Organization, role string) error {
+	if !user.OrganizationID.Valid {
+		if err := user.SetOrganization(tx, organization.ID, role); err != nil {
+			return apierrors.NewInternalServerError("Database error updating user").WithInternalError(err)
+		}
+		return nil
+	}
+	if user.OrganizationID.UUID == organization.ID {
+		return nil
+	}
+
+	member, err := models.NewOrganizationMember(organization.ID, user.ID, role)
+	if err != nil {
+		return apierrors.NewInternalServerError("Error creating organization member").WithInternalError(err)
+	}
+	if err := models.SaveOrganizationMember(tx, member); err != nil {
+		return apierrors.NewInternalServerError("Database error saving organization member").WithInternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/mailer/mockclient"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/tokens"
+)
+
+type OrganizationInvitationsTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	Mailer         *mockclient.MockMailer
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	admin *models.User
+	token string
+}
+
+func TestOrganizationInvitations(t *testing.T) {
+	mockMailer := &mockclient.MockMailer{}
+	api, config, err := setupAPIForTest(WithMailer(mockMailer))
+	require.NoError(t, err)
+
+	ts := &OrganizationInvitationsTestSuite{
+		API:    api,
+		Config: config,
+		Mailer: mockMailer,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *OrganizationInvitationsTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+	ts.Mailer.Reset()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ts.admin.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating organization admin jwt")
+	ts.token = token
+}
+
+func (ts *OrganizationInvitationsTestSuite) makeRequest(method, path string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	if ts.token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.token))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *OrganizationInvitationsTestSuite) invite(email, role string) (*models.OrganizationInvitation, string) {
+	w := ts.makeRequest(http.MethodPost, "/admin/invitations", map[string]interface{}{
+		"email": email,
+		"role":  role,
+	})
+	require.Equal(ts.T(), http.StatusCreated, w.Code, w.Body.String())
+
+	invitation := &models.OrganizationInvitation{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(invitation))
+
+	calls := ts.Mailer.OrganizationInviteCalls
+	require.NotEmpty(ts.T(), calls)
+	return invitation, calls[len(calls)-1].Token
+}
+
+func (ts *OrganizationInvitationsTestSuite) accept(token string, body map[string]interface{}) *httptest.ResponseRecorder {
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	body["token"] = token
+
+	var buffer bytes.Buffer
+	require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	req := httptest.NewRequest(http.MethodPost, "/invitations/accept", &buffer)
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *OrganizationInvitationsTestSuite) TestInviteAndAcceptNewUser() {
+	invitation, token := ts.invite("new@example.com", models.OrganizationRoleClient)
+	assert.Equal(ts.T(), ts.OrganizationID, invitation.OrganizationID)
+	assert.Equal(ts.T(), ts.admin.ID, invitation.InvitedBy.UUID)
+	assert.NotNil(ts.T(), invitation.SentAt)
+
+	w := ts.makeRequest(http.MethodGet, "/admin/invitations", nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+	data := AdminListOrganizationInvitationsResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Invitations, 1)
+
+	w = ts.accept(token, map[string]interface{}{"password": "test-password-123"})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	response := tokens.AccessTokenResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&response))
+	require.NotEmpty(ts.T(), response.Token)
+
+	user, err := models.FindUserByID(ts.API.db, response.User.ID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), ts.OrganizationID, user.OrganizationID.UUID)
+	assert.Equal(ts.T(), models.OrganizationRoleClient, user.OrganizationRole)
+	assert.True(ts.T(), user.IsConfirmed())
+
+	// the invitation cannot be used twice
+	w = ts.accept(token, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+
+	w = ts.makeRequest(http.MethodGet, "/admin/invitations", nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+	data = AdminListOrganizationInvitationsResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Invitations, 0)
+}
+
+func (ts *OrganizationInvitationsTestSuite) TestInviteExistingUser() {
+	u, err := models.NewUser("", "existing@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_id", "organization_role"))
+
+	_, token := ts.invite(u.GetEmail(), models.OrganizationRoleAdmin)
+
+	w := ts.accept(token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	member, err := u.FindOrganizationMembership(ts.API.db, ts.OrganizationID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), models.OrganizationRoleAdmin, member.Role)
+
+	// members cannot be invited again
+	w = ts.makeRequest(http.MethodPost, "/admin/invitations", map[string]interface{}{
+		"email": u.GetEmail(),
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code)
+}
+
+func (ts *OrganizationInvitationsTestSuite) TestInviteValidation() {
+	ts.invite("dup@example.com", "")
+
+	w := ts.makeRequest(http.MethodPost, "/admin/invitations", map[string]interface{}{
+		"email": "dup@example.com",
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code)
+
+	w = ts.makeRequest(http.MethodPost, "/admin/invitations", map[string]interface{}{
+		"email": "role@example.com",
+		"role":  models.OrganizationRoleProjectAdmin,
+	})
+	require.Equal(ts.T(), http.StatusBadRequest, w.Code)
+
+	// organization admins can only invite into their own organization
+	w = ts.makeRequest(http.MethodPost, "/admin/invitations", map[string]interface{}{
+		"email":           "other@example.com",
+		"organization_id": uuid.Must(uuid.NewV4()),
+	})
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+}
+
+func (ts *OrganizationInvitationsTestSuite) TestResendAndRevoke() {
+	invitation, oldToken := ts.invite("resend@example.com", models.OrganizationRoleClient)
+
+	w := ts.makeRequest(http.MethodPost, fmt.Sprintf("/admin/invitations/%s/resend", invitation.ID), nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	require.Len(ts.T(), ts.Mailer.OrganizationInviteCalls, 2)
+	newToken := ts.Mailer.OrganizationInviteCalls[1].Token
+	require.NotEqual(ts.T(), oldToken, newToken)
+
+	w = ts.accept(oldToken, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+
+	w = ts.makeRequest(http.MethodDelete, fmt.Sprintf("/admin/invitations/%s", invitation.ID), nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	w = ts.accept(newToken, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,327 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+	"github.com/supabase/auth/internal/tokens"
+)
+
+// UserOrganization is a membership of the current user as returned by
+// GET /user/organizations.
+type UserOrganization struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Role           string    `json:"role"`
+	Active         bool      `json:"active"`
+}
+
+type UserOrganizationsResponse struct {
+	Organizations []*UserOrganization `json:"organizations"`
+}
+
+type SwitchOrganizationParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+type AdminOrganizationMemberParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+type AdminListOrganizationMembersResponse struct {
+	Members []*models.OrganizationMember `json:"members"`
+}
+
+// UserOrganizations lists the organizations the current user is a member of.
+// The organization of the current access token is marked as active.
+func (a *API) UserOrganizations(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+	claims := getClaims(ctx)
+
+	members, err := user.OrganizationMemberships(db)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading organizations").WithInternalError(err)
+	}
+
+	// The token only grants the active organization, the memberships of the
+	// user grant the others.
+	organizations := make([]*UserOrganization, 0, len(members))
+	for _, member := range members {
+		organization, err := a.findOrganization(ctx, member.OrganizationID)
+		if err != nil {
+			if models.IsNotFoundError(err) {
+				continue
+			}
+			return apierrors.NewInternalServerError("Database error loading organization").WithInternalError(err)
+		}
+
+		organizations = append(organizations, &UserOrganization{
+			OrganizationID: organization.ID,
+			Name:           organization.Name,
+			Role:           member.Role,
+			Active:         claims != nil && claims.OrganizationID == organization.ID,
+		})
+	}
+
+	return sendJSON(w, http.StatusOK, UserOrganizationsResponse{
+		Organizations: organizations,
+	})
+}
+
+// UserSwitchOrganization makes another organization of the user the active
+// organization of the current session. A new access and refresh token are
+// issued whose organization, role and tier claims match that organization.
+func (a *API) UserSwitchOrganization(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	user := getUser(ctx)
+	session := getSession(ctx)
+
+	if session == nil {
+		return apierrors.NewForbiddenError(apierrors.ErrorCodeSessionNotFound, "Session not found")
+	}
+
+	params := &SwitchOrganizationParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	if params.OrganizationID == uuid.Nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "organization_id is required")
+	}
+
+	// The token does not grant the organization being switched to, nothing
+	// of it is used until the membership below is found.
+	organization, err := a.findOrganization(ctx, params.OrganizationID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading organization").WithInternalError(err)
+	}
+	if organization.ProjectID != user.ProjectID {
+		return apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+	}
+
+	member, err := user.FindOrganizationMembership(db, organization.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewForbiddenError(apierrors.ErrorCodeOrganizationMemberNotFound, "User is not a member of the organization")
+		}
+		return apierrors.NewInternalServerError("Database error loading organization membership").WithInternalError(err)
+	}
+
+	var tokenString string
+	var expiresAt int64
+	var refreshToken string
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		session, terr := models.FindSessionByID(tx, session.ID, true)
+		if terr != nil {
+			return terr
+		}
+
+		if terr := session.SetActiveOrganization(tx, organization.ID); terr != nil {
+			return terr
+		}
+
+		refreshToken, terr = a.rotateSessionRefreshToken(r, tx, user, session)
+		if terr != nil {
+			return terr
+		}
+
+		tokenString, expiresAt, terr = a.tokenService.GenerateAccessToken(r, tx, tokens.GenerateAccessTokenParams{
+			User:                 user,
+			SessionID:            &session.ID,
+			AuthenticationMethod: models.TokenRefresh,
+		})
+		if terr != nil {
+			httpErr, ok := terr.(*HTTPError)
+			if ok {
+				return httpErr
+			}
+			return apierrors.NewInternalServerError("error generating jwt token").WithInternalError(terr)
+		}
+
+		return models.NewAuditLogEntry(config.AuditLog, r, tx, user, models.OrganizationSwitchedAction, "", map[string]interface{}{
+			"organization_id":   organization.ID,
+			"organization_role": member.Role,
+			"session_id":        session.ID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, &tokens.AccessTokenResponse{
+		Token:        tokenString,
+		TokenType:    "bearer",
+		ExpiresIn:    config.JWT.Exp,
+		ExpiresAt:    expiresAt,
+		RefreshToken: refreshToken,
+		User:         user,
+	})
+}
+
+// loadOrganizationMember loads the user in the user_id URL param and its
+// membership in the organization loaded by loadOrganization.
+func (a *API) loadOrganizationMember(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	organization := getOrganization(ctx)
+
+	userID, err := uuid.FromString(chi.URLParam(r, "user_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "user_id must be an UUID")
+	}
+
+	user, err := models.FindUserByID(db, userID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeUserNotFound, "User not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading user").WithInternalError(err)
+	}
+
+	member, err := user.FindOrganizationMembership(db, organization.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationMemberNotFound, "Organization member not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading organization member").WithInternalError(err)
+	}
+
+	return withOrganizationMember(withUser(ctx, user), member), nil
+}
+
+// adminOrganizationMembers lists the members of an organization.
+func (a *API) adminOrganizationMembers(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	organization := getOrganization(ctx)
+
+	pageParams, err := paginate(r)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Pagination Parameters: %v", err).WithInternalError(err)
+	}
+
+	members, err := models.FindOrganizationMembersByOrganizationID(db, organization.ID, pageParams)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error finding organization members").WithInternalError(err)
+	}
+	addPaginationHeaders(w, r, pageParams)
+
+	return sendJSON(w, http.StatusOK, AdminListOrganizationMembersResponse{
+		Members: members,
+	})
+}
+
+// adminOrganizationMemberAdd adds a user of the organization's project to the
+// organization, or changes the role of an existing member.
+func (a *API) adminOrganizationMemberAdd(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+
+	params := &AdminOrganizationMemberParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	if params.UserID == uuid.Nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "user_id is required")
+	}
+	if params.Role == "" {
+		params.Role = models.OrganizationRoleClient
+	}
+	if !slices.Contains(models.OrganizationMemberRoles, params.Role) {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid organization role %q", params.Role)
+	}
+
+	user, err := models.FindUserByID(db, params.UserID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeUserNotFound, "User not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading user").WithInternalError(err)
+	}
+	if user.ProjectID != organization.ProjectID {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "user_id must belong to the organization's project")
+	}
+
+	// The role in the user's own organization is stored on the user and
+	// guarded by the prevent_role_change trigger.
+	if user.OrganizationID.Valid && user.OrganizationID.UUID == organization.ID {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeValidationFailed, "The membership of a user in their own organization cannot be changed")
+	}
+
+	member, err := models.NewOrganizationMember(organization.ID, user.ID, params.Role)
+	if err != nil {
+		return apierrors.NewInternalServerError("Error creating organization member").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.SaveOrganizationMember(tx, member); terr != nil {
+			return terr
+		}
+
+		return models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationMemberAddedAction, "", map[string]interface{}{
+			"organization_id":   organization.ID,
+			"user_id":           user.ID,
+			"organization_role": member.Role,
+		})
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error adding organization member").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, member)
+}
+
+// adminOrganizationMemberDelete removes a user from an organization. Sessions
+// that had the organization active fall back to the user's own organization on
+// their next refresh.
+func (a *API) adminOrganizationMemberDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+	user := getUser(ctx)
+	member := getOrganizationMember(ctx)
+
+	if user.OrganizationID.Valid && user.OrganizationID.UUID == organization.ID {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeValidationFailed, "Users cannot be removed from their own organization")
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := tx.Destroy(member); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting organization member").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.OrganizationMemberRemovedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"user_id":         user.ID,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
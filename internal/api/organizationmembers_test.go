@@ -0,0 +1,252 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+	"github.com/supabase/auth/internal/tokens"
+)
+
+type OrganizationMembersTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	other      *models.Organization
+	user       *models.User
+	adminToken string
+	userToken  string
+}
+
+func TestOrganizationMembers(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &OrganizationMembersTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *OrganizationMembersTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	adminToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		Role:      "supabase_admin",
+		ProjectID: ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating admin jwt")
+	ts.adminToken = adminToken
+
+	owner, err := models.NewUser("", "owner@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(owner, "organization_id", "organization_role"))
+
+	ts.other, err = models.NewOrganization(ts.ProjectID, owner.ID, "Other", "")
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		return tx.Create(ts.other)
+	}))
+	require.NoError(ts.T(), owner.SetOrganization(ts.API.db, ts.other.ID, models.OrganizationRoleAdmin))
+
+	ts.user, err = models.NewUser("", "member@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(ts.user, "organization_role"))
+
+	session, err := models.NewSession(ts.user.ID, nil)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(session))
+
+	req := httptest.NewRequest(http.MethodPost, "/token?grant_type=password", nil)
+	ts.userToken, _, err = ts.API.generateAccessToken(req, ts.API.db, ts.user, &session.ID, models.PasswordGrant)
+	require.NoError(ts.T(), err)
+}
+
+func (ts *OrganizationMembersTestSuite) makeRequest(method, path, token string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *OrganizationMembersTestSuite) addMember(role string) {
+	w := ts.makeRequest(http.MethodPost, fmt.Sprintf("/admin/organizations/%s/members", ts.other.ID), ts.adminToken, map[string]interface{}{
+		"user_id": ts.user.ID,
+		"role":    role,
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+}
+
+func (ts *OrganizationMembersTestSuite) TestAdminOrganizationMembers() {
+	ts.addMember(models.OrganizationRoleAdmin)
+
+	w := ts.makeRequest(http.MethodGet, fmt.Sprintf("/admin/organizations/%s/members", ts.other.ID), ts.adminToken, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	data := AdminListOrganizationMembersResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Members, 2)
+
+	member, err := models.FindOrganizationMember(ts.API.db, ts.other.ID, ts.user.ID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), models.OrganizationRoleAdmin, member.Role)
+
+	w = ts.makeRequest(http.MethodDelete, fmt.Sprintf("/admin/organizations/%s/members/%s", ts.other.ID, ts.user.ID), ts.adminToken, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	_, err = models.FindOrganizationMember(ts.API.db, ts.other.ID, ts.user.ID)
+	require.True(ts.T(), models.IsNotFoundError(err))
+}
+
+func (ts *OrganizationMembersTestSuite) TestAdminOrganizationMemberValidation() {
+	w := ts.makeRequest(http.MethodPost, fmt.Sprintf("/admin/organizations/%s/members", ts.other.ID), ts.adminToken, map[string]interface{}{
+		"user_id": ts.user.ID,
+		"role":    "owner",
+	})
+	require.Equal(ts.T(), http.StatusBadRequest, w.Code)
+
+	// project admins and API keys cannot be granted through a membership
+	for _, role := range []string{models.OrganizationRoleProjectAdmin, models.OrganizationRoleAPIKey} {
+		w = ts.makeRequest(http.MethodPost, fmt.Sprintf("/admin/organizations/%s/members", ts.other.ID), ts.adminToken, map[string]interface{}{
+			"user_id": ts.user.ID,
+			"role":    role,
+		})
+		require.Equal(ts.T(), http.StatusBadRequest, w.Code)
+	}
+
+	// the home membership lives on the user
+	w = ts.makeRequest(http.MethodPost, fmt.Sprintf("/admin/organizations/%s/members", ts.OrganizationID), ts.adminToken, map[string]interface{}{
+		"user_id": ts.user.ID,
+		"role":    models.OrganizationRoleAdmin,
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code)
+
+	w = ts.makeRequest(http.MethodDelete, fmt.Sprintf("/admin/organizations/%s/members/%s", ts.OrganizationID, ts.user.ID), ts.adminToken, nil)
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code)
+}
+
+func (ts *OrganizationMembersTestSuite) TestUserOrganizations() {
+	ts.addMember(models.OrganizationRoleAdmin)
+
+	w := ts.makeRequest(http.MethodGet, "/user/organizations", ts.userToken, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := UserOrganizationsResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Organizations, 2)
+
+	active := map[uuid.UUID]bool{}
+	for _, o := range data.Organizations {
+		active[o.OrganizationID] = o.Active
+	}
+	assert.True(ts.T(), active[ts.OrganizationID])
+	assert.False(ts.T(), active[ts.other.ID])
+}
+
+func (ts *OrganizationMembersTestSuite) TestUserSwitchOrganization() {
+	ts.addMember(models.OrganizationRoleAdmin)
+
+	w := ts.makeRequest(http.MethodPost, "/user/organizations/switch", ts.userToken, map[string]interface{}{
+		"organization_id": ts.other.ID,
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := tokens.AccessTokenResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.NotEmpty(ts.T(), data.RefreshToken)
+
+	claims := &AccessTokenClaims{}
+	_, err := jwt.ParseWithClaims(data.Token, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(ts.Config.JWT.Secret), nil
+	})
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), ts.other.ID, claims.OrganizationID)
+	assert.Equal(ts.T(), models.OrganizationRoleAdmin, claims.OrganizationRole)
+	assert.Equal(ts.T(), ts.ProjectID, claims.ProjectID)
+}
+
+func (ts *OrganizationMembersTestSuite) TestSwitchedAdminManagesOrganizationUsers() {
+	ts.addMember(models.OrganizationRoleAdmin)
+
+	client, err := models.NewUser("", "client@example.com", "test", ts.Config.JWT.Aud, nil, ts.other.ID, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(client, "organization_role"))
+
+	// a client of the home organization, where the member is not an admin
+	homeClient, err := models.NewUser("", "home-client@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(homeClient, "organization_role"))
+
+	w := ts.makeRequest(http.MethodPost, "/user/organizations/switch", ts.userToken, map[string]interface{}{
+		"organization_id": ts.other.ID,
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := tokens.AccessTokenResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+
+	w = ts.makeRequest(http.MethodGet, "/admin/users", data.Token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	users := AdminListUsersResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&users))
+	ids := []uuid.UUID{}
+	for _, u := range users.Users {
+		ids = append(ids, u.ID)
+	}
+	assert.Contains(ts.T(), ids, client.ID)
+	assert.Contains(ts.T(), ids, ts.user.ID)
+	assert.NotContains(ts.T(), ids, homeClient.ID)
+
+	w = ts.makeRequest(http.MethodPut, fmt.Sprintf("/admin/users/%s", client.ID), data.Token, map[string]interface{}{
+		"user_metadata": map[string]interface{}{"name": "Client"},
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodPut, fmt.Sprintf("/admin/users/%s", homeClient.ID), data.Token, map[string]interface{}{
+		"user_metadata": map[string]interface{}{"name": "Home client"},
+	})
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+
+	// the token of the home organization, where the member is a client, is
+	// not an admin token
+	w = ts.makeRequest(http.MethodGet, "/admin/users", ts.userToken, nil)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+}
+
+func (ts *OrganizationMembersTestSuite) TestUserSwitchOrganizationNotMember() {
+	w := ts.makeRequest(http.MethodPost, "/user/organizations/switch", ts.userToken, map[string]interface{}{
+		"organization_id": ts.other.ID,
+	})
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+
+	w = ts.makeRequest(http.MethodPost, "/user/organizations/switch", ts.userToken, map[string]interface{}{
+		"organization_id": uuid.Must(uuid.NewV4()),
+	})
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+}
@@ -122,18 +122,21 @@ type SmsOtpResponse struct {
 func (a *API) SmsOtp(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
-	config := a.config
-
-	if !config.External.Phone.Enabled {
-		return apierrors.NewBadRequestError(apierrors.ErrorCodePhoneProviderDisabled, "Unsupported phone provider")
-	}
-	var err error
 
 	params := &SmsParams{}
 	if err := retrieveRequestParams(r, params); err != nil {
 		return err
 	}
 
+	config, err := a.projectConfig(db, params.OrganizationID, params.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	if !config.External.Phone.Enabled {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodePhoneProviderDisabled, "Unsupported phone provider")
+	}
+
 	// For backwards compatibility, we default to SMS if params Channel is not specified
 	if params.Phone != "" && params.Channel == "" {
 		params.Channel = sms_provider.SMSProvider
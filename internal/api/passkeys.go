@@ -0,0 +1,422 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	wbnprotocol "github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/metering"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/storage"
+	"github.com/supabase/auth/internal/utilities"
+)
+
+// PasskeyParams are the parameters of the passkey registration and sign-in
+// ceremonies. The options requests only need the relying party in WebAuthn.
+type PasskeyParams struct {
+	ChallengeID  uuid.UUID       `json:"challenge_id"`
+	FriendlyName string          `json:"friendly_name"`
+	WebAuthn     *WebAuthnParams `json:"webauthn"`
+}
+
+type UpdatePasskeyParams struct {
+	FriendlyName string `json:"friendly_name"`
+}
+
+type PasskeyChallengeResponse struct {
+	ID        uuid.UUID              `json:"id"`
+	ExpiresAt int64                  `json:"expires_at"`
+	WebAuthn  *WebAuthnChallengeData `json:"webauthn"`
+}
+
+// webAuthnConfig returns the relying party of the request. The credential
+// response is required to complete a ceremony.
+func (params *PasskeyParams) webAuthnConfig(requireResponse bool) (*webauthn.WebAuthn, error) {
+	if params.WebAuthn == nil {
+		return nil, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "WebAuthn config required")
+	}
+	if requireResponse && params.WebAuthn.CredentialResponse == nil {
+		return nil, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "credential_response required")
+	}
+	webAuthn, err := params.WebAuthn.ToConfig()
+	if err != nil {
+		return nil, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%v", err)
+	}
+	return webAuthn, nil
+}
+
+// createPasskeyChallenge saves the WebAuthn session of a ceremony, user is
+// nil for sign-ins.
+func (a *API) createPasskeyChallenge(r *http.Request, db *storage.Connection, user *models.User, session *webauthn.SessionData, challengeType string, options interface{}) (*PasskeyChallengeResponse, error) {
+	config := a.config
+	challenge := models.NewPasskeyChallenge(user, utilities.GetIPAddress(r), session)
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.DeleteExpiredPasskeyChallenges(tx, config.Passkey.ChallengeExpiryDuration); terr != nil {
+			return terr
+		}
+		return tx.Create(challenge)
+	})
+	if err != nil {
+		return nil, apierrors.NewInternalServerError("Database error creating passkey challenge").WithInternalError(err)
+	}
+
+	return &PasskeyChallengeResponse{
+		ID:        challenge.ID,
+		ExpiresAt: challenge.GetExpiryTime(config.Passkey.ChallengeExpiryDuration).Unix(),
+		WebAuthn: &WebAuthnChallengeData{
+			Type:              challengeType,
+			CredentialOptions: options,
+		},
+	}, nil
+}
+
+// consumePasskeyChallenge loads and deletes the challenge of a ceremony, it
+// must have been created for the user from the same IP address.
+func (a *API) consumePasskeyChallenge(r *http.Request, db *storage.Connection, user *models.User, challengeID uuid.UUID) (*models.PasskeyChallenge, error) {
+	challenge, err := models.FindPasskeyChallengeByID(db, challengeID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewUnprocessableEntityError(apierrors.ErrorCodePasskeyChallengeExpired, "Passkey challenge not found, create a new challenge")
+		}
+		return nil, apierrors.NewInternalServerError("Database error finding passkey challenge").WithInternalError(err)
+	}
+
+	if err := db.Destroy(challenge); err != nil {
+		return nil, apierrors.NewInternalServerError("Database error deleting passkey challenge").WithInternalError(err)
+	}
+
+	userID := uuid.Nil
+	if user != nil {
+		userID = user.ID
+	}
+	if challenge.UserID.UUID != userID {
+		return nil, apierrors.NewUnprocessableEntityError(apierrors.ErrorCodePasskeyChallengeExpired, "Passkey challenge not found, create a new challenge")
+	}
+	if challenge.IPAddress != utilities.GetIPAddress(r) {
+		return nil, apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFAIPAddressMismatch, "Challenge and verify IP addresses mismatch.")
+	}
+	if challenge.HasExpired(a.config.Passkey.ChallengeExpiryDuration) {
+		return nil, apierrors.NewUnprocessableEntityError(apierrors.ErrorCodePasskeyChallengeExpired, "Passkey challenge %v has expired, create a new challenge.", challenge.ID)
+	}
+	return challenge, nil
+}
+
+// PasskeyAuthenticateOptions starts a passkey sign-in. The authenticator
+// picks the passkey, so no user is needed.
+func (a *API) PasskeyAuthenticateOptions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	params := &PasskeyParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+	webAuthn, err := params.webAuthnConfig(false)
+	if err != nil {
+		return err
+	}
+
+	options, session, err := webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(wbnprotocol.VerificationRequired))
+	if err != nil {
+		return apierrors.NewInternalServerError("Failed to generate WebAuthn login data").WithInternalError(err)
+	}
+
+	response, err := a.createPasskeyChallenge(r, db, nil, session, "request", options)
+	if err != nil {
+		return err
+	}
+	return sendJSON(w, http.StatusOK, response)
+}
+
+// PasskeyGrant implements the passkey grant type flow, signing in the owner
+// of the passkey the authenticator picked.
+func (a *API) PasskeyGrant(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	config := a.config
+	db := a.db.WithContext(ctx)
+
+	if !config.Passkey.Enabled {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodePasskeyDisabled, "Passkeys are disabled")
+	}
+
+	params := &PasskeyParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+	webAuthn, err := params.webAuthnConfig(true)
+	if err != nil {
+		return err
+	}
+
+	parsedResponse, err := wbnprotocol.ParseCredentialRequestResponseBody(bytes.NewReader(params.WebAuthn.CredentialResponse))
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid credential_response")
+	}
+
+	challenge, err := a.consumePasskeyChallenge(r, db, nil, params.ChallengeID)
+	if err != nil {
+		return err
+	}
+
+	var passkey *models.Passkey
+	var user *models.User
+	credential, err := webAuthn.ValidateDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+		var err error
+		if passkey, err = models.FindPasskeyByCredentialID(db, rawID); err != nil {
+			return nil, err
+		}
+		if userID, err := uuid.FromString(string(userHandle)); err != nil || userID != passkey.UserID {
+			return nil, errors.New("passkey does not belong to the user handle")
+		}
+		if user, err = models.FindUserByID(db, passkey.UserID); err != nil {
+			return nil, err
+		}
+		return &models.PasskeyUser{User: user, Passkeys: []*models.Passkey{passkey}}, nil
+	}, *challenge.WebAuthnSessionData.SessionData, parsedResponse)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeInvalidCredentials, InvalidLoginMessage).WithInternalError(err)
+	}
+
+	if user.IsBanned() {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeUserBanned, "User is banned")
+	}
+
+	grantParams := models.GrantParams{
+		AAL2: config.Passkey.AAL2,
+	}
+	grantParams.FillGrantParams(r)
+
+	var token *AccessTokenResponse
+	err = db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		if terr = passkey.UpdateLastUsed(tx, credential); terr != nil {
+			return apierrors.NewInternalServerError("Database error updating passkey").WithInternalError(terr)
+		}
+		if terr = models.NewAuditLogEntry(config.AuditLog, r, tx, user, models.LoginAction, "", map[string]interface{}{
+			"provider":   "passkey",
+			"passkey_id": passkey.ID,
+		}); terr != nil {
+			return terr
+		}
+		token, terr = a.tokenService.IssueRefreshToken(r, w.Header(), tx, user, models.PasskeySignIn, grantParams)
+		return terr
+	})
+	if err != nil {
+		return err
+	}
+
+	metering.RecordLogin(metering.LoginTypePasskey, user.ID, nil)
+	return sendJSON(w, http.StatusOK, token)
+}
+
+func (a *API) loadPasskey(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+
+	passkeyID, err := uuid.FromString(chi.URLParam(r, "passkey_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "passkey_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "passkey_id", passkeyID)
+
+	passkey, err := models.FindOwnedPasskeyByID(db, user, passkeyID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodePasskeyNotFound, "Passkey not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading passkey").WithInternalError(err)
+	}
+	return withPasskey(ctx, passkey), nil
+}
+
+// UserPasskeys lists the passkeys of the user.
+func (a *API) UserPasskeys(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+
+	passkeys, err := models.FindPasskeysByUser(db, user)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading passkeys").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{
+		"passkeys": passkeys,
+	})
+}
+
+// PasskeyRegisterOptions starts the registration of a passkey for the user.
+// Anonymous users register one to sign up without a password.
+func (a *API) PasskeyRegisterOptions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	user := getUser(ctx)
+
+	params := &PasskeyParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+	webAuthn, err := params.webAuthnConfig(false)
+	if err != nil {
+		return err
+	}
+
+	passkeys, err := models.FindPasskeysByUser(db, user)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading passkeys").WithInternalError(err)
+	}
+	if len(passkeys) >= config.Passkey.MaxPasskeys {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeTooManyPasskeys, "Maximum number of passkeys reached, delete a passkey to register another one")
+	}
+
+	exclusions := make([]wbnprotocol.CredentialDescriptor, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		exclusions = append(exclusions, passkey.Credential.Descriptor())
+	}
+
+	options, session, err := webAuthn.BeginRegistration(
+		&models.PasskeyUser{User: user, Passkeys: passkeys},
+		webauthn.WithExclusions(exclusions),
+		webauthn.WithResidentKeyRequirement(wbnprotocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		return apierrors.NewInternalServerError("Failed to generate WebAuthn registration data").WithInternalError(err)
+	}
+
+	response, err := a.createPasskeyChallenge(r, db, user, session, "create", options)
+	if err != nil {
+		return err
+	}
+	return sendJSON(w, http.StatusOK, response)
+}
+
+// PasskeyRegister completes the registration of a passkey for the user.
+func (a *API) PasskeyRegister(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	user := getUser(ctx)
+
+	params := &PasskeyParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+	webAuthn, err := params.webAuthnConfig(true)
+	if err != nil {
+		return err
+	}
+
+	parsedResponse, err := wbnprotocol.ParseCredentialCreationResponseBody(bytes.NewReader(params.WebAuthn.CredentialResponse))
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid credential_response")
+	}
+
+	challenge, err := a.consumePasskeyChallenge(r, db, user, params.ChallengeID)
+	if err != nil {
+		return err
+	}
+
+	credential, err := webAuthn.CreateCredential(&models.PasskeyUser{User: user}, *challenge.WebAuthnSessionData.SessionData, parsedResponse)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid passkey registration").WithInternalError(err)
+	}
+
+	passkey, err := models.NewPasskey(user, params.FriendlyName, credential)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid passkey registration").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if _, terr := models.FindPasskeyByCredentialID(tx, credential.ID); terr == nil {
+			return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeConflict, "Passkey is already registered")
+		} else if !models.IsNotFoundError(terr) {
+			return apierrors.NewInternalServerError("Database error loading passkey").WithInternalError(terr)
+		}
+
+		if terr := tx.Create(passkey); terr != nil {
+			return apierrors.NewInternalServerError("Database error saving passkey").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, user, models.PasskeyRegisteredAction, "", map[string]interface{}{
+			"passkey_id":    passkey.ID,
+			"friendly_name": passkey.FriendlyName,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, passkey)
+}
+
+// PasskeyUpdate renames a passkey of the user.
+func (a *API) PasskeyUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+	passkey := getPasskey(ctx)
+
+	params := &UpdatePasskeyParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := passkey.UpdateFriendlyName(tx, params.FriendlyName); terr != nil {
+			return apierrors.NewInternalServerError("Database error updating passkey").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, user, models.PasskeyUpdatedAction, "", map[string]interface{}{
+			"passkey_id":    passkey.ID,
+			"friendly_name": passkey.FriendlyName,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, passkey)
+}
+
+// PasskeyDelete removes a passkey of the user, sessions it started are
+// kept.
+func (a *API) PasskeyDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+	passkey := getPasskey(ctx)
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, user, models.PasskeyDeletedAction, "", map[string]interface{}{
+			"passkey_id": passkey.ID,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(passkey); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting passkey").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
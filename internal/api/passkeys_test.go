@@ -0,0 +1,201 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+)
+
+type PasskeysTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	user  *models.User
+	token string
+}
+
+func TestPasskeys(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &PasskeysTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *PasskeysTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+	ts.Config.Passkey.Enabled = true
+	ts.Config.Passkey.MaxPasskeys = 10
+
+	u, err := models.NewUser("", "passkey@example.com", "password", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error creating test user model")
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_role"), "Error saving new test user")
+	ts.user = u
+
+	s, err := models.NewSession(u.ID, nil)
+	require.NoError(ts.T(), err, "Error creating test session")
+	require.NoError(ts.T(), ts.API.db.Create(s), "Error saving test session")
+
+	req := httptest.NewRequest(http.MethodPost, "/user/passkeys", nil)
+	token, _, err := ts.API.generateAccessToken(req, ts.API.db, u, &s.ID, models.PasswordGrant)
+	require.NoError(ts.T(), err, "Error generating access token")
+	ts.token = token
+}
+
+func (ts *PasskeysTestSuite) makeRequest(method, path, token string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *PasskeysTestSuite) createPasskey(friendlyName string, credentialID string) *models.Passkey {
+	passkey, err := models.NewPasskey(ts.user, friendlyName, &webauthn.Credential{ID: []byte(credentialID)})
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(passkey))
+	return passkey
+}
+
+func webAuthnRelyingParty() map[string]interface{} {
+	return map[string]interface{}{
+		"rpId":      "example.com",
+		"rpOrigins": []string{"https://example.com"},
+	}
+}
+
+func (ts *PasskeysTestSuite) TestPasskeysDisabled() {
+	ts.Config.Passkey.Enabled = false
+
+	w := ts.makeRequest(http.MethodGet, "/user/passkeys", ts.token, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodePasskeyDisabled)
+
+	w = ts.makeRequest(http.MethodPost, "/passkeys/authenticate/options", "", map[string]interface{}{
+		"webauthn": webAuthnRelyingParty(),
+	})
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodPost, "/token?grant_type=passkey", "", map[string]interface{}{
+		"challenge_id": uuid.Must(uuid.NewV4()),
+		"webauthn":     webAuthnRelyingParty(),
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodePasskeyDisabled)
+}
+
+func (ts *PasskeysTestSuite) TestAuthenticateOptions() {
+	w := ts.makeRequest(http.MethodPost, "/passkeys/authenticate/options", "", map[string]interface{}{
+		"webauthn": webAuthnRelyingParty(),
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	response := PasskeyChallengeResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(ts.T(), "request", response.WebAuthn.Type)
+
+	challenge, err := models.FindPasskeyChallengeByID(ts.API.db, response.ID)
+	require.NoError(ts.T(), err)
+	assert.False(ts.T(), challenge.UserID.Valid)
+
+	// Challenges are single use
+	for i := 0; i < 2; i++ {
+		w = ts.makeRequest(http.MethodPost, "/token?grant_type=passkey", "", map[string]interface{}{
+			"challenge_id": response.ID,
+			"webauthn": map[string]interface{}{
+				"rpId":                "example.com",
+				"rpOrigins":           []string{"https://example.com"},
+				"credential_response": map[string]interface{}{},
+			},
+		})
+		assert.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+	}
+}
+
+func (ts *PasskeysTestSuite) TestRegisterOptions() {
+	ts.createPasskey("laptop", "existing")
+
+	w := ts.makeRequest(http.MethodPost, "/user/passkeys/register/options", ts.token, map[string]interface{}{
+		"webauthn": webAuthnRelyingParty(),
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := map[string]interface{}{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	webAuthn := data["webauthn"].(map[string]interface{})
+	assert.Equal(ts.T(), "create", webAuthn["type"])
+
+	publicKey := webAuthn["credential_options"].(map[string]interface{})["publicKey"].(map[string]interface{})
+	assert.Equal(ts.T(), "required", publicKey["authenticatorSelection"].(map[string]interface{})["residentKey"])
+	assert.Len(ts.T(), publicKey["excludeCredentials"], 1)
+
+	challengeID, err := uuid.FromString(data["id"].(string))
+	require.NoError(ts.T(), err)
+	challenge, err := models.FindPasskeyChallengeByID(ts.API.db, challengeID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), ts.user.ID, challenge.UserID.UUID)
+
+	ts.Config.Passkey.MaxPasskeys = 1
+	w = ts.makeRequest(http.MethodPost, "/user/passkeys/register/options", ts.token, map[string]interface{}{
+		"webauthn": webAuthnRelyingParty(),
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodeTooManyPasskeys)
+}
+
+func (ts *PasskeysTestSuite) TestManagePasskeys() {
+	passkey := ts.createPasskey("laptop", "credential")
+	path := fmt.Sprintf("/user/passkeys/%s", passkey.ID)
+
+	w := ts.makeRequest(http.MethodGet, "/user/passkeys", ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), "laptop")
+	assert.NotContains(ts.T(), w.Body.String(), "credential_id")
+
+	w = ts.makeRequest(http.MethodPut, path, ts.token, map[string]interface{}{
+		"friendly_name": "phone",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	passkey, err := models.FindOwnedPasskeyByID(ts.API.db, ts.user, passkey.ID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), "phone", passkey.FriendlyName)
+
+	w = ts.makeRequest(http.MethodDelete, path, ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodPut, path, ts.token, map[string]interface{}{
+		"friendly_name": "tablet",
+	})
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodePasskeyNotFound)
+}
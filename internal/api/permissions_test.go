@@ -0,0 +1,169 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+	"github.com/supabase/auth/internal/tokens"
+)
+
+type PermissionsTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	admin *models.User
+}
+
+func TestPermissions(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &PermissionsTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *PermissionsTestSuite) SetupTest() {
+	models.ClearRolePermissionsCache()
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+}
+
+func (ts *PermissionsTestSuite) tokenFor(user *models.User, role string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: user.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: role,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating jwt")
+	return token
+}
+
+func (ts *PermissionsTestSuite) makeRequest(method, path string, body map[string]interface{}, headers map[string]string) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *PermissionsTestSuite) TestRolePermissions() {
+	permissions, err := models.FindPermissionsByOrganizationRole(ts.API.db, models.OrganizationRoleAdmin)
+	require.NoError(ts.T(), err)
+	assert.ElementsMatch(ts.T(), models.Permissions, permissions)
+
+	permissions, err = models.FindPermissionsByOrganizationRole(ts.API.db, models.OrganizationRoleAPIKey)
+	require.NoError(ts.T(), err)
+	assert.Contains(ts.T(), permissions, models.PermissionUsersRead)
+	assert.NotContains(ts.T(), permissions, models.PermissionKeysManage)
+
+	permissions, err = models.FindPermissionsByOrganizationRole(ts.API.db, models.OrganizationRoleClient)
+	require.NoError(ts.T(), err)
+	assert.Empty(ts.T(), permissions)
+
+	permissions, err = models.FindPermissionsByOrganizationRole(ts.API.db, "")
+	require.NoError(ts.T(), err)
+	assert.Empty(ts.T(), permissions)
+}
+
+func (ts *PermissionsTestSuite) TestAccessTokenPermissionsClaim() {
+	session, err := models.NewSession(ts.admin.ID, nil)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(session))
+
+	user, err := models.FindUserByID(ts.API.db, ts.admin.ID)
+	require.NoError(ts.T(), err)
+
+	req := httptest.NewRequest(http.MethodPost, "/token", nil)
+	token, _, err := ts.API.tokenService.GenerateAccessToken(req, ts.API.db, tokens.GenerateAccessTokenParams{
+		User:                 user,
+		SessionID:            &session.ID,
+		AuthenticationMethod: models.PasswordGrant,
+	})
+	require.NoError(ts.T(), err)
+
+	claims := &AccessTokenClaims{}
+	_, err = jwt.NewParser().ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(ts.Config.JWT.Secret), nil
+	})
+	require.NoError(ts.T(), err)
+	assert.ElementsMatch(ts.T(), models.Permissions, claims.Permissions)
+}
+
+func (ts *PermissionsTestSuite) TestClientNotAllowed() {
+	client, err := models.NewUser("", "client@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(client))
+
+	w := ts.makeRequest(http.MethodGet, "/admin/users", nil, map[string]string{
+		"Authorization": fmt.Sprintf("Bearer %s", ts.tokenFor(client, models.OrganizationRoleClient)),
+	})
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+}
+
+func (ts *PermissionsTestSuite) TestRequirePermission() {
+	var key string
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		organization, terr := models.FindOrganizationByID(tx, ts.OrganizationID)
+		if terr != nil {
+			return terr
+		}
+
+		var apiKey *models.APIKey
+		if apiKey, key, terr = models.NewAPIKey(organization, "backend", ""); terr != nil {
+			return terr
+		}
+		return tx.Create(apiKey)
+	}))
+
+	headers := map[string]string{"apikey": key}
+
+	w := ts.makeRequest(http.MethodGet, "/admin/users", nil, headers)
+	assert.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	// API keys are not granted smtp:manage
+	w = ts.makeRequest(http.MethodGet, fmt.Sprintf("/admin/smtp/organizations/%s", ts.OrganizationID), nil, headers)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+
+	data := map[string]interface{}{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	assert.Equal(ts.T(), string(apierrors.ErrorCodeInsufficientPermissions), data["error_code"])
+
+	// Organization admins hold every permission
+	w = ts.makeRequest(http.MethodGet, "/admin/audit", nil, map[string]string{
+		"Authorization": fmt.Sprintf("Bearer %s", ts.tokenFor(ts.admin, models.OrganizationRoleAdmin)),
+	})
+	assert.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+}
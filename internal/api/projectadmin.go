@@ -0,0 +1,320 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/storage"
+	"github.com/supabase/auth/internal/utilities"
+)
+
+type AdminProjectParams struct {
+	Name        *string           `json:"name"`
+	Description *string           `json:"description"`
+	RateLimits  *models.RateLimit `json:"rate_limits"`
+}
+
+type adminProjectDeleteParams struct {
+	Cascade bool `json:"cascade"`
+}
+
+type AdminListProjectsResponse struct {
+	Projects []*models.Project `json:"projects"`
+}
+
+// loadProject loads the project in the project_id URL param. Admins whose
+// token is bound to a project can only load that project.
+func (a *API) loadProject(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	projectID, err := uuid.FromString(chi.URLParam(r, "project_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "project_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "project_id", projectID)
+
+	if requestProjectID := a.requestProjectID(ctx, r); requestProjectID != uuid.Nil && requestProjectID != projectID {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeProjectNotFound, "Project not found")
+	}
+
+	project, err := models.FindProjectByID(db, projectID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeProjectNotFound, "Project not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading project").WithInternalError(err)
+	}
+
+	return withProject(ctx, project), nil
+}
+
+// adminProjects responds with a list of projects. Admins whose token is bound
+// to a project only see that project.
+func (a *API) adminProjects(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	pageParams, err := paginate(r)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Pagination Parameters: %v", err).WithInternalError(err)
+	}
+
+	var projects []*models.Project
+	if projectID := a.requestProjectID(ctx, r); projectID != uuid.Nil {
+		project, err := models.FindProjectByID(db, projectID)
+		if err != nil && !models.IsNotFoundError(err) {
+			return apierrors.NewInternalServerError("Database error finding projects").WithInternalError(err)
+		}
+		projects = []*models.Project{}
+		if project != nil {
+			projects = append(projects, project)
+		}
+		pageParams.Count = uint64(len(projects))
+	} else {
+		sortParams, err := sort(r, map[string]bool{models.CreatedAt: true}, []models.SortField{{Name: models.CreatedAt, Dir: models.Descending}})
+		if err != nil {
+			return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Sort Parameters: %v", err)
+		}
+
+		filter := r.URL.Query().Get("filter")
+
+		projects, err = models.FindProjects(db, pageParams, sortParams, filter)
+		if err != nil {
+			return apierrors.NewInternalServerError("Database error finding projects").WithInternalError(err)
+		}
+	}
+	addPaginationHeaders(w, r, pageParams)
+
+	return sendJSON(w, http.StatusOK, AdminListProjectsResponse{
+		Projects: projects,
+	})
+}
+
+// adminProjectCreate creates a new project. Only admins whose token is not
+// bound to a project can create projects.
+func (a *API) adminProjectCreate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+
+	if a.requestProjectID(ctx, r) != uuid.Nil {
+		return apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to create projects with a project scoped token")
+	}
+
+	params := &AdminProjectParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	if params.Name == nil || *params.Name == "" {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "name is required")
+	}
+
+	var description string
+	if params.Description != nil {
+		description = *params.Description
+	}
+
+	var rateLimits models.RateLimit
+	if params.RateLimits != nil {
+		rateLimits = *params.RateLimits
+	}
+	if err := rateLimits.Validate(); err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid rate_limits: %s", err.Error())
+	}
+
+	if existing, err := models.FindProjectByName(db, *params.Name); err != nil && !models.IsNotFoundError(err) {
+		return apierrors.NewInternalServerError("Database error checking project name").WithInternalError(err)
+	} else if existing != nil {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeProjectExists, "A project with this name already exists")
+	}
+
+	project, err := models.NewProject(*params.Name, description, rateLimits)
+	if err != nil {
+		return apierrors.NewInternalServerError("Error creating project").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := tx.Create(project); terr != nil {
+			return terr
+		}
+
+		return models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.ProjectCreatedAction, "", map[string]interface{}{
+			"project_id":   project.ID,
+			"project_name": project.Name,
+		})
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error creating project").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusCreated, project)
+}
+
+// adminProjectGet returns a single project.
+func (a *API) adminProjectGet(w http.ResponseWriter, r *http.Request) error {
+	project := getProject(r.Context())
+
+	return sendJSON(w, http.StatusOK, project)
+}
+
+// adminProjectUpdate updates the name, description or rate limits of a
+// project.
+func (a *API) adminProjectUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+
+	params := &AdminProjectParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	if params.Name != nil {
+		if *params.Name == "" {
+			return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "name cannot be empty")
+		}
+
+		if *params.Name != project.Name {
+			if existing, err := models.FindProjectByName(db, *params.Name); err != nil && !models.IsNotFoundError(err) {
+				return apierrors.NewInternalServerError("Database error checking project name").WithInternalError(err)
+			} else if existing != nil {
+				return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeProjectExists, "A project with this name already exists")
+			}
+		}
+	}
+
+	if params.RateLimits != nil {
+		if err := params.RateLimits.Validate(); err != nil {
+			return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Invalid rate_limits: %s", err.Error())
+		}
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if params.Name != nil || params.Description != nil {
+			name := project.Name
+			if params.Name != nil {
+				name = *params.Name
+			}
+			description := project.Description.String()
+			if params.Description != nil {
+				description = *params.Description
+			}
+			if terr := project.UpdateInfo(tx, name, description); terr != nil {
+				return terr
+			}
+		}
+
+		if params.RateLimits != nil {
+			if terr := project.UpdateRateLimits(tx, *params.RateLimits); terr != nil {
+				return terr
+			}
+		}
+
+		return models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.ProjectModifiedAction, "", map[string]interface{}{
+			"project_id":   project.ID,
+			"project_name": project.Name,
+		})
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Error updating project").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, project)
+}
+
+// adminProjectDelete deletes a project. Projects that still have users are
+// only deleted, together with their organizations and users, when the caller
+// sets cascade.
+func (a *API) adminProjectDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+
+	// Cascade defaults to false
+	params := &adminProjectDeleteParams{}
+	if body, _ := utilities.GetBodyBytes(r); len(body) != 0 {
+		// we only want to parse the body if it's not empty
+		// retrieveRequestParams will handle any errors with stream
+		if err := retrieveRequestParams(r, params); err != nil {
+			return err
+		}
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		userCount, terr := models.CountUsersInProject(tx, project.ID)
+		if terr != nil {
+			return apierrors.NewInternalServerError("Database error counting project users").WithInternalError(terr)
+		}
+
+		if userCount > 0 && !params.Cascade {
+			return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeProjectHasUsers, "Project still has %d users, set cascade to delete them with the project", userCount)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.ProjectDeletedAction, "", map[string]interface{}{
+			"project_id":   project.ID,
+			"project_name": project.Name,
+			"cascade":      params.Cascade,
+			"user_count":   userCount,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(project); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting project").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// adminProjectOrganizations responds with a list of the organizations that
+// belong to the project.
+func (a *API) adminProjectOrganizations(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	project := getProject(ctx)
+
+	pageParams, err := paginate(r)
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Pagination Parameters: %v", err).WithInternalError(err)
+	}
+
+	sortParams, err := sort(r, map[string]bool{models.CreatedAt: true}, []models.SortField{{Name: models.CreatedAt, Dir: models.Descending}})
+	if err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Bad Sort Parameters: %v", err)
+	}
+
+	filter := r.URL.Query().Get("filter")
+
+	var organizations []*models.Organization
+	err = db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		organizations, terr = models.FindOrganizations(tx, project.ID, pageParams, sortParams, filter)
+		return terr
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error finding organizations").WithInternalError(err)
+	}
+	addPaginationHeaders(w, r, pageParams)
+
+	return sendJSON(w, http.StatusOK, AdminListOrganizationsResponse{
+		Organizations: organizations,
+	})
+}
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+)
+
+type ProjectAdminTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	token       string
+	globalToken string
+}
+
+func TestProjectAdmin(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &ProjectAdminTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *ProjectAdminTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		Role:      "supabase_admin",
+		ProjectID: ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating admin jwt")
+	ts.token = token
+
+	globalToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		Role: "supabase_admin",
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating admin jwt")
+	ts.globalToken = globalToken
+}
+
+func (ts *ProjectAdminTestSuite) makeRequest(token, method, path string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *ProjectAdminTestSuite) TestAdminProjectsList() {
+	w := ts.makeRequest(ts.token, http.MethodGet, "/admin/projects", nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	data := AdminListProjectsResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Projects, 1)
+	assert.Equal(ts.T(), ts.ProjectID, data.Projects[0].ID)
+}
+
+func (ts *ProjectAdminTestSuite) TestAdminProjectCreate() {
+	body := map[string]interface{}{
+		"name":        "second",
+		"description": "Second project",
+		"rate_limits": map[string]interface{}{
+			"seconds": 60,
+			"rate_limits": []map[string]interface{}{
+				{"tier": "free", "limit": 10},
+				{"tier": "high", "limit": 1000},
+			},
+		},
+	}
+
+	w := ts.makeRequest(ts.token, http.MethodPost, "/admin/projects", body)
+	require.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+
+	w = ts.makeRequest(ts.globalToken, http.MethodPost, "/admin/projects", body)
+	require.Equal(ts.T(), http.StatusCreated, w.Code, w.Body.String())
+
+	project := models.Project{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&project))
+	assert.Equal(ts.T(), "second", project.Name)
+	assert.Equal(ts.T(), 60, project.RateLimits.Seconds)
+	require.Len(ts.T(), project.RateLimits.RateLimits, 2)
+
+	w = ts.makeRequest(ts.globalToken, http.MethodPost, "/admin/projects", body)
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code, w.Body.String())
+
+	w = ts.makeRequest(ts.globalToken, http.MethodGet, "/admin/projects", nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+	assert.Equal(ts.T(), "2", w.Header().Get("X-Total-Count"))
+}
+
+func (ts *ProjectAdminTestSuite) TestAdminProjectCreateValidation() {
+	cases := []struct {
+		desc string
+		body map[string]interface{}
+	}{
+		{
+			desc: "Missing name",
+			body: map[string]interface{}{"description": "No name"},
+		},
+		{
+			desc: "Missing window",
+			body: map[string]interface{}{
+				"name":        "limited",
+				"rate_limits": map[string]interface{}{"rate_limits": []map[string]interface{}{{"tier": "free", "limit": 10}}},
+			},
+		},
+		{
+			desc: "Duplicate tier",
+			body: map[string]interface{}{
+				"name": "limited",
+				"rate_limits": map[string]interface{}{
+					"seconds":     60,
+					"rate_limits": []map[string]interface{}{{"tier": "free", "limit": 10}, {"tier": "free", "limit": 20}},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		ts.Run(c.desc, func() {
+			w := ts.makeRequest(ts.globalToken, http.MethodPost, "/admin/projects", c.body)
+			require.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+		})
+	}
+}
+
+func (ts *ProjectAdminTestSuite) TestAdminProjectGetUpdate() {
+	path := "/admin/projects/" + ts.ProjectID.String()
+
+	w := ts.makeRequest(ts.token, http.MethodGet, path, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	w = ts.makeRequest(ts.token, http.MethodPut, path, map[string]interface{}{
+		"description": "Updated",
+		"rate_limits": map[string]interface{}{
+			"seconds":     30,
+			"rate_limits": []map[string]interface{}{{"tier": "low", "limit": 5}},
+		},
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	project, err := models.FindProjectByID(ts.API.db, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), "Updated", project.Description.String())
+	assert.Equal(ts.T(), 30, project.RateLimits.Seconds)
+}
+
+func (ts *ProjectAdminTestSuite) TestAdminProjectOtherProject() {
+	w := ts.makeRequest(ts.token, http.MethodGet, "/admin/projects/"+uuid.Must(uuid.NewV4()).String(), nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+}
+
+func (ts *ProjectAdminTestSuite) TestAdminProjectOrganizations() {
+	w := ts.makeRequest(ts.token, http.MethodGet, "/admin/projects/"+ts.ProjectID.String()+"/organizations", nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	data := AdminListOrganizationsResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Organizations, 1)
+	assert.Equal(ts.T(), ts.OrganizationID, data.Organizations[0].ID)
+}
+
+func (ts *ProjectAdminTestSuite) TestAdminProjectDelete() {
+	path := "/admin/projects/" + ts.ProjectID.String()
+
+	w := ts.makeRequest(ts.token, http.MethodDelete, path, nil)
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code, w.Body.String())
+
+	_, err := models.FindProjectByID(ts.API.db, ts.ProjectID)
+	require.NoError(ts.T(), err)
+
+	w = ts.makeRequest(ts.token, http.MethodDelete, path, map[string]interface{}{
+		"cascade": true,
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	_, err = models.FindProjectByID(ts.API.db, ts.ProjectID)
+	require.True(ts.T(), models.IsNotFoundError(err))
+}
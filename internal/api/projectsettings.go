@@ -0,0 +1,120 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// AdminProjectSettingsParams are the settings a project overrides. Omitted
+// or null settings inherit the global configuration.
+type AdminProjectSettingsParams struct {
+	EnabledProviders  []string `json:"enabled_providers"`
+	DisableSignup     *bool    `json:"disable_signup"`
+	MailerAutoconfirm *bool    `json:"mailer_autoconfirm"`
+	PhoneAutoconfirm  *bool    `json:"phone_autoconfirm"`
+	AnonymousUsers    *bool    `json:"anonymous_users"`
+	URIAllowList      []string `json:"uri_allow_list"`
+}
+
+// adminProjectSettingsGet responds with the settings a project overrides.
+// The merged view is served by /settings.
+func (a *API) adminProjectSettingsGet(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	project := getProject(ctx)
+
+	settings, err := models.FindProjectSettings(db, project.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeProjectSettingsNotFound, "Project settings not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading project settings").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, settings)
+}
+
+// adminProjectSettingsUpdate creates or replaces the settings of a project.
+func (a *API) adminProjectSettingsUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+
+	params := &AdminProjectSettingsParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	settings := &models.ProjectSettings{
+		ProjectID:         project.ID,
+		DisableSignup:     params.DisableSignup,
+		MailerAutoconfirm: params.MailerAutoconfirm,
+		PhoneAutoconfirm:  params.PhoneAutoconfirm,
+		AnonymousUsers:    params.AnonymousUsers,
+	}
+	settings.SetEnabledProviders(params.EnabledProviders)
+	settings.SetURIAllowList(params.URIAllowList)
+
+	if err := settings.Validate(); err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%v", err)
+	}
+
+	err := db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.SaveProjectSettings(tx, settings); terr != nil {
+			return apierrors.NewInternalServerError("Database error saving project settings").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.ProjectSettingsModifiedAction, "", map[string]interface{}{
+			"project_id":        project.ID,
+			"enabled_providers": settings.GetEnabledProviders(),
+			"uri_allow_list":    settings.GetURIAllowList(),
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, settings)
+}
+
+// adminProjectSettingsDelete removes the settings of a project, it uses the
+// global configuration again.
+func (a *API) adminProjectSettingsDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+
+	settings, err := models.FindProjectSettings(db, project.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeProjectSettingsNotFound, "Project settings not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading project settings").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.ProjectSettingsDeletedAction, "", map[string]interface{}{
+			"project_id": project.ID,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := models.DeleteProjectSettings(tx, settings.ProjectID); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting project settings").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
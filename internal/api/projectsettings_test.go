@@ -0,0 +1,260 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+)
+
+type ProjectSettingsTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	admin *models.User
+	token string
+}
+
+func TestProjectSettings(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &ProjectSettingsTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *ProjectSettingsTestSuite) SetupTest() {
+	ts.Config.DisableSignup = false
+	ts.Config.Mailer.Autoconfirm = false
+	ts.Config.External.AnonymousUsers.Enabled = true
+
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		Role:           "supabase_admin",
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating admin jwt")
+	ts.token = token
+}
+
+func (ts *ProjectSettingsTestSuite) makeRequest(method, path, token string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *ProjectSettingsTestSuite) saveSettings(settings *models.ProjectSettings) {
+	settings.ProjectID = ts.ProjectID
+	require.NoError(ts.T(), models.SaveProjectSettings(ts.API.db, settings))
+}
+
+func (ts *ProjectSettingsTestSuite) TestProjectSettings() {
+	path := fmt.Sprintf("/admin/settings/projects/%s", ts.ProjectID)
+
+	w := ts.makeRequest(http.MethodGet, path, ts.token, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodPut, path, ts.token, map[string]interface{}{
+		"enabled_providers": []string{"email", "github"},
+		"disable_signup":    true,
+		"uri_allow_list":    []string{"https://app.example.com/**"},
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := map[string]interface{}{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	assert.Equal(ts.T(), []interface{}{"email", "github"}, data["enabled_providers"])
+	assert.Equal(ts.T(), true, data["disable_signup"])
+	assert.Nil(ts.T(), data["mailer_autoconfirm"])
+
+	settings, err := models.FindProjectSettings(ts.API.db, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), []string{"https://app.example.com/**"}, settings.GetURIAllowList())
+
+	w = ts.makeRequest(http.MethodDelete, path, ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	_, err = models.FindProjectSettings(ts.API.db, ts.ProjectID)
+	assert.True(ts.T(), models.IsNotFoundError(err))
+}
+
+func (ts *ProjectSettingsTestSuite) TestInvalidProjectSettings() {
+	path := fmt.Sprintf("/admin/settings/projects/%s", ts.ProjectID)
+
+	for _, body := range []map[string]interface{}{
+		{"enabled_providers": []string{"myspace"}},
+		{"uri_allow_list": []string{""}},
+		{"uri_allow_list": []string{"https://[example.com"}},
+	} {
+		w := ts.makeRequest(http.MethodPut, path, ts.token, body)
+		assert.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+	}
+}
+
+func (ts *ProjectSettingsTestSuite) TestOrganizationAdminScope() {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ts.admin.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating organization admin jwt")
+
+	w := ts.makeRequest(http.MethodPut, fmt.Sprintf("/admin/settings/projects/%s", ts.ProjectID), token, map[string]interface{}{
+		"disable_signup": true,
+	})
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+}
+
+func (ts *ProjectSettingsTestSuite) TestMergedSettings() {
+	disabled := false
+	ts.Config.External.Github.Enabled = true
+
+	settings := &models.ProjectSettings{AnonymousUsers: &disabled}
+	settings.SetEnabledProviders([]string{"email"})
+	ts.saveSettings(settings)
+
+	w := ts.makeRequest(http.MethodGet, "/settings", "", nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	global := Settings{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&global))
+	assert.True(ts.T(), global.ExternalProviders.GitHub)
+	assert.True(ts.T(), global.ExternalProviders.AnonymousUsers)
+
+	w = ts.makeRequest(http.MethodGet, fmt.Sprintf("/settings?project_id=%s", ts.ProjectID), "", nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	merged := Settings{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&merged))
+	assert.True(ts.T(), merged.ExternalProviders.Email)
+	assert.False(ts.T(), merged.ExternalProviders.GitHub)
+	assert.False(ts.T(), merged.ExternalProviders.AnonymousUsers)
+
+	w = ts.makeRequest(http.MethodGet, "/settings?project_id=invalid", "", nil)
+	assert.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+func (ts *ProjectSettingsTestSuite) TestSignupDisabled() {
+	disabled := true
+	ts.saveSettings(&models.ProjectSettings{DisableSignup: &disabled})
+
+	w := ts.makeRequest(http.MethodPost, "/signup", "", map[string]interface{}{
+		"email":           "test@example.com",
+		"password":        "test123",
+		"organization_id": ts.OrganizationID,
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code, w.Body.String())
+
+	var httpErr HTTPError
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&httpErr))
+	assert.Equal(ts.T(), apierrors.ErrorCodeSignupDisabled, httpErr.ErrorCode)
+}
+
+func (ts *ProjectSettingsTestSuite) TestMailerAutoconfirm() {
+	enabled := true
+	ts.saveSettings(&models.ProjectSettings{MailerAutoconfirm: &enabled})
+
+	w := ts.makeRequest(http.MethodPost, "/signup", "", map[string]interface{}{
+		"email":           "test@example.com",
+		"password":        "test123",
+		"organization_id": ts.OrganizationID,
+		"project_id":      ts.ProjectID,
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	user, err := models.FindUserByEmailAndAudience(ts.API.db, "test@example.com", ts.Config.JWT.Aud, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	assert.True(ts.T(), user.IsConfirmed())
+}
+
+func (ts *ProjectSettingsTestSuite) TestAnonymousUsersDisabled() {
+	disabled := false
+	ts.saveSettings(&models.ProjectSettings{AnonymousUsers: &disabled})
+
+	w := ts.makeRequest(http.MethodPost, "/signup", "", map[string]interface{}{
+		"organization_id": ts.OrganizationID,
+		"project_id":      ts.ProjectID,
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodeAnonymousProviderDisabled)
+}
+
+func (ts *ProjectSettingsTestSuite) TestOtpProviderDisabled() {
+	settings := &models.ProjectSettings{}
+	settings.SetEnabledProviders([]string{"phone"})
+	ts.saveSettings(settings)
+
+	w := ts.makeRequest(http.MethodPost, "/otp", "", map[string]interface{}{
+		"email":           "test@example.com",
+		"organization_id": ts.OrganizationID,
+		"project_id":      ts.ProjectID,
+	})
+	require.Equal(ts.T(), http.StatusUnprocessableEntity, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodeEmailProviderDisabled)
+}
+
+func (ts *ProjectSettingsTestSuite) TestExternalProviderDisabled() {
+	ts.Config.External.Github.Enabled = true
+
+	settings := &models.ProjectSettings{}
+	settings.SetEnabledProviders([]string{"email"})
+	ts.saveSettings(settings)
+
+	w := ts.makeRequest(http.MethodGet, fmt.Sprintf("/authorize?provider=github&project_id=%s", ts.ProjectID), "", nil)
+	require.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodeProviderDisabled)
+
+	settings.SetEnabledProviders([]string{"github"})
+	settings.SetURIAllowList([]string{"https://app.example.com/**"})
+	ts.saveSettings(settings)
+
+	// The redirect URL is checked against the allow list of the project
+	w = ts.makeRequest(http.MethodGet, fmt.Sprintf("/authorize?provider=github&project_id=%s&redirect_to=%s", ts.ProjectID, "https://app.example.com/callback"), "", nil)
+	require.Equal(ts.T(), http.StatusFound, w.Code, w.Body.String())
+
+	u, err := url.Parse(w.Header().Get("Location"))
+	require.NoError(ts.T(), err, "redirect url parse failed")
+
+	claims := ExternalProviderClaims{}
+	p := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}))
+	_, err = p.ParseWithClaims(u.Query().Get("state"), &claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(ts.Config.JWT.Secret), nil
+	})
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), "https://app.example.com/callback", claims.Referrer)
+}
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type AdminProjectTierParams struct {
+	TierModel string `json:"tier_model"`
+	TierTime  string `json:"tier_time"`
+	TierUsage string `json:"tier_usage"`
+	IsDefault *bool  `json:"is_default"`
+}
+
+type AdminListProjectTiersResponse struct {
+	Tiers []*models.ProjectTier `json:"tiers"`
+}
+
+// apply overrides the tier values that were provided in the request.
+func (p *AdminProjectTierParams) apply(tier *models.ProjectTier) error {
+	if p.TierModel != "" {
+		tier.TierModel = p.TierModel
+	}
+	if p.TierTime != "" {
+		tier.TierTime = p.TierTime
+	}
+	if p.TierUsage != "" {
+		tier.TierUsage = p.TierUsage
+	}
+	if p.IsDefault != nil {
+		tier.IsDefault = *p.IsDefault
+	}
+
+	if err := tier.Validate(); err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%s", err.Error())
+	}
+	return nil
+}
+
+// adminProjectTiers responds with the tiers offered by a project.
+func (a *API) adminProjectTiers(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	project := getProject(ctx)
+
+	tiers, err := models.FindProjectTiers(db, project.ID)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error finding project tiers").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, AdminListProjectTiersResponse{
+		Tiers: tiers,
+	})
+}
+
+// adminProjectTierUpdate creates or updates the tier in the tier URL param.
+// Values that are not provided keep their current value, new tiers default
+// to the free values.
+func (a *API) adminProjectTierUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+	name := chi.URLParam(r, "tier")
+
+	params := &AdminProjectTierParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	var tier *models.ProjectTier
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		tier, terr = models.FindProjectTier(tx, project.ID, name)
+		if terr != nil {
+			if !models.IsNotFoundError(terr) {
+				return apierrors.NewInternalServerError("Database error loading project tier").WithInternalError(terr)
+			}
+			tier = &models.ProjectTier{
+				ProjectID: project.ID,
+				Tier:      name,
+				TierModel: "free",
+				TierTime:  "free",
+				TierUsage: "free",
+			}
+		}
+
+		if terr := params.apply(tier); terr != nil {
+			return terr
+		}
+
+		if terr := models.SaveProjectTier(tx, tier); terr != nil {
+			return apierrors.NewInternalServerError("Database error saving project tier").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.ProjectTierModifiedAction, "", map[string]interface{}{
+			"project_id": project.ID,
+			"tier":       tier.Tier,
+			"is_default": tier.IsDefault,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, tier)
+}
+
+// adminProjectTierDelete removes a tier from a project. Users of a deleted
+// default tier fall back to the global default tier.
+func (a *API) adminProjectTierDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	config := a.config
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+
+	tier, err := models.FindProjectTier(db, project.ID, chi.URLParam(r, "tier"))
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeProjectTierNotFound, "Project tier not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading project tier").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(config.AuditLog, r, tx, adminUser, models.ProjectTierDeletedAction, "", map[string]interface{}{
+			"project_id": project.ID,
+			"tier":       tier.Tier,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(tier); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting project tier").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// UserTier responds with the tier that applies to the current user and
+// whether it comes from the organization, the project or the global default.
+func (a *API) UserTier(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	claims := getClaims(ctx)
+	user := getUser(ctx)
+
+	tier, err := models.FindEffectiveTier(db, claims.OrganizationID, user.ProjectID, claims.OrganizationRole)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading tier").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, tier)
+}
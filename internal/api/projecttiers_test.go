@@ -0,0 +1,164 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+)
+
+type ProjectTiersTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	token string
+}
+
+func TestProjectTiers(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &ProjectTiersTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *ProjectTiersTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	claims := &AccessTokenClaims{
+		Role:      "supabase_admin",
+		ProjectID: ts.ProjectID,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating admin jwt")
+	ts.token = token
+}
+
+func (ts *ProjectTiersTestSuite) makeRequest(method, path, token string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *ProjectTiersTestSuite) userTier(u *models.User, organizationID uuid.UUID) *models.EffectiveTier {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: u.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   organizationID,
+		OrganizationRole: u.OrganizationRole,
+		ProjectID:        u.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err)
+
+	w := ts.makeRequest(http.MethodGet, "/user/tier", token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	tier := &models.EffectiveTier{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(tier))
+	return tier
+}
+
+func (ts *ProjectTiersTestSuite) TestProjectTiers() {
+	path := fmt.Sprintf("/admin/projects/%s/tiers", ts.ProjectID)
+
+	w := ts.makeRequest(http.MethodPut, path+"/pro", ts.token, map[string]interface{}{
+		"tier_model": "high",
+		"tier_time":  "medium",
+		"tier_usage": "high",
+		"is_default": true,
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodGet, path, ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	list := AdminListProjectTiersResponse{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&list))
+
+	defaults := []string{}
+	for _, tier := range list.Tiers {
+		if tier.IsDefault {
+			defaults = append(defaults, tier.Tier)
+		}
+	}
+	assert.Equal(ts.T(), []string{"pro"}, defaults)
+
+	w = ts.makeRequest(http.MethodPut, path+"/pro", ts.token, map[string]interface{}{
+		"tier_model": "unknown",
+	})
+	assert.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodDelete, path+"/pro", ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodDelete, path+"/pro", ts.token, nil)
+	assert.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func (ts *ProjectTiersTestSuite) TestUserTier() {
+	projectUser, err := models.NewUser("", "project@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(projectUser, "organization_id", "organization_role"))
+
+	require.NoError(ts.T(), models.SaveProjectTier(ts.API.db, &models.ProjectTier{
+		ProjectID: ts.ProjectID,
+		Tier:      "pro",
+		TierModel: "high",
+		TierTime:  "high",
+		TierUsage: "medium",
+		IsDefault: true,
+	}))
+
+	tier := ts.userTier(projectUser, uuid.Nil)
+	assert.Equal(ts.T(), models.TierSourceProject, tier.Source)
+	assert.Equal(ts.T(), "pro", tier.Tier)
+	assert.Equal(ts.T(), "high", tier.TierModel)
+
+	// The organization tier takes precedence over the project tier
+	organizationUser, err := models.NewUser("", "organization@example.com", "test", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(organizationUser))
+
+	tier = ts.userTier(organizationUser, ts.OrganizationID)
+	assert.Equal(ts.T(), models.TierSourceOrganization, tier.Source)
+	assert.Equal(ts.T(), models.DefaultTier, tier.Tier)
+
+	// Without a project default the global default applies
+	projectTier, err := models.FindProjectTier(ts.API.db, ts.ProjectID, "pro")
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Destroy(projectTier))
+	require.NoError(ts.T(), ts.API.db.RawQuery("update auth.projects_tiers set is_default = false where project_id = ?", ts.ProjectID).Exec())
+
+	tier = ts.userTier(projectUser, uuid.Nil)
+	assert.Equal(ts.T(), models.TierSourceDefault, tier.Source)
+	assert.Equal(ts.T(), models.DefaultTier, tier.Tier)
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/utilities"
+	"golang.org/x/oauth2"
+)
+
+// CustomOIDCPrefix prefixes the names of the OIDC providers registered by
+// projects, as in custom:<slug>.
+const CustomOIDCPrefix = "custom:"
+
+// IsCustomOIDCProvider reports whether the provider name refers to an OIDC
+// provider registered by a project.
+func IsCustomOIDCProvider(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), CustomOIDCPrefix)
+}
+
+// standardOIDCClaims are the claims of Claims, the remaining claims of a
+// custom provider are passed on as custom claims.
+var standardOIDCClaims = map[string]bool{
+	"iss": true, "sub": true, "aud": true, "iat": true, "exp": true,
+	"name": true, "family_name": true, "given_name": true, "middle_name": true,
+	"nickname": true, "preferred_username": true, "profile": true, "picture": true,
+	"website": true, "gender": true, "birthdate": true, "zoneinfo": true,
+	"locale": true, "updated_at": true, "email": true, "email_verified": true,
+	"phone": true, "phone_verified": true,
+}
+
+// CustomOIDCConfiguration configures an OIDC provider registered by a
+// project. The issuer is read from URL.
+type CustomOIDCConfiguration struct {
+	conf.OAuthProviderConfiguration
+
+	// DiscoveryURL is where the provider metadata is read from, by default
+	// the well-known location below the issuer.
+	DiscoveryURL string
+	Scopes       []string
+
+	// ClaimMappings maps standard claims, such as email, to the claims the
+	// provider returns them in.
+	ClaimMappings map[string]string
+
+	// AllowPrivateNetworks lets the provider be reached on loopback, private
+	// and link-local addresses.
+	AllowPrivateNetworks bool
+}
+
+// customOIDCDiscoveryTTL is how long the metadata of a custom provider is
+// cached before it is discovered again.
+const customOIDCDiscoveryTTL = 10 * time.Minute
+
+// maxCustomOIDCDiscoveries bounds the number of cached provider metadata.
+const maxCustomOIDCDiscoveries = 1000
+
+type customOIDCDiscoveryEntry struct {
+	provider  *oidc.Provider
+	expiresAt time.Time
+}
+
+var customOIDCDiscoveryCache = struct {
+	sync.Mutex
+	entries map[string]customOIDCDiscoveryEntry
+}{
+	entries: make(map[string]customOIDCDiscoveryEntry),
+}
+
+// errCustomOIDCAddressNotAllowed is returned when a custom provider resolves
+// to an address of the internal network.
+var errCustomOIDCAddressNotAllowed = errors.New("custom OIDC provider address is not allowed")
+
+// isInternalAddress reports whether ip is a loopback, private, link-local or
+// unspecified address.
+func isInternalAddress(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsUnspecified()
+}
+
+// newCustomOIDCHTTPClient returns the client used to reach custom providers,
+// whose URLs are chosen by project admins. Unless private networks are
+// allowed, the dialer refuses internal addresses once the host name was
+// resolved, so that neither the URLs nor their DNS records can point the
+// server at itself or its network.
+func newCustomOIDCHTTPClient(allowPrivateNetworks bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: defaultTimeout,
+	}
+	if !allowPrivateNetworks {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternalAddress(ip) {
+				return fmt.Errorf("%w: %s", errCustomOIDCAddressNotAllowed, host)
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout: defaultTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: defaultTimeout,
+		},
+	}
+}
+
+var (
+	customOIDCHTTPClient        = newCustomOIDCHTTPClient(false)
+	customOIDCPrivateHTTPClient = newCustomOIDCHTTPClient(true)
+)
+
+func customOIDCClient(allowPrivateNetworks bool) *http.Client {
+	if allowPrivateNetworks {
+		return customOIDCPrivateHTTPClient
+	}
+	return customOIDCHTTPClient
+}
+
+type customOIDCDiscovery struct {
+	Issuer      string   `json:"issuer"`
+	AuthURL     string   `json:"authorization_endpoint"`
+	TokenURL    string   `json:"token_endpoint"`
+	UserInfoURL string   `json:"userinfo_endpoint"`
+	JWKSURL     string   `json:"jwks_uri"`
+	Algorithms  []string `json:"id_token_signing_alg_values_supported"`
+}
+
+type customOIDCProvider struct {
+	*oauth2.Config
+	client        *http.Client
+	oidc          *oidc.Provider
+	issuer        string
+	claimMappings map[string]string
+}
+
+// discoverCustomOIDCProvider reads the provider metadata from the discovery
+// URL, or from the cache when it was read recently. The metadata must be
+// published by the configured issuer.
+func discoverCustomOIDCProvider(ctx context.Context, client *http.Client, issuer, discoveryURL string) (*oidc.Provider, error) {
+	if discoveryURL == "" {
+		discoveryURL = strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	}
+
+	key := issuer + " " + discoveryURL
+	now := time.Now()
+
+	customOIDCDiscoveryCache.Lock()
+	entry, ok := customOIDCDiscoveryCache.entries[key]
+	customOIDCDiscoveryCache.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.provider, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer utilities.SafeClose(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to read OIDC discovery document: %s", res.Status)
+	}
+
+	var discovery customOIDCDiscovery
+	if err := json.NewDecoder(res.Body).Decode(&discovery); err != nil {
+		return nil, fmt.Errorf("unable to decode OIDC discovery document: %w", err)
+	}
+	if discovery.Issuer != issuer {
+		return nil, fmt.Errorf("OIDC discovery document issuer %q does not match %q", discovery.Issuer, issuer)
+	}
+
+	config := &oidc.ProviderConfig{
+		IssuerURL:   discovery.Issuer,
+		AuthURL:     discovery.AuthURL,
+		TokenURL:    discovery.TokenURL,
+		UserInfoURL: discovery.UserInfoURL,
+		JWKSURL:     discovery.JWKSURL,
+		Algorithms:  discovery.Algorithms,
+	}
+	// The provider outlives the request, it keeps the context to fetch the
+	// signing keys with.
+	provider := config.NewProvider(oidc.ClientContext(context.Background(), client))
+
+	customOIDCDiscoveryCache.Lock()
+	if len(customOIDCDiscoveryCache.entries) >= maxCustomOIDCDiscoveries {
+		customOIDCDiscoveryCache.entries = make(map[string]customOIDCDiscoveryEntry)
+	}
+	customOIDCDiscoveryCache.entries[key] = customOIDCDiscoveryEntry{
+		provider:  provider,
+		expiresAt: now.Add(customOIDCDiscoveryTTL),
+	}
+	customOIDCDiscoveryCache.Unlock()
+
+	return provider, nil
+}
+
+// NewCustomOIDCProvider creates a provider for an OIDC provider registered by
+// a project, configured through OIDC discovery.
+func NewCustomOIDCProvider(ctx context.Context, ext CustomOIDCConfiguration, scopes string) (OAuthProvider, error) {
+	if err := ext.ValidateOAuth(); err != nil {
+		return nil, err
+	}
+	if ext.URL == "" {
+		return nil, errors.New("unable to find issuer for the custom OIDC provider")
+	}
+
+	client := customOIDCClient(ext.AllowPrivateNetworks)
+	oidcProvider, err := discoverCustomOIDCProvider(ctx, client, ext.URL, ext.DiscoveryURL)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthScopes := []string{oidc.ScopeOpenID}
+	for _, scope := range ext.Scopes {
+		if scope != oidc.ScopeOpenID {
+			oauthScopes = append(oauthScopes, scope)
+		}
+	}
+	if scopes != "" {
+		oauthScopes = append(oauthScopes, strings.Split(scopes, ",")...)
+	}
+
+	return &customOIDCProvider{
+		Config: &oauth2.Config{
+			ClientID:     ext.ClientID[0],
+			ClientSecret: ext.Secret,
+			Endpoint:     oidcProvider.Endpoint(),
+			Scopes:       oauthScopes,
+			RedirectURL:  ext.RedirectURI,
+		},
+		client:        client,
+		oidc:          oidcProvider,
+		issuer:        ext.URL,
+		claimMappings: ext.ClaimMappings,
+	}, nil
+}
+
+func (g customOIDCProvider) GetOAuthToken(code string) (*oauth2.Token, error) {
+	return g.Exchange(context.WithValue(context.Background(), oauth2.HTTPClient, g.client), code)
+}
+
+// GetUserData reads the claims of the ID token, or of the userinfo endpoint
+// when the provider returned none.
+func (g customOIDCProvider) GetUserData(ctx context.Context, tok *oauth2.Token) (*UserProvidedData, error) {
+	var raw map[string]interface{}
+	ctx = oidc.ClientContext(ctx, g.client)
+
+	if idToken := tok.Extra("id_token"); idToken != nil {
+		token, _, err := ParseIDToken(ctx, g.oidc, &oidc.Config{
+			ClientID: g.ClientID,
+		}, idToken.(string), ParseIDTokenOptions{
+			AccessToken: tok.AccessToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := token.Claims(&raw); err != nil {
+			return nil, err
+		}
+	} else {
+		userInfo, err := g.oidc.UserInfo(ctx, oauth2.StaticTokenSource(tok))
+		if err != nil {
+			return nil, err
+		}
+		if err := userInfo.Claims(&raw); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := mapCustomOIDCClaims(raw, g.claimMappings)
+	if err != nil {
+		return nil, err
+	}
+	data.Metadata.Issuer = g.issuer
+	return data, nil
+}
+
+// mapCustomOIDCClaims builds the user data from the claims of a custom
+// provider, reading standard claims from the claims they are mapped to.
+func mapCustomOIDCClaims(raw map[string]interface{}, claimMappings map[string]string) (*UserProvidedData, error) {
+	mapped := make(map[string]interface{}, len(raw))
+	customClaims := make(map[string]interface{})
+	for k, v := range raw {
+		if standardOIDCClaims[k] {
+			mapped[k] = v
+		} else {
+			customClaims[k] = v
+		}
+	}
+	for claim, source := range claimMappings {
+		if v, ok := raw[source]; ok {
+			mapped[claim] = v
+		}
+	}
+
+	// Some providers return the verification flags as strings
+	for _, claim := range []string{"email_verified", "phone_verified"} {
+		if s, ok := mapped[claim].(string); ok {
+			mapped[claim], _ = strconv.ParseBool(s)
+		}
+	}
+
+	b, err := json.Marshal(mapped)
+	if err != nil {
+		return nil, err
+	}
+	var claims Claims
+	if err := json.Unmarshal(b, &claims); err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("custom OIDC provider returned no subject")
+	}
+	if len(customClaims) > 0 {
+		claims.CustomClaims = customClaims
+	}
+
+	// To be deprecated
+	claims.FullName = claims.Name
+	claims.AvatarURL = claims.Picture
+	claims.ProviderId = claims.Subject
+
+	data := &UserProvidedData{Metadata: &claims}
+	if claims.Email != "" {
+		data.Emails = []Email{{
+			Email:    claims.Email,
+			Verified: claims.EmailVerified,
+			Primary:  true,
+		}}
+	}
+	return data, nil
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/supabase/auth/internal/conf"
+)
+
+func TestCustomOIDCProviderDiscovery(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/.well-known/openid-configuration", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"userinfo_endpoint":      server.URL + "/userinfo",
+			"jwks_uri":               server.URL + "/keys",
+		}))
+	}))
+	defer server.Close()
+
+	ext := CustomOIDCConfiguration{
+		OAuthProviderConfiguration: conf.OAuthProviderConfiguration{
+			Enabled:     true,
+			ClientID:    []string{"client"},
+			Secret:      "secret",
+			RedirectURI: "https://auth.example.com/callback",
+			URL:         server.URL,
+		},
+		Scopes: []string{"openid", "email", "profile"},
+	}
+
+	// The test server listens on a loopback address
+	_, err := NewCustomOIDCProvider(context.Background(), ext, "groups")
+	require.ErrorIs(t, err, errCustomOIDCAddressNotAllowed)
+
+	ext.AllowPrivateNetworks = true
+	p, err := NewCustomOIDCProvider(context.Background(), ext, "groups")
+	require.NoError(t, err)
+
+	u, err := url.Parse(p.AuthCodeURL("state"))
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	require.Equal(t, "openid email profile groups", u.Query().Get("scope"))
+	require.Equal(t, "client", u.Query().Get("client_id"))
+
+	// The discovery document must belong to the issuer
+	ext.URL = "https://other.example.com"
+	ext.DiscoveryURL = server.URL + "/.well-known/openid-configuration"
+	_, err = NewCustomOIDCProvider(context.Background(), ext, "")
+	require.Error(t, err)
+
+	// The metadata is cached
+	discoveries := 0
+	cached := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		discoveries++
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                 "https://cached.example.com",
+			"authorization_endpoint": "https://cached.example.com/authorize",
+			"token_endpoint":         "https://cached.example.com/token",
+		}))
+	}))
+	defer cached.Close()
+
+	ext.URL = "https://cached.example.com"
+	ext.DiscoveryURL = cached.URL + "/.well-known/openid-configuration"
+	for i := 0; i < 2; i++ {
+		_, err = NewCustomOIDCProvider(context.Background(), ext, "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, discoveries)
+}
+
+func TestIsInternalAddress(t *testing.T) {
+	for _, ip := range []string{"127.0.0.1", "::1", "10.0.0.1", "172.16.0.1", "192.168.1.1", "169.254.169.254", "fe80::1", "fc00::1", "0.0.0.0"} {
+		require.True(t, isInternalAddress(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{"8.8.8.8", "2001:4860:4860::8888"} {
+		require.False(t, isInternalAddress(net.ParseIP(ip)), ip)
+	}
+}
+
+func TestMapCustomOIDCClaims(t *testing.T) {
+	data, err := mapCustomOIDCClaims(map[string]interface{}{
+		"sub":            "123",
+		"upn":            "user@example.com",
+		"email_verified": "true",
+		"display_name":   "Jane Doe",
+		"groups":         []interface{}{"admins"},
+	}, map[string]string{
+		"email": "upn",
+		"name":  "display_name",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "123", data.Metadata.Subject)
+	require.Equal(t, "Jane Doe", data.Metadata.Name)
+	require.Equal(t, []Email{{Email: "user@example.com", Verified: true, Primary: true}}, data.Emails)
+	require.Contains(t, data.Metadata.CustomClaims, "groups")
+
+	_, err = mapCustomOIDCClaims(map[string]interface{}{"email": "user@example.com"}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type RecoveryCodesResponse struct {
+	FactorID      uuid.UUID `json:"factor_id"`
+	RecoveryCodes []string  `json:"recovery_codes"`
+}
+
+type RecoveryCodesStatusResponse struct {
+	FactorID  uuid.UUID `json:"factor_id"`
+	Remaining int       `json:"remaining"`
+}
+
+// generateRecoveryCodes replaces the recovery codes of the user, creating
+// their recovery code factor when missing.
+func (a *API) generateRecoveryCodes(r *http.Request, tx *storage.Connection, user *models.User) (*models.Factor, []string, error) {
+	config := a.config
+
+	factor, err := models.FindRecoveryCodeFactor(tx, user)
+	if err != nil {
+		if !models.IsNotFoundError(err) {
+			return nil, nil, apierrors.NewInternalServerError("Database error loading recovery codes").WithInternalError(err)
+		}
+		factor = models.NewRecoveryCodeFactor(user)
+		if terr := tx.Create(factor); terr != nil {
+			return nil, nil, apierrors.NewInternalServerError("Database error creating recovery code factor").WithInternalError(terr)
+		}
+	}
+
+	codes, err := models.GenerateRecoveryCodes(tx, factor, config.MFA.RecoveryCodes.Count)
+	if err != nil {
+		return nil, nil, apierrors.NewInternalServerError("Database error generating recovery codes").WithInternalError(err)
+	}
+
+	if err := models.NewAuditLogEntry(config.AuditLog, r, tx, user, models.GenerateRecoveryCodesAction, r.RemoteAddr, map[string]interface{}{
+		"factor_id": factor.ID,
+		"count":     len(codes),
+	}); err != nil {
+		return nil, nil, err
+	}
+	return factor, codes, nil
+}
+
+// enrollRecoveryCodes issues the recovery codes of the user on their first
+// MFA enrollment, later enrollments keep the existing codes.
+func (a *API) enrollRecoveryCodes(r *http.Request, tx *storage.Connection, user *models.User) ([]string, error) {
+	if !a.config.MFA.RecoveryCodes.Enabled {
+		return nil, nil
+	}
+
+	if _, err := models.FindRecoveryCodeFactor(tx, user); err == nil {
+		return nil, nil
+	} else if !models.IsNotFoundError(err) {
+		return nil, apierrors.NewInternalServerError("Database error loading recovery codes").WithInternalError(err)
+	}
+
+	_, codes, err := a.generateRecoveryCodes(r, tx, user)
+	return codes, err
+}
+
+// deleteRecoveryCodes removes the recovery code factor of the user, the
+// sessions it verified are downgraded to AAL1.
+func (a *API) deleteRecoveryCodes(r *http.Request, tx *storage.Connection, actor, user *models.User, factor *models.Factor) error {
+	if err := models.NewAuditLogEntryForUser(a.config.AuditLog, r, tx, actor, user, models.DeleteRecoveryCodesAction, r.RemoteAddr, map[string]interface{}{
+		"user_id":   user.ID,
+		"factor_id": factor.ID,
+	}); err != nil {
+		return err
+	}
+	if err := factor.DowngradeSessionsToAAL1(tx); err != nil {
+		return err
+	}
+	if err := tx.Destroy(factor); err != nil {
+		return apierrors.NewInternalServerError("Database error deleting recovery codes").WithInternalError(err)
+	}
+	return nil
+}
+
+// deleteUnusableRecoveryCodes removes the recovery codes of the user once no
+// other verified factor is left, they would otherwise be a second factor on
+// their own.
+func (a *API) deleteUnusableRecoveryCodes(r *http.Request, tx *storage.Connection, actor, user *models.User) error {
+	if err := tx.Load(user, "Factors"); err != nil {
+		return err
+	}
+
+	var recoveryFactor *models.Factor
+	for i := range user.Factors {
+		factor := &user.Factors[i]
+		if factor.IsRecoveryCodeFactor() {
+			recoveryFactor = factor
+		} else if factor.IsVerified() {
+			return nil
+		}
+	}
+	if recoveryFactor == nil {
+		return nil
+	}
+	return a.deleteRecoveryCodes(r, tx, actor, user, recoveryFactor)
+}
+
+// UserRecoveryCodes reports how many recovery codes the user has left.
+func (a *API) UserRecoveryCodes(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+
+	factor, err := models.FindRecoveryCodeFactor(db, user)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeMFARecoveryCodesNotFound, "Recovery codes not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading recovery codes").WithInternalError(err)
+	}
+
+	remaining, err := models.CountUnusedRecoveryCodes(db, factor)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading recovery codes").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, &RecoveryCodesStatusResponse{
+		FactorID:  factor.ID,
+		Remaining: remaining,
+	})
+}
+
+// UserRegenerateRecoveryCodes replaces the recovery codes of the user, the
+// previous codes stop working.
+func (a *API) UserRegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+	session := getSession(ctx)
+
+	if !a.config.MFA.RecoveryCodes.Enabled {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFARecoveryCodesDisabled, "MFA recovery codes are disabled")
+	}
+
+	if err := db.Load(user, "Factors"); err != nil {
+		return apierrors.NewInternalServerError("Database error loading factors").WithInternalError(err)
+	}
+	hasVerifiedFactor := false
+	for _, factor := range user.Factors {
+		if !factor.IsRecoveryCodeFactor() && factor.IsVerified() {
+			hasVerifiedFactor = true
+		}
+	}
+	if !hasVerifiedFactor {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeMFAFactorNotFound, "A verified MFA factor is required to generate recovery codes")
+	}
+	if session == nil || !session.IsAAL2() {
+		return apierrors.NewForbiddenError(apierrors.ErrorCodeInsufficientAAL, "AAL2 required to generate recovery codes")
+	}
+
+	var factor *models.Factor
+	var codes []string
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		factor, codes, terr = a.generateRecoveryCodes(r, tx, user)
+		return terr
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, &RecoveryCodesResponse{
+		FactorID:      factor.ID,
+		RecoveryCodes: codes,
+	})
+}
+
+// adminUserDeleteRecoveryCodes resets the recovery codes of a user, who
+// generates new ones through /user/recovery_codes.
+func (a *API) adminUserDeleteRecoveryCodes(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+	adminUser := getAdminUser(ctx)
+
+	factor, err := models.FindRecoveryCodeFactor(db, user)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeMFARecoveryCodesNotFound, "Recovery codes not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading recovery codes").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		return a.deleteRecoveryCodes(r, tx, adminUser, user, factor)
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
@@ -0,0 +1,284 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/mailer/mockclient"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type RLSTestSuite struct {
+	suite.Suite
+	API       *API
+	Config    *conf.GlobalConfiguration
+	ProjectID uuid.UUID
+
+	adminA        *models.User
+	organizationA *models.Organization
+	organizationB *models.Organization
+	apiKeyA       *models.APIKey
+	apiKeyB       *models.APIKey
+	rawAPIKeyA    string
+}
+
+func TestRLS(t *testing.T) {
+	api, config, err := setupAPIForTest(WithMailer(&mockclient.MockMailer{}))
+	require.NoError(t, err)
+
+	ts := &RLSTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *RLSTestSuite) SetupTest() {
+	var organizationID uuid.UUID
+	ts.ProjectID, organizationID, ts.adminA = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	adminB, err := models.NewUser("", "admin-b@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(adminB, "organization_id", "organization_role"))
+
+	db := ts.API.db.WithContext(storage.WithSessionVariables(context.Background(), storage.BypassRLS))
+	require.NoError(ts.T(), db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		if ts.organizationA, terr = models.FindOrganizationByID(tx, organizationID); terr != nil {
+			return terr
+		}
+
+		if ts.organizationB, terr = models.NewOrganization(ts.ProjectID, adminB.ID, "Organization B", ""); terr != nil {
+			return terr
+		}
+		if terr = tx.Create(ts.organizationB); terr != nil {
+			return terr
+		}
+
+		if ts.apiKeyA, ts.rawAPIKeyA, terr = models.NewAPIKey(ts.organizationA, "key-a", ""); terr != nil {
+			return terr
+		}
+		if terr = tx.Create(ts.apiKeyA); terr != nil {
+			return terr
+		}
+
+		if ts.apiKeyB, _, terr = models.NewAPIKey(ts.organizationB, "key-b", ""); terr != nil {
+			return terr
+		}
+		return tx.Create(ts.apiKeyB)
+	}))
+}
+
+// transaction runs fn as solomon_auth_user_role with the session variables.
+// The test database user owns the tables, and the owner is not subject to
+// the row level security policies.
+func (ts *RLSTestSuite) transaction(vars *storage.SessionVariables, fn func(tx *storage.Connection) error) error {
+	db := ts.API.db.WithContext(storage.WithSessionVariables(context.Background(), vars))
+	return db.Transaction(func(tx *storage.Connection) error {
+		if err := tx.RawQuery("SET LOCAL ROLE solomon_auth_user_role").Exec(); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// tenantTransaction runs fn as the admin of organization A.
+func (ts *RLSTestSuite) tenantTransaction(role string, fn func(tx *storage.Connection) error) error {
+	vars := &storage.SessionVariables{
+		ProjectID:        ts.ProjectID,
+		OrganizationRole: role,
+		Owner:            ts.adminA.ID,
+	}
+	if role != models.OrganizationRoleProjectAdmin {
+		vars.OrganizationID = ts.organizationA.ID
+	}
+	return ts.transaction(vars, fn)
+}
+
+func apiKeyIDs(apiKeys []*models.APIKey) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for _, apiKey := range apiKeys {
+		ids = append(ids, apiKey.ID)
+	}
+	return ids
+}
+
+func (ts *RLSTestSuite) TestCrossTenantReadsReturnNothing() {
+	require.NoError(ts.T(), ts.tenantTransaction(models.OrganizationRoleAdmin, func(tx *storage.Connection) error {
+		apiKeys, err := models.FindAPIKeys(tx, ts.ProjectID, uuid.Nil, nil)
+		require.NoError(ts.T(), err)
+		require.Equal(ts.T(), []uuid.UUID{ts.apiKeyA.ID}, apiKeyIDs(apiKeys))
+
+		apiKeys, err = models.FindAPIKeys(tx, ts.ProjectID, ts.organizationB.ID, nil)
+		require.NoError(ts.T(), err)
+		require.Empty(ts.T(), apiKeys)
+
+		_, err = models.FindAPIKeyByID(tx, ts.apiKeyB.ID)
+		require.True(ts.T(), models.IsNotFoundError(err))
+
+		_, err = models.FindOrganizationByID(tx, ts.organizationA.ID)
+		require.NoError(ts.T(), err)
+
+		_, err = models.FindOrganizationByID(tx, ts.organizationB.ID)
+		require.True(ts.T(), models.IsNotFoundError(err))
+		return nil
+	}))
+}
+
+func (ts *RLSTestSuite) TestProjectAdminReadsWholeProject() {
+	require.NoError(ts.T(), ts.tenantTransaction(models.OrganizationRoleProjectAdmin, func(tx *storage.Connection) error {
+		apiKeys, err := models.FindAPIKeys(tx, ts.ProjectID, uuid.Nil, nil)
+		require.NoError(ts.T(), err)
+		require.ElementsMatch(ts.T(), []uuid.UUID{ts.apiKeyA.ID, ts.apiKeyB.ID}, apiKeyIDs(apiKeys))
+
+		_, err = models.FindOrganizationByID(tx, ts.organizationB.ID)
+		require.NoError(ts.T(), err)
+		return nil
+	}))
+}
+
+func (ts *RLSTestSuite) TestCrossProjectReadsReturnNothing() {
+	vars := &storage.SessionVariables{
+		ProjectID:        uuid.Must(uuid.NewV4()),
+		OrganizationRole: models.OrganizationRoleProjectAdmin,
+	}
+
+	require.NoError(ts.T(), ts.transaction(vars, func(tx *storage.Connection) error {
+		apiKeys, err := models.FindAPIKeys(tx, uuid.Nil, uuid.Nil, nil)
+		require.NoError(ts.T(), err)
+		require.Empty(ts.T(), apiKeys)
+
+		_, err = models.FindOrganizationByID(tx, ts.organizationA.ID)
+		require.True(ts.T(), models.IsNotFoundError(err))
+		return nil
+	}))
+}
+
+func (ts *RLSTestSuite) TestBypassReadsEveryTenant() {
+	require.NoError(ts.T(), ts.transaction(storage.BypassRLS, func(tx *storage.Connection) error {
+		apiKeys, err := models.FindAPIKeys(tx, ts.ProjectID, uuid.Nil, nil)
+		require.NoError(ts.T(), err)
+		require.ElementsMatch(ts.T(), []uuid.UUID{ts.apiKeyA.ID, ts.apiKeyB.ID}, apiKeyIDs(apiKeys))
+		return nil
+	}))
+}
+
+func (ts *RLSTestSuite) TestNonOwnerAdminAndAPIKeyReadOrganization() {
+	for _, role := range []string{models.OrganizationRoleAdmin, models.OrganizationRoleAPIKey} {
+		vars := &storage.SessionVariables{
+			OrganizationID:   ts.organizationA.ID,
+			ProjectID:        ts.ProjectID,
+			OrganizationRole: role,
+		}
+
+		require.NoError(ts.T(), ts.transaction(vars, func(tx *storage.Connection) error {
+			_, err := models.FindOrganizationByID(tx, ts.organizationA.ID)
+			require.NoError(ts.T(), err, role)
+
+			_, err = models.FindOrganizationByID(tx, ts.organizationB.ID)
+			require.True(ts.T(), models.IsNotFoundError(err), role)
+			return nil
+		}))
+	}
+}
+
+func (ts *RLSTestSuite) TestOrganizationAdminCannotDeleteOtherAPIKey() {
+	claims := &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ts.adminA.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.organizationA.ID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err)
+
+	for _, c := range []struct {
+		apiKey *models.APIKey
+		status int
+	}{
+		{ts.apiKeyB, http.StatusNotFound},
+		{ts.apiKeyA, http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("http://localhost/admin/api_keys/%s", c.apiKey.ID), nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		w := httptest.NewRecorder()
+		ts.API.handler.ServeHTTP(w, req)
+		require.Equal(ts.T(), c.status, w.Code, w.Body.String())
+	}
+
+	db := ts.API.db.WithContext(storage.WithSessionVariables(context.Background(), storage.BypassRLS))
+	require.NoError(ts.T(), db.Transaction(func(tx *storage.Connection) error {
+		_, err := models.FindAPIKeyByID(tx, ts.apiKeyB.ID)
+		return err
+	}))
+}
+
+func (ts *RLSTestSuite) TestOrganizationAdminAndAPIKeyManageInvitations() {
+	// An admin that joined organization A after it was created, so is not its
+	// owner
+	adminC, err := models.NewUser("", "admin-c@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(adminC, "organization_id", "organization_role"))
+	require.NoError(ts.T(), ts.API.db.Transaction(func(tx *storage.Connection) error {
+		return adminC.SetOrganization(tx, ts.organizationA.ID, models.OrganizationRoleAdmin)
+	}))
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: adminC.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.organizationA.ID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err)
+
+	for _, c := range []struct {
+		desc   string
+		header string
+		value  string
+		email  string
+	}{
+		{"organization admin", "Authorization", fmt.Sprintf("Bearer %s", token), "invited-by-admin@example.com"},
+		{"API key", "apikey", ts.rawAPIKeyA, "invited-by-key@example.com"},
+	} {
+		ts.Run(c.desc, func() {
+			var buffer bytes.Buffer
+			require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+				"email": c.email,
+				"role":  models.OrganizationRoleClient,
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "http://localhost/admin/invitations", &buffer)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(c.header, c.value)
+			w := httptest.NewRecorder()
+			ts.API.handler.ServeHTTP(w, req)
+			require.Equal(ts.T(), http.StatusCreated, w.Code, w.Body.String())
+
+			req = httptest.NewRequest(http.MethodGet, "http://localhost/admin/invitations", nil)
+			req.Header.Set(c.header, c.value)
+			w = httptest.NewRecorder()
+			ts.API.handler.ServeHTTP(w, req)
+			require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+		})
+	}
+}
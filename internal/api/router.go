@@ -21,6 +21,12 @@ func (r *router) Route(pattern string, fn func(*router)) {
 	})
 }
 
+func (r *router) Group(fn func(*router)) {
+	r.chi.Group(func(c chi.Router) {
+		fn(&router{c})
+	})
+}
+
 func (r *router) Get(pattern string, fn apiHandler) {
 	r.chi.Get(pattern, handler(fn))
 }
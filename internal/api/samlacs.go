@@ -305,8 +305,10 @@ func (a *API) handleSamlAcs(w http.ResponseWriter, r *http.Request) error {
 	if err := db.Transaction(func(tx *storage.Connection) error {
 		var terr error
 
-		organization_id := getOrganizationID(ctx)
-		project_id := getProjectID(ctx)
+		// accounts are created in, or linked within, the organization and
+		// project owning the SSO provider
+		organization_id := ssoProvider.OrganizationID
+		project_id := ssoProvider.ProjectID
 
 		// accounts potentially created via SAML can contain non-unique email addresses in the auth.users table
 		var decision models.AccountLinkingDecision
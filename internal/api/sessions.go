@@ -0,0 +1,162 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// SessionResponse describes a session of a user, as shown on a list of
+// signed in devices.
+type SessionResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	UserAgent     *string    `json:"user_agent,omitempty"`
+	IP            *string    `json:"ip,omitempty"`
+	Tag           string     `json:"tag,omitempty"`
+	AAL           string     `json:"aal"`
+	OAuthClientID *uuid.UUID `json:"oauth_client_id,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	RefreshedAt   time.Time  `json:"refreshed_at"`
+	NotAfter      *time.Time `json:"not_after,omitempty"`
+	Current       bool       `json:"current"`
+}
+
+func (a *API) sessionsResponse(sessions []*models.Session, current *models.Session) []*SessionResponse {
+	// Most recently used first
+	slices.SortFunc(sessions, func(x, y *models.Session) int {
+		return y.LastRefreshedAt(nil).Compare(x.LastRefreshedAt(nil))
+	})
+
+	response := make([]*SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		response = append(response, &SessionResponse{
+			ID:            s.ID,
+			UserAgent:     s.UserAgent,
+			IP:            s.IP,
+			Tag:           s.DetermineTag(a.config.Sessions.Tags),
+			AAL:           s.GetAAL(),
+			OAuthClientID: s.OAuthClientID,
+			CreatedAt:     s.CreatedAt,
+			RefreshedAt:   s.LastRefreshedAt(nil),
+			NotAfter:      s.NotAfter,
+			Current:       current != nil && current.ID == s.ID,
+		})
+	}
+	return response
+}
+
+// findUserSession loads the session of the user named in the URL. The
+// session in the request context stays the one of the caller.
+func (a *API) findUserSession(r *http.Request, user *models.User) (*models.Session, error) {
+	db := a.db.WithContext(r.Context())
+
+	sessionID, err := uuid.FromString(chi.URLParam(r, "session_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "session_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "target_session_id", sessionID)
+
+	session, err := models.FindOwnedSessionByID(db, user, sessionID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeSessionNotFound, "Session not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading session").WithInternalError(err)
+	}
+	return session, nil
+}
+
+// UserSessions lists the sessions of the user, marking the one making the
+// request.
+func (a *API) UserSessions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+
+	sessions, err := models.FindAllSessionsForUser(db, user.ID, false)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading sessions").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{
+		"sessions": a.sessionsResponse(sessions, getSession(ctx)),
+	})
+}
+
+// UserSessionDelete signs the user out of one of their sessions.
+func (a *API) UserSessionDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+
+	session, err := a.findUserSession(r, user)
+	if err != nil {
+		return err
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, user, models.SessionRevokedAction, "", map[string]interface{}{
+			"session_id": session.ID,
+		}); terr != nil {
+			return terr
+		}
+		return models.LogoutSession(tx, session.ID)
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Error revoking session").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// adminUserSessions lists the sessions of a user.
+func (a *API) adminUserSessions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+
+	sessions, err := models.FindAllSessionsForUser(db, user.ID, false)
+	if err != nil {
+		return apierrors.NewInternalServerError("Database error loading sessions").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{
+		"sessions": a.sessionsResponse(sessions, nil),
+	})
+}
+
+// adminUserSessionDelete signs a user out of one of their sessions.
+func (a *API) adminUserSessionDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	user := getUser(ctx)
+	adminUser := getAdminUser(ctx)
+
+	session, err := a.findUserSession(r, user)
+	if err != nil {
+		return err
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntryForUser(a.config.AuditLog, r, tx, adminUser, user, models.SessionRevokedAction, "", map[string]interface{}{
+			"user_id":    user.ID,
+			"session_id": session.ID,
+		}); terr != nil {
+			return terr
+		}
+		return models.LogoutSession(tx, session.ID)
+	})
+	if err != nil {
+		return apierrors.NewInternalServerError("Error revoking session").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+)
+
+type SessionsTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	user    *models.User
+	session *models.Session
+	token   string
+}
+
+func TestSessions(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &SessionsTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *SessionsTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	u, err := models.NewUser("", "sessions@example.com", "password", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err, "Error creating test user model")
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_role"), "Error saving new test user")
+	ts.user = u
+
+	ts.session = ts.createSession(u, "Mozilla/5.0 (Macintosh)", time.Now())
+
+	req := httptest.NewRequest(http.MethodGet, "/user/sessions", nil)
+	token, _, err := ts.API.generateAccessToken(req, ts.API.db, u, &ts.session.ID, models.PasswordGrant)
+	require.NoError(ts.T(), err, "Error generating access token")
+	ts.token = token
+}
+
+func (ts *SessionsTestSuite) createSession(u *models.User, userAgent string, refreshedAt time.Time) *models.Session {
+	s, err := models.NewSession(u.ID, nil)
+	require.NoError(ts.T(), err, "Error creating test session")
+	s.UserAgent = &userAgent
+	s.RefreshedAt = &refreshedAt
+	require.NoError(ts.T(), ts.API.db.Create(s), "Error saving test session")
+	return s
+}
+
+func (ts *SessionsTestSuite) makeRequest(method, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.token))
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *SessionsTestSuite) TestUserSessions() {
+	ts.Config.Sessions.Tags = []string{"default"}
+	other := ts.createSession(ts.user, "Mozilla/5.0 (iPhone)", time.Now().Add(-time.Hour))
+
+	w := ts.makeRequest(http.MethodGet, "/user/sessions")
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	data := struct {
+		Sessions []*SessionResponse `json:"sessions"`
+	}{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&data))
+	require.Len(ts.T(), data.Sessions, 2)
+
+	// Most recently refreshed first
+	assert.Equal(ts.T(), ts.session.ID, data.Sessions[0].ID)
+	assert.True(ts.T(), data.Sessions[0].Current)
+	assert.Equal(ts.T(), other.ID, data.Sessions[1].ID)
+	assert.False(ts.T(), data.Sessions[1].Current)
+	assert.Equal(ts.T(), "Mozilla/5.0 (iPhone)", *data.Sessions[1].UserAgent)
+	assert.Equal(ts.T(), "default", data.Sessions[1].Tag)
+	assert.Equal(ts.T(), models.AAL1.String(), data.Sessions[1].AAL)
+}
+
+func (ts *SessionsTestSuite) TestUserSessionDelete() {
+	other := ts.createSession(ts.user, "Mozilla/5.0 (iPhone)", time.Now())
+
+	w := ts.makeRequest(http.MethodDelete, fmt.Sprintf("/user/sessions/%s", other.ID))
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	_, err := models.FindSessionByID(ts.API.db, other.ID, false)
+	require.True(ts.T(), models.IsNotFoundError(err))
+
+	// The session of the caller is left alone
+	_, err = models.FindSessionByID(ts.API.db, ts.session.ID, false)
+	require.NoError(ts.T(), err)
+
+	w = ts.makeRequest(http.MethodDelete, fmt.Sprintf("/user/sessions/%s", other.ID))
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+	assert.Contains(ts.T(), w.Body.String(), apierrors.ErrorCodeSessionNotFound)
+}
+
+func (ts *SessionsTestSuite) TestUserSessionDeleteOtherUser() {
+	u, err := models.NewUser("", "other@example.com", "password", ts.Config.JWT.Aud, nil, ts.OrganizationID, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(u, "organization_role"))
+	other := ts.createSession(u, "Mozilla/5.0 (iPhone)", time.Now())
+
+	w := ts.makeRequest(http.MethodDelete, fmt.Sprintf("/user/sessions/%s", other.ID))
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+
+	_, err = models.FindSessionByID(ts.API.db, other.ID, false)
+	require.NoError(ts.T(), err)
+}
@@ -1,6 +1,14 @@
 package api
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
 
 type ProviderSettings struct {
 	AnonymousUsers bool `json:"anonymous_users"`
@@ -40,8 +48,61 @@ type Settings struct {
 	SAMLEnabled       bool             `json:"saml_enabled"`
 }
 
+// resolveProjectID returns the project of the request, the project of the
+// organization when only the organization is known. Requests naming an
+// organization that does not exist fail rather than use the global
+// configuration.
+func (a *API) resolveProjectID(tx *storage.Connection, organizationID, projectID uuid.UUID) (uuid.UUID, error) {
+	if projectID != uuid.Nil || organizationID == uuid.Nil {
+		return projectID, nil
+	}
+
+	// The caller is not authorized for the organization yet, only its project
+	// is read.
+	organization, err := a.findOrganization(tx.Context(), organizationID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return uuid.Nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+		}
+		return uuid.Nil, apierrors.NewInternalServerError("Database error loading organization").WithInternalError(err)
+	}
+	return organization.ProjectID, nil
+}
+
+// projectConfig returns the configuration of a project, the global one with
+// the settings of the project applied. The project of the organization is
+// used when only the organization is known.
+func (a *API) projectConfig(tx *storage.Connection, organizationID, projectID uuid.UUID) (*conf.GlobalConfiguration, error) {
+	projectID, err := a.resolveProjectID(tx, organizationID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := models.FindProjectConfiguration(tx, projectID, a.config)
+	if err != nil {
+		return nil, apierrors.NewInternalServerError("Database error loading project settings").WithInternalError(err)
+	}
+	return config, nil
+}
+
+// Settings responds with the global settings, merged with the settings of
+// the project in the project_id query param.
 func (a *API) Settings(w http.ResponseWriter, r *http.Request) error {
-	config := a.config
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	projectID := uuid.Nil
+	if value := r.URL.Query().Get("project_id"); value != "" {
+		var err error
+		if projectID, err = uuid.FromString(value); err != nil {
+			return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "project_id must be an UUID")
+		}
+	}
+
+	config, err := a.projectConfig(db, uuid.Nil, projectID)
+	if err != nil {
+		return err
+	}
 
 	return sendJSON(w, http.StatusOK, &Settings{
 		ExternalProviders: ProviderSettings{
@@ -3,6 +3,7 @@ package shared
 import (
 	"context"
 
+	"github.com/gofrs/uuid"
 	"github.com/supabase/auth/internal/models"
 )
 
@@ -18,8 +19,16 @@ const (
 	UserKey              ContextKey = "user"
 	SessionKey           ContextKey = "session"
 	OAuthServerClientKey ContextKey = "oauth_server_client"
+	TenantKey            ContextKey = "tenant"
 )
 
+// Tenant is the organization and project an admin request is limited to.
+// Either ID is uuid.Nil when the request is not limited to it.
+type Tenant struct {
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+}
+
 // GetUser reads the user from the context - shared implementation
 func GetUser(ctx context.Context) *models.User {
 	if ctx == nil {
@@ -70,3 +79,20 @@ func GetOAuthServerClient(ctx context.Context) *models.OAuthServerClient {
 	}
 	return obj.(*models.OAuthServerClient)
 }
+
+// WithTenant adds the tenant of an admin request to the context
+func WithTenant(ctx context.Context, tenant *Tenant) context.Context {
+	return context.WithValue(ctx, TenantKey, tenant)
+}
+
+// GetTenant retrieves the tenant of an admin request from the context
+func GetTenant(ctx context.Context) *Tenant {
+	if ctx == nil {
+		return nil
+	}
+	obj := ctx.Value(TenantKey)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*Tenant)
+}
@@ -116,25 +116,28 @@ func (params *SignupParams) ToUserModel(isSSOUser bool) (user *models.User, err
 // Signup is the endpoint for registering a new Admin user
 func (a *API) Signup(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	config := a.config
 	db := a.db.WithContext(ctx)
 
-	if config.DisableSignup {
-		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeSignupDisabled, "Signups not allowed for this instance")
-	}
-
 	params := &SignupParams{}
 	if err := retrieveRequestParams(r, params); err != nil {
 		return err
 	}
 
+	config, err := a.projectConfig(db, params.OrganizationID, params.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	if config.DisableSignup {
+		return apierrors.NewUnprocessableEntityError(apierrors.ErrorCodeSignupDisabled, "Signups not allowed for this instance")
+	}
+
 	if err := a.validateSignupParams(ctx, params); err != nil {
 		return err
 	}
 
 	params.ConfigureDefaults()
 
-	var err error
 	flowType := getFlowFromChallenge(params.CodeChallenge)
 
 	var user *models.User
@@ -0,0 +1,248 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// defaultSMTPPort is used when an SMTP configuration does not name a port.
+const defaultSMTPPort = 587
+
+type AdminSMTPConfigParams struct {
+	Host       string `json:"host"`
+	Port       int    `json:"port"`
+	User       string `json:"user"`
+	AdminEmail string `json:"admin_email"`
+	SenderName string `json:"sender_name"`
+	Domain     string `json:"domain"`
+
+	// Pass is left unchanged when omitted, so that the stored password does
+	// not have to be sent again with every update.
+	Pass *string `json:"pass"`
+}
+
+// apply copies the params onto the settings, encrypting the password with
+// the database encryption keys.
+func (p *AdminSMTPConfigParams) apply(a *API, settings *models.SMTPSettings, id string) error {
+	settings.Host = p.Host
+	settings.Port = p.Port
+	if settings.Port == 0 {
+		settings.Port = defaultSMTPPort
+	}
+	settings.User = storage.NullString(p.User)
+	settings.AdminEmail = p.AdminEmail
+	settings.SenderName = storage.NullString(p.SenderName)
+	settings.Domain = storage.NullString(p.Domain)
+
+	if err := settings.Validate(); err != nil {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "%v", err)
+	}
+
+	if p.Pass != nil {
+		if err := settings.SetPassword(id, *p.Pass, a.config.Security.DBEncryption); err != nil {
+			return apierrors.NewInternalServerError("Error encrypting SMTP password").WithInternalError(err)
+		}
+	}
+	return nil
+}
+
+// adminOrganizationSMTPConfigGet responds with the SMTP configuration of an
+// organization. The password is never returned.
+func (a *API) adminOrganizationSMTPConfigGet(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	organization := getOrganization(ctx)
+
+	config, err := models.FindOrganizationSMTPConfig(db, organization.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeSMTPConfigNotFound, "SMTP config not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading SMTP config").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, config)
+}
+
+// adminOrganizationSMTPConfigUpdate creates or replaces the SMTP
+// configuration of an organization.
+func (a *API) adminOrganizationSMTPConfigUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+
+	params := &AdminSMTPConfigParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	config, err := models.FindOrganizationSMTPConfig(db, organization.ID)
+	if err != nil {
+		if !models.IsNotFoundError(err) {
+			return apierrors.NewInternalServerError("Database error loading SMTP config").WithInternalError(err)
+		}
+		config = &models.OrganizationSMTPConfig{OrganizationID: organization.ID}
+	}
+
+	if err := params.apply(a, &config.SMTPSettings, organization.ID.String()); err != nil {
+		return err
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := tx.Save(config); terr != nil {
+			return apierrors.NewInternalServerError("Database error saving SMTP config").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.SMTPConfigModifiedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"host":            config.Host,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, config)
+}
+
+// adminOrganizationSMTPConfigDelete removes the SMTP configuration of an
+// organization, its mail goes through the project or global server again.
+func (a *API) adminOrganizationSMTPConfigDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	organization := getOrganization(ctx)
+
+	config, err := models.FindOrganizationSMTPConfig(db, organization.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeSMTPConfigNotFound, "SMTP config not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading SMTP config").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.SMTPConfigDeletedAction, "", map[string]interface{}{
+			"organization_id": organization.ID,
+			"host":            config.Host,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(config); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting SMTP config").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// adminProjectSMTPConfigGet responds with the SMTP configuration of a
+// project. The password is never returned.
+func (a *API) adminProjectSMTPConfigGet(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	project := getProject(ctx)
+
+	config, err := models.FindProjectSMTPConfig(db, project.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeSMTPConfigNotFound, "SMTP config not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading SMTP config").WithInternalError(err)
+	}
+
+	return sendJSON(w, http.StatusOK, config)
+}
+
+// adminProjectSMTPConfigUpdate creates or replaces the SMTP configuration of
+// a project.
+func (a *API) adminProjectSMTPConfigUpdate(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+
+	params := &AdminSMTPConfigParams{}
+	if err := retrieveRequestParams(r, params); err != nil {
+		return err
+	}
+
+	config, err := models.FindProjectSMTPConfig(db, project.ID)
+	if err != nil {
+		if !models.IsNotFoundError(err) {
+			return apierrors.NewInternalServerError("Database error loading SMTP config").WithInternalError(err)
+		}
+		config = &models.ProjectSMTPConfig{ProjectID: project.ID}
+	}
+
+	if err := params.apply(a, &config.SMTPSettings, project.ID.String()); err != nil {
+		return err
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := tx.Save(config); terr != nil {
+			return apierrors.NewInternalServerError("Database error saving SMTP config").WithInternalError(terr)
+		}
+
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.SMTPConfigModifiedAction, "", map[string]interface{}{
+			"project_id": project.ID,
+			"host":       config.Host,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, config)
+}
+
+// adminProjectSMTPConfigDelete removes the SMTP configuration of a project,
+// its mail goes through the global server again.
+func (a *API) adminProjectSMTPConfigDelete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+	adminUser := getAdminUser(ctx)
+	project := getProject(ctx)
+
+	config, err := models.FindProjectSMTPConfig(db, project.ID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return apierrors.NewNotFoundError(apierrors.ErrorCodeSMTPConfigNotFound, "SMTP config not found")
+		}
+		return apierrors.NewInternalServerError("Database error loading SMTP config").WithInternalError(err)
+	}
+
+	err = db.Transaction(func(tx *storage.Connection) error {
+		if terr := models.NewAuditLogEntry(a.config.AuditLog, r, tx, adminUser, models.SMTPConfigDeletedAction, "", map[string]interface{}{
+			"project_id": project.ID,
+			"host":       config.Host,
+		}); terr != nil {
+			return apierrors.NewInternalServerError("Error recording audit log entry").WithInternalError(terr)
+		}
+
+		if terr := tx.Destroy(config); terr != nil {
+			return apierrors.NewInternalServerError("Database error deleting SMTP config").WithInternalError(terr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendJSON(w, http.StatusOK, map[string]interface{}{})
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+)
+
+type SMTPConfigsTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	admin *models.User
+	token string
+}
+
+func TestSMTPConfigs(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	config.Security.DBEncryption = conf.DatabaseEncryptionConfiguration{
+		Encrypt:         true,
+		EncryptionKeyID: "key-id",
+		EncryptionKey:   "pwFoiPyybQMqNmYVN0gUnpbfpGQV2sDv9vp0ZAxi_Y4",
+		DecryptionKeys: map[string]string{
+			"key-id": "pwFoiPyybQMqNmYVN0gUnpbfpGQV2sDv9vp0ZAxi_Y4",
+		},
+	}
+
+	ts := &SMTPConfigsTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *SMTPConfigsTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, ts.admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ts.admin.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating organization admin jwt")
+	ts.token = token
+}
+
+func (ts *SMTPConfigsTestSuite) makeRequest(method, path string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ts.token))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (ts *SMTPConfigsTestSuite) TestOrganizationSMTPConfig() {
+	path := fmt.Sprintf("/admin/smtp/organizations/%s", ts.OrganizationID)
+
+	w := ts.makeRequest(http.MethodGet, path, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodPut, path, map[string]interface{}{
+		"host":        "smtp.example.com",
+		"user":        "mailer",
+		"pass":        "secret",
+		"admin_email": "noreply@example.com",
+		"domain":      "example.com",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+	assert.NotContains(ts.T(), w.Body.String(), "secret")
+
+	stored, err := models.FindOrganizationSMTPConfig(ts.API.db, ts.OrganizationID)
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), 587, stored.Port)
+	assert.NotEqual(ts.T(), "secret", stored.Pass.String())
+
+	// Updates without a password keep the stored one
+	w = ts.makeRequest(http.MethodPut, path, map[string]interface{}{
+		"host":        "smtp2.example.com",
+		"admin_email": "noreply@example.com",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	smtp, err := models.FindSMTPConfiguration(ts.API.db, ts.OrganizationID, ts.ProjectID, ts.Config.SMTP, ts.Config.Security.DBEncryption)
+	require.NoError(ts.T(), err)
+	require.NotNil(ts.T(), smtp)
+	assert.Equal(ts.T(), "smtp2.example.com", smtp.Host)
+	assert.Equal(ts.T(), "secret", smtp.Pass)
+
+	w = ts.makeRequest(http.MethodDelete, path, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	smtp, err = models.FindSMTPConfiguration(ts.API.db, ts.OrganizationID, ts.ProjectID, ts.Config.SMTP, ts.Config.Security.DBEncryption)
+	require.NoError(ts.T(), err)
+	assert.Nil(ts.T(), smtp)
+}
+
+func (ts *SMTPConfigsTestSuite) TestInvalidSMTPConfig() {
+	w := ts.makeRequest(http.MethodPut, fmt.Sprintf("/admin/smtp/organizations/%s", ts.OrganizationID), map[string]interface{}{
+		"host":        "smtp.example.com",
+		"admin_email": "noreply@other.com",
+		"domain":      "example.com",
+	})
+	assert.Equal(ts.T(), http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+func (ts *SMTPConfigsTestSuite) TestOrganizationAdminScope() {
+	w := ts.makeRequest(http.MethodGet, fmt.Sprintf("/admin/smtp/organizations/%s", uuid.Must(uuid.NewV4())), nil)
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+
+	w = ts.makeRequest(http.MethodPut, fmt.Sprintf("/admin/smtp/projects/%s", ts.ProjectID), map[string]interface{}{
+		"host":        "smtp.example.com",
+		"admin_email": "noreply@example.com",
+	})
+	assert.Equal(ts.T(), http.StatusForbidden, w.Code, w.Body.String())
+}
+
+func (ts *SMTPConfigsTestSuite) TestSMTPConfigurationFallback() {
+	project := &models.ProjectSMTPConfig{
+		ProjectID: ts.ProjectID,
+		SMTPSettings: models.SMTPSettings{
+			Host:       "smtp.project.com",
+			Port:       587,
+			AdminEmail: "noreply@project.com",
+		},
+	}
+	require.NoError(ts.T(), ts.API.db.Create(project))
+
+	smtp, err := models.FindSMTPConfiguration(ts.API.db, ts.OrganizationID, ts.ProjectID, ts.Config.SMTP, ts.Config.Security.DBEncryption)
+	require.NoError(ts.T(), err)
+	require.NotNil(ts.T(), smtp)
+	assert.Equal(ts.T(), "smtp.project.com", smtp.Host)
+
+	organization := &models.OrganizationSMTPConfig{
+		OrganizationID: ts.OrganizationID,
+		SMTPSettings: models.SMTPSettings{
+			Host:       "smtp.organization.com",
+			Port:       587,
+			AdminEmail: "noreply@organization.com",
+		},
+	}
+	require.NoError(ts.T(), ts.API.db.Create(organization))
+
+	smtp, err = models.FindSMTPConfiguration(ts.API.db, ts.OrganizationID, ts.ProjectID, ts.Config.SMTP, ts.Config.Security.DBEncryption)
+	require.NoError(ts.T(), err)
+	require.NotNil(ts.T(), smtp)
+	assert.Equal(ts.T(), "smtp.organization.com", smtp.Host)
+
+	smtp, err = models.FindSMTPConfiguration(ts.API.db, uuid.Nil, uuid.Must(uuid.NewV4()), ts.Config.SMTP, ts.Config.Security.DBEncryption)
+	require.NoError(ts.T(), err)
+	assert.Nil(ts.T(), smtp)
+}
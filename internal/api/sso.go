@@ -34,8 +34,9 @@ func (p *SingleSignOnParams) validate() (bool, error) {
 	} else if !hasProviderID && !hasDomain {
 		return hasProviderID, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "A provider_id or domain needs to be provided")
 	}
-	if p.OrganizationID == uuid.Nil && p.ProjectID == uuid.Nil {
-		return hasProviderID, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "Organization ID or Project ID must be set")
+	if hasDomain && p.ProjectID == uuid.Nil {
+		// the same domain can be assigned to providers of different projects
+		return hasProviderID, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "A project_id needs to be provided with a domain")
 	}
 
 	return hasProviderID, nil
@@ -67,7 +68,7 @@ func (a *API) SingleSignOn(w http.ResponseWriter, r *http.Request) error {
 			return apierrors.NewInternalServerError("Unable to find SSO provider by ID").WithInternalError(err)
 		}
 	} else {
-		ssoProvider, err = models.FindSSOProviderByDomain(db, params.Domain)
+		ssoProvider, err = models.FindSSOProviderByDomain(db, params.Domain, params.ProjectID)
 		if models.IsNotFoundError(err) {
 			return apierrors.NewNotFoundError(apierrors.ErrorCodeSSOProviderNotFound, "No SSO provider assigned for this domain")
 		} else if err != nil {
@@ -75,6 +76,11 @@ func (a *API) SingleSignOn(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 
+	if (params.ProjectID != uuid.Nil && ssoProvider.ProjectID != params.ProjectID) ||
+		(params.OrganizationID != uuid.Nil && ssoProvider.OrganizationID != params.OrganizationID) {
+		return apierrors.NewNotFoundError(apierrors.ErrorCodeSSOProviderNotFound, "No such SSO provider")
+	}
+
 	if !ssoProvider.IsEnabled() {
 		return apierrors.NewNotFoundError(
 			apierrors.ErrorCodeSSOProviderDisabled,
@@ -91,10 +97,8 @@ func (a *API) SingleSignOn(w http.ResponseWriter, r *http.Request) error {
 	var flowStateID *uuid.UUID
 	flowStateID = nil
 	if isPKCEFlow(flowType) {
-
-		organization_id := params.OrganizationID
-		project_id := params.ProjectID
-		flowState, err := generateFlowState(db, models.SSOSAML.String(), models.SSOSAML, codeChallengeMethod, codeChallenge, nil, organization_id, project_id)
+		// users signing in through the provider belong to its organization
+		flowState, err := generateFlowState(db, models.SSOSAML.String(), models.SSOSAML, codeChallengeMethod, codeChallenge, nil, ssoProvider.OrganizationID, ssoProvider.ProjectID)
 		if err != nil {
 			return err
 		}
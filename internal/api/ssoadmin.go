@@ -1,7 +1,5 @@
 package api
 
-//lint:file-ignore U1000 SSO provider functionality is kept for future use
-
 import (
 	"context"
 	"io"
@@ -23,10 +21,12 @@ import (
 
 // loadSSOProvider looks for an idp_id and first checks it for a "resource_"
 // prefix, if present the provider is loaded by resource_id. Otherwise the
-// provider is loaded by id.
+// provider is loaded by id. Providers outside of the admin's tenant are
+// reported as not found.
 func (a *API) loadSSOProvider(w http.ResponseWriter, r *http.Request) (context.Context, error) {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
+	organizationID, projectID := a.adminTenant(ctx, r)
 
 	var (
 		provider *models.SSOProvider
@@ -38,7 +38,7 @@ func (a *API) loadSSOProvider(w http.ResponseWriter, r *http.Request) (context.C
 	switch {
 	case strings.HasPrefix(idpParam, resourcePrefix):
 		resourceID := strings.TrimPrefix(idpParam, resourcePrefix)
-		provider, err = models.FindSSOProviderByResourceID(db, resourceID)
+		provider, err = models.FindSSOProviderByResourceID(db, resourceID, projectID)
 	default:
 		idpID, idpErr := uuid.FromString(idpParam)
 		if idpErr != nil {
@@ -55,17 +55,23 @@ func (a *API) loadSSOProvider(w http.ResponseWriter, r *http.Request) (context.C
 		}
 	}
 
+	if (projectID != uuid.Nil && provider.ProjectID != projectID) ||
+		(organizationID != uuid.Nil && provider.OrganizationID != organizationID) {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeSSOProviderNotFound, "SSO Identity Provider not found")
+	}
+
 	observability.LogEntrySetField(r, "sso_provider_id", provider.ID.String())
 	return withSSOProvider(r.Context(), provider), nil
 }
 
-// adminSSOProvidersList lists all SSO Identity Providers in the system. Does
-// not deal with pagination at this time.
+// adminSSOProvidersList lists the SSO Identity Providers of the admin's
+// tenant. Does not deal with pagination at this time.
 func (a *API) adminSSOProvidersList(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
+	organizationID, projectID := a.adminTenant(ctx, r)
 
-	providers, err := models.FindAllSSOProvidersByFilter(db, r.URL.Query())
+	providers, err := models.FindAllSSOProvidersByFilter(db, r.URL.Query(), organizationID, projectID)
 	if err != nil {
 		return err
 	}
@@ -81,7 +87,8 @@ func (a *API) adminSSOProvidersList(w http.ResponseWriter, r *http.Request) erro
 }
 
 type CreateSSOProviderParams struct {
-	Type string `json:"type"`
+	Type           string    `json:"type"`
+	OrganizationID uuid.UUID `json:"organization_id"`
 
 	MetadataURL      string                      `json:"metadata_url"`
 	MetadataXML      string                      `json:"metadata_xml"`
@@ -209,7 +216,8 @@ func fetchSAMLMetadata(ctx context.Context, url string) ([]byte, error) {
 	return data, nil
 }
 
-// adminSSOProvidersCreate creates a new SAML Identity Provider in the system.
+// adminSSOProvidersCreate creates a new SAML Identity Provider owned by an
+// organization.
 func (a *API) adminSSOProvidersCreate(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	db := a.db.WithContext(ctx)
@@ -223,6 +231,11 @@ func (a *API) adminSSOProvidersCreate(w http.ResponseWriter, r *http.Request) er
 		return err
 	}
 
+	organization, err := a.adminOrganization(ctx, r, params.OrganizationID)
+	if err != nil {
+		return err
+	}
+
 	rawMetadata, metadata, err := params.metadata(ctx)
 	if err != nil {
 		return err
@@ -237,6 +250,8 @@ func (a *API) adminSSOProvidersCreate(w http.ResponseWriter, r *http.Request) er
 	}
 
 	provider := &models.SSOProvider{
+		OrganizationID: organization.ID,
+		ProjectID:      organization.ProjectID,
 
 		// TODO handle Name, Description, Attribute Mapping
 		SAMLProvider: models.SAMLProvider{
@@ -261,7 +276,7 @@ func (a *API) adminSSOProvidersCreate(w http.ResponseWriter, r *http.Request) er
 	provider.SAMLProvider.AttributeMapping = params.AttributeMapping
 
 	for _, domain := range params.Domains {
-		existingProvider, err := models.FindSSOProviderByDomain(db, domain)
+		existingProvider, err := models.FindSSOProviderByDomain(db, domain, organization.ProjectID)
 		if err != nil && !models.IsNotFoundError(err) {
 			return err
 		}
@@ -313,6 +328,10 @@ func (a *API) adminSSOProvidersUpdate(w http.ResponseWriter, r *http.Request) er
 
 	provider := getSSOProvider(ctx)
 
+	if params.OrganizationID != uuid.Nil && params.OrganizationID != provider.OrganizationID {
+		return apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "The organization of an SSO provider cannot be changed")
+	}
+
 	if params.MetadataXML != "" || params.MetadataURL != "" {
 		// metadata is being updated
 		rawMetadata, metadata, err := params.metadata(ctx)
@@ -342,7 +361,7 @@ func (a *API) adminSSOProvidersUpdate(w http.ResponseWriter, r *http.Request) er
 	keepDomains := make(map[string]bool)
 
 	for _, domain := range params.Domains {
-		existingProvider, err := models.FindSSOProviderByDomain(db, domain)
+		existingProvider, err := models.FindSSOProviderByDomain(db, domain, provider.ProjectID)
 		if err != nil && !models.IsNotFoundError(err) {
 			return err
 		}
@@ -357,6 +376,7 @@ func (a *API) adminSSOProvidersUpdate(w http.ResponseWriter, r *http.Request) er
 			createDomains = append(createDomains, models.SSODomain{
 				Domain:        domain,
 				SSOProviderID: provider.ID,
+				ProjectID:     provider.ProjectID,
 			})
 		}
 	}
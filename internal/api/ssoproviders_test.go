@@ -0,0 +1,228 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+type SSOProvidersTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+
+	other *models.Organization
+	token string
+}
+
+func TestSSOProviders(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &SSOProvidersTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	if config.SAML.Enabled {
+		suite.Run(t, ts)
+	}
+}
+
+func (ts *SSOProvidersTestSuite) SetupTest() {
+	var admin *models.User
+	ts.ProjectID, ts.OrganizationID, admin = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: admin.ID.String(),
+		},
+		Role:             "authenticated",
+		OrganizationID:   ts.OrganizationID,
+		OrganizationRole: models.OrganizationRoleAdmin,
+		ProjectID:        ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err, "Error generating organization admin jwt")
+	ts.token = token
+
+	owner, err := models.NewUser("", "owner@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(owner, "organization_id", "organization_role"))
+
+	ts.other, err = models.NewOrganization(ts.ProjectID, owner.ID, "Other", "")
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		return tx.Create(ts.other)
+	}))
+}
+
+func (ts *SSOProvidersTestSuite) makeRequest(method, path, token string, body map[string]interface{}) *httptest.ResponseRecorder {
+	var buffer bytes.Buffer
+	if body != nil {
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buffer)
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	ts.API.handler.ServeHTTP(w, req)
+	return w
+}
+
+// createOtherProvider creates a provider owned by the other organization of
+// the project.
+func (ts *SSOProvidersTestSuite) createOtherProvider(domain string) *models.SSOProvider {
+	provider := &models.SSOProvider{
+		OrganizationID: ts.other.ID,
+		ProjectID:      ts.ProjectID,
+		SAMLProvider: models.SAMLProvider{
+			EntityID:    "https://other.example.com/saml/metadata",
+			MetadataXML: ssoProviderMetadata("https://other.example.com/saml/metadata"),
+		},
+		SSODomains: []models.SSODomain{
+			{
+				Domain: domain,
+			},
+		},
+	}
+	require.NoError(ts.T(), ts.API.db.Eager().Create(provider))
+	return provider
+}
+
+func (ts *SSOProvidersTestSuite) TestOrganizationAdminManagesOwnProviders() {
+	w := ts.makeRequest(http.MethodPost, "/admin/sso/providers", ts.token, map[string]interface{}{
+		"type":         "saml",
+		"metadata_xml": ssoProviderMetadata("https://idp.example.com/saml/metadata"),
+		"domains":      []string{"example.com"},
+	})
+	require.Equal(ts.T(), http.StatusCreated, w.Code, w.Body.String())
+
+	provider := models.SSOProvider{}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&provider))
+	assert.Equal(ts.T(), ts.OrganizationID, provider.OrganizationID)
+	assert.Equal(ts.T(), ts.ProjectID, provider.ProjectID)
+
+	other := ts.createOtherProvider("example.org")
+
+	w = ts.makeRequest(http.MethodGet, "/admin/sso/providers", ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+	var list struct {
+		Items []models.SSOProvider `json:"items"`
+	}
+	require.NoError(ts.T(), json.NewDecoder(w.Body).Decode(&list))
+	require.Len(ts.T(), list.Items, 1)
+	assert.Equal(ts.T(), provider.ID, list.Items[0].ID)
+
+	w = ts.makeRequest(http.MethodGet, fmt.Sprintf("/admin/sso/providers/%s", provider.ID), ts.token, nil)
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	// providers of other organizations are not visible
+	w = ts.makeRequest(http.MethodGet, fmt.Sprintf("/admin/sso/providers/%s", other.ID), ts.token, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+	w = ts.makeRequest(http.MethodDelete, fmt.Sprintf("/admin/sso/providers/%s", other.ID), ts.token, nil)
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+}
+
+func (ts *SSOProvidersTestSuite) TestCreateValidation() {
+	w := ts.makeRequest(http.MethodPost, "/admin/sso/providers", ts.token, map[string]interface{}{
+		"type":            "saml",
+		"organization_id": ts.other.ID,
+		"metadata_xml":    ssoProviderMetadata("https://idp.example.com/saml/metadata"),
+	})
+	require.Equal(ts.T(), http.StatusForbidden, w.Code)
+
+	// domains are unique within a project
+	ts.createOtherProvider("example.com")
+	w = ts.makeRequest(http.MethodPost, "/admin/sso/providers", ts.token, map[string]interface{}{
+		"type":         "saml",
+		"metadata_xml": ssoProviderMetadata("https://idp.example.com/saml/metadata"),
+		"domains":      []string{"example.com"},
+	})
+	require.Equal(ts.T(), http.StatusBadRequest, w.Code)
+}
+
+func (ts *SSOProvidersTestSuite) TestSingleSignOnUsesProviderTenant() {
+	provider := ts.createOtherProvider("example.com")
+
+	w := ts.makeRequest(http.MethodPost, "/sso", "", map[string]interface{}{
+		"domain":             "example.com",
+		"skip_http_redirect": true,
+	})
+	require.Equal(ts.T(), http.StatusBadRequest, w.Code)
+
+	w = ts.makeRequest(http.MethodPost, "/sso", "", map[string]interface{}{
+		"provider_id":        provider.ID,
+		"organization_id":    ts.OrganizationID,
+		"skip_http_redirect": true,
+	})
+	require.Equal(ts.T(), http.StatusNotFound, w.Code)
+
+	w = ts.makeRequest(http.MethodPost, "/sso", "", map[string]interface{}{
+		"domain":                "example.com",
+		"project_id":            ts.ProjectID,
+		"skip_http_redirect":    true,
+		"code_challenge":        "vby3iMQ4XUuycKkEyNsYHXshPql1Dod7Ebey2iXTXm4",
+		"code_challenge_method": "s256",
+	})
+	require.Equal(ts.T(), http.StatusOK, w.Code, w.Body.String())
+
+	relayState := models.SAMLRelayState{}
+	require.NoError(ts.T(), ts.API.db.Q().Where("sso_provider_id = ?", provider.ID).First(&relayState))
+	require.NotNil(ts.T(), relayState.FlowStateID)
+
+	flowState, err := models.FindFlowStateByID(ts.API.db, relayState.FlowStateID.String())
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), ts.other.ID, flowState.OrganizationID.UUID)
+	assert.Equal(ts.T(), ts.ProjectID, flowState.ProjectID)
+}
+
+func ssoProviderMetadata(entityID string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?><md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+  <md:IDPSSODescriptor WantAuthnRequestsSigned="false" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+        <ds:X509Data>
+          <ds:X509Certificate>MIIDdDCCAlygAwIBAgIGAYKSjRZiMA0GCSqGSIb3DQEBCwUAMHsxFDASBgNVBAoTC0dvb2dsZSBJ
+bmMuMRYwFAYDVQQHEw1Nb3VudGFpbiBWaWV3MQ8wDQYDVQQDEwZHb29nbGUxGDAWBgNVBAsTD0dv
+b2dsZSBGb3IgV29yazELMAkGA1UEBhMCVVMxEzARBgNVBAgTCkNhbGlmb3JuaWEwHhcNMjIwODEy
+MTQ1NDU1WhcNMjcwODExMTQ1NDU1WjB7MRQwEgYDVQQKEwtHb29nbGUgSW5jLjEWMBQGA1UEBxMN
+TW91bnRhaW4gVmlldzEPMA0GA1UEAxMGR29vZ2xlMRgwFgYDVQQLEw9Hb29nbGUgRm9yIFdvcmsx
+CzAJBgNVBAYTAlVTMRMwEQYDVQQIEwpDYWxpZm9ybmlhMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A
+MIIBCgKCAQEAlncFzErcnZm7ZWO71NZStnCIAoYNKf6Uw3LPLzcvk0YrA/eBC3PVDHSfahi+apGO
+Ytdq7IQUvBdto3rJTvP49fjyO0WLbAbiPC+dILt2Gx9kttxpSp99Bf+8ObL/fTy5Y2oHbJBfBX1V
+qfDQIY0fcej3AndFYUOE0gZXyeSbnROB8W1PzHxOc7rq1mlas0rvyja7AK4gwXjIwyIGsFDmHnve
+buqWOYMzOT9oD+iQq9BWYVHkXGZn0BXzKtnw9w8I3IxQdndUoCl95pYRIvdl1b0dWdO9cXtSsTkL
+kAa8B/mCQcF4W2M3t/yKtrcLcRTALg3/Hc+Xz+3BpY/fSDk1SwIDAQABMA0GCSqGSIb3DQEBCwUA
+A4IBAQCER02WLf6bKwTGVD/3VTntetIiETuPs46Dum8blbsg+2BYdAHIQcB9cLuMRosIw0nYj54m
+SfiyfoWGcx3CkMup1MtKyWu+SqDHl9Bpf+GFLG0ngKD/zB6xwpv/TCi+g/FBYe2TvzD6B1V0z7Vs
+Xf+Gc2TWBKmCuKf/g2AUt7IQLpOaqxuJVoZjp4sEMov6d3FnaoHQEd0lg+XmnYfLNtwe3QRSU0BD
+x6lVV4kXi0x0n198/gkjnA85rPZoZ6dmqHtkcM0Gabgg6KEE5ubSDlWDsdv27uANceCZAoxd1+in
+4/KqqkhynnbJs7Op5ZX8cckiHGGTGHNb35kys/XukuCo</ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</md:NameIDFormat>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="%s"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="%s"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, entityID, entityID, entityID)
+}
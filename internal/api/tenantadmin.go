@@ -0,0 +1,349 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/api/shared"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// adminScope is the tenant an organization or project admin is allowed to
+// manage. OrganizationID is uuid.Nil for project admins. OrganizationRole is
+// the role of the caller, which limits the users it may manage.
+type adminScope struct {
+	OrganizationID   uuid.UUID
+	ProjectID        uuid.UUID
+	OrganizationRole string
+}
+
+// organizationRoleRanks orders the organization roles by how much they are
+// allowed to manage. Roles that are not listed rank lowest.
+var organizationRoleRanks = map[string]int{
+	models.OrganizationRoleAPIKey:       1,
+	models.OrganizationRoleAdmin:        2,
+	models.OrganizationRoleProjectAdmin: 3,
+}
+
+// outranks reports whether the user has an organization role above the one of
+// the caller, such as a project admin for an organization admin, and so may
+// not be managed by it.
+func (s *adminScope) outranks(user *models.User) bool {
+	return organizationRoleRanks[user.OrganizationRole] > organizationRoleRanks[s.OrganizationRole]
+}
+
+// contains reports whether the user belongs to the scope, either through its
+// home organization or as a member of the organization of the scope.
+func (s *adminScope) contains(tx *storage.Connection, user *models.User) (bool, error) {
+	if user.ProjectID != s.ProjectID {
+		return false, nil
+	}
+	if s.OrganizationID == uuid.Nil {
+		return true, nil
+	}
+	if _, err := user.FindOrganizationMembership(tx, s.OrganizationID); err != nil {
+		if models.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// requireUserAdminCredentials accepts service role tokens, which keep global
+// access, tokens of users whose organization_role was granted permissions,
+// such as admin and project_admin, which are scoped to their organization or
+// project, and API keys, which are scoped to their organization.
+func (a *API) requireUserAdminCredentials(w http.ResponseWriter, req *http.Request) (context.Context, error) {
+	if apiKey := getCallerAPIKey(req.Context()); apiKey != nil {
+		return a.requireAPIKeyAdmin(req.Context(), apiKey)
+	}
+
+	t, err := a.extractBearerToken(req)
+	if err != nil || t == "" {
+		return nil, err
+	}
+
+	ctx, err := a.parseJWTClaims(t, req)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := getClaims(ctx)
+	if claims == nil || slices.Contains(a.config.JWT.AdminRoles, claims.Role) {
+		return a.requireAdmin(ctx)
+	}
+
+	return a.requireTenantAdmin(ctx)
+}
+
+// requireTenantAdmin checks that the token belongs to a user whose
+// organization role was granted any permission, such as organization and
+// project admins. The role is read from the database rather than the token so
+// that revoked admins lose access before their token expires. Users that
+// switched organization use their role as a member of the organization of the
+// token. Which endpoints the user may call is left to requirePermission.
+func (a *API) requireTenantAdmin(ctx context.Context) (context.Context, error) {
+	db := a.db.WithContext(ctx)
+	claims := getClaims(ctx)
+
+	userID, err := uuid.FromString(claims.Subject)
+	if err != nil {
+		return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeBadJWT, "invalid claim: sub claim must be a UUID").WithInternalError(err)
+	}
+
+	user, err := models.FindUserByID(db, userID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "User not allowed")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading admin").WithInternalError(err)
+	}
+
+	if user.IsBanned() || user.ProjectID != claims.ProjectID {
+		return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "User not allowed")
+	}
+
+	// Project admins manage their whole project, every other role is limited
+	// to the organization of the token.
+	scope := &adminScope{ProjectID: user.ProjectID, OrganizationRole: user.OrganizationRole}
+	if user.OrganizationRole != models.OrganizationRoleProjectAdmin {
+		member, err := user.FindOrganizationMembership(db, claims.OrganizationID)
+		if err != nil {
+			if models.IsNotFoundError(err) {
+				return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "User not allowed")
+			}
+			return nil, apierrors.NewInternalServerError("Database error loading organization membership").WithInternalError(err)
+		}
+		scope.OrganizationID = member.OrganizationID
+		scope.OrganizationRole = member.Role
+	}
+	if scope.OrganizationRole != claims.OrganizationRole {
+		return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "User not allowed")
+	}
+
+	permissions, err := models.FindPermissionsByOrganizationRole(db, scope.OrganizationRole)
+	if err != nil {
+		return nil, apierrors.NewInternalServerError("Database error loading permissions").WithInternalError(err)
+	}
+	if len(permissions) == 0 {
+		return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "User not allowed")
+	}
+
+	ctx = storage.WithSessionVariables(ctx, &storage.SessionVariables{
+		OrganizationID:   scope.OrganizationID,
+		ProjectID:        scope.ProjectID,
+		OrganizationRole: scope.OrganizationRole,
+		Owner:            user.ID,
+	})
+	ctx = withAdminUser(ctx, user)
+	return withAdminScope(ctx, scope), nil
+}
+
+// requireAPIKeyAdmin lets an API key act as an admin of its organization.
+// The key is recorded as the actor of the audit log entries it causes.
+func (a *API) requireAPIKeyAdmin(ctx context.Context, apiKey *models.APIKey) (context.Context, error) {
+	actor := &models.User{
+		ID:               apiKey.ID,
+		Role:             models.OrganizationRoleAPIKey,
+		Email:            storage.NullString(apiKey.Name),
+		ProjectID:        apiKey.ProjectID,
+		OrganizationID:   uuid.NullUUID{UUID: apiKey.OrganizationID, Valid: true},
+		OrganizationRole: models.OrganizationRoleAPIKey,
+	}
+
+	ctx = withAdminUser(ctx, actor)
+	return withAdminScope(ctx, &adminScope{
+		OrganizationID:   apiKey.OrganizationID,
+		ProjectID:        apiKey.ProjectID,
+		OrganizationRole: models.OrganizationRoleAPIKey,
+	}), nil
+}
+
+// withClaimsSessionVariables limits the transactions of an authenticated
+// user to the tenant in their claims. Tokens with an admin role bypass the
+// row level security policies.
+func (a *API) withClaimsSessionVariables(ctx context.Context) context.Context {
+	claims := getClaims(ctx)
+	if claims == nil {
+		return ctx
+	}
+	if slices.Contains(a.config.JWT.AdminRoles, claims.Role) {
+		return storage.WithSessionVariables(ctx, storage.BypassRLS)
+	}
+
+	vars := &storage.SessionVariables{
+		OrganizationID:   claims.OrganizationID,
+		ProjectID:        claims.ProjectID,
+		OrganizationRole: claims.OrganizationRole,
+	}
+	if user := getUser(ctx); user != nil {
+		vars.Owner = user.ID
+	}
+	return storage.WithSessionVariables(ctx, vars)
+}
+
+// adminTenant returns the organization and project the admin acts on. Tenant
+// admins are limited to their scope, service role tokens use the tenant in
+// their claims.
+func (a *API) adminTenant(ctx context.Context, r *http.Request) (uuid.UUID, uuid.UUID) {
+	if scope := getAdminScope(ctx); scope != nil {
+		return scope.OrganizationID, scope.ProjectID
+	}
+	return a.requestOrganizationID(ctx, r), a.requestProjectID(ctx, r)
+}
+
+// loadAdminTenant passes the tenant of the admin on to handlers outside of
+// this package, such as the OAuth client management endpoints.
+func (a *API) loadAdminTenant(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	organizationID, projectID := a.adminTenant(ctx, r)
+	return shared.WithTenant(ctx, &shared.Tenant{
+		OrganizationID: organizationID,
+		ProjectID:      projectID,
+	}), nil
+}
+
+// adminOrganization resolves the organization an admin acts on. Organization
+// admins always use their own organization, project admins and service role
+// tokens must name one of their project.
+func (a *API) adminOrganization(ctx context.Context, r *http.Request, organizationID uuid.UUID) (*models.Organization, error) {
+	db := a.db.WithContext(ctx)
+	scopeOrganizationID, projectID := a.adminTenant(ctx, r)
+
+	if scopeOrganizationID != uuid.Nil {
+		if organizationID != uuid.Nil && organizationID != scopeOrganizationID {
+			return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to manage another organization")
+		}
+		organizationID = scopeOrganizationID
+	}
+	if organizationID == uuid.Nil {
+		return nil, apierrors.NewBadRequestError(apierrors.ErrorCodeValidationFailed, "organization_id is required")
+	}
+
+	var organization *models.Organization
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		organization, terr = models.FindOrganizationByID(tx, organizationID)
+		return terr
+	})
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading organization").WithInternalError(err)
+	}
+	if projectID != uuid.Nil && organization.ProjectID != projectID {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeOrganizationNotFound, "Organization not found")
+	}
+
+	return organization, nil
+}
+
+// findOrganization reads an organization outside of the row level security
+// policies, in a transaction of its own. It is only used once the caller was
+// authorized for the organization some other way, such as a membership or an
+// invitation, or to find the project of a request.
+func (a *API) findOrganization(ctx context.Context, organizationID uuid.UUID) (*models.Organization, error) {
+	db := a.db.WithContext(storage.WithSessionVariables(ctx, storage.BypassRLS))
+
+	var organization *models.Organization
+	err := db.Transaction(func(tx *storage.Connection) error {
+		var terr error
+		organization, terr = models.FindOrganizationByID(tx, organizationID)
+		return terr
+	})
+	return organization, err
+}
+
+// loadAdminOrganization loads the organization in the organization_id URL
+// param. Organization admins can only load their own organization.
+func (a *API) loadAdminOrganization(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+
+	organizationID, err := uuid.FromString(chi.URLParam(r, "organization_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "organization_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "organization_id", organizationID)
+
+	organization, err := a.adminOrganization(ctx, r, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return withOrganization(ctx, organization), nil
+}
+
+// loadAdminProject loads the project in the project_id URL param. The
+// settings of a project are shared by all of its organizations, so only
+// project admins and service role tokens can manage them.
+func (a *API) loadAdminProject(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	db := a.db.WithContext(ctx)
+
+	projectID, err := uuid.FromString(chi.URLParam(r, "project_id"))
+	if err != nil {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeValidationFailed, "project_id must be an UUID")
+	}
+
+	observability.LogEntrySetField(r, "project_id", projectID)
+
+	scopeOrganizationID, scopeProjectID := a.adminTenant(ctx, r)
+	if scopeOrganizationID != uuid.Nil {
+		return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeNotAdmin, "Not allowed to manage the project")
+	}
+	if scopeProjectID != uuid.Nil && scopeProjectID != projectID {
+		return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeProjectNotFound, "Project not found")
+	}
+
+	project, err := models.FindProjectByID(db, projectID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			return nil, apierrors.NewNotFoundError(apierrors.ErrorCodeProjectNotFound, "Project not found")
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading project").WithInternalError(err)
+	}
+
+	return withProject(ctx, project), nil
+}
+
+// requirePermission checks that the caller's organization role was granted
+// all of the permissions. Service role tokens have every permission. The
+// permissions are looked up for the role rather than read from the token so
+// that changes apply before tokens expire.
+func (a *API) requirePermission(permissions ...string) middlewareHandler {
+	return func(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+		ctx := r.Context()
+		db := a.db.WithContext(ctx)
+
+		var role string
+		if adminUser := getAdminUser(ctx); adminUser != nil {
+			if getAdminScope(ctx) == nil {
+				return ctx, nil
+			}
+			role = adminUser.OrganizationRole
+		} else if claims := getClaims(ctx); claims != nil {
+			role = claims.OrganizationRole
+		}
+
+		granted, err := models.FindPermissionsByOrganizationRole(db, role)
+		if err != nil {
+			return nil, apierrors.NewInternalServerError("Database error loading permissions").WithInternalError(err)
+		}
+
+		for _, permission := range permissions {
+			if !slices.Contains(granted, permission) {
+				return nil, apierrors.NewForbiddenError(apierrors.ErrorCodeInsufficientPermissions, "Missing permission %s", permission)
+			}
+		}
+		return ctx, nil
+	}
+}
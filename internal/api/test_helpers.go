@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"testing"
@@ -66,3 +67,9 @@ func InitializeTestDatabase(t *testing.T, api *API, config *conf.GlobalConfigura
 
 	return project_id, organization_id, user
 }
+
+// withoutRLS runs fn in a transaction that bypasses the row level security
+// policies, for tests that read or write organizations and API keys directly.
+func withoutRLS(db *storage.Connection, fn func(tx *storage.Connection) error) error {
+	return db.WithContext(storage.WithSessionVariables(context.Background(), storage.BypassRLS)).Transaction(fn)
+}
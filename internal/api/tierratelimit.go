@@ -0,0 +1,314 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/supabase/auth/internal/api/apierrors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/observability"
+	"github.com/supabase/auth/internal/ratelimit"
+	"github.com/supabase/auth/internal/utilities"
+)
+
+// tierLookupTTL is how long the rate limits of a project and the tier of an
+// organization are cached before they are read from the database again.
+const tierLookupTTL = time.Minute
+
+// maxTierLookups bounds the number of cached lookups, request bodies can name
+// any organization.
+const maxTierLookups = 10000
+
+// maxTierLimiters bounds the number of limiters, unauthenticated callers get
+// a limiter per IP address.
+const maxTierLimiters = 10000
+
+// TierLimiters holds one ratelimit.Limiter per project and tier, and caches
+// the rate limits and tier looked up for each project and organization.
+//
+// The rate of a limiter is compared with the project's configuration on every
+// lookup, so changes made through the admin API take effect once the cached
+// lookup expires.
+type TierLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*tierLimiter
+	lookups  map[string]*tierLookup
+}
+
+type tierLimiter struct {
+	rate     conf.Rate
+	limiter  ratelimit.Limiter
+	lastUsed time.Time
+}
+
+// tierLookup is the rate limits of a project and the tier of one of its
+// organizations.
+type tierLookup struct {
+	rateLimits models.RateLimit
+	tier       string
+	expiresAt  time.Time
+}
+
+// NewTierLimiters returns an empty set of tier limiters.
+func NewTierLimiters() *TierLimiters {
+	return &TierLimiters{
+		limiters: make(map[string]*tierLimiter),
+		lookups:  make(map[string]*tierLookup),
+	}
+}
+
+// AllowAt reports whether the project and tier may perform one more request
+// at the given time under the given rate. A non empty bucket, such as the IP
+// address of an unauthenticated caller, gets a limit of its own within the
+// tier.
+func (tl *TierLimiters) AllowAt(projectID uuid.UUID, tier, bucket string, r conf.Rate, at time.Time) bool {
+	key := projectID.String() + ":" + tier
+	if bucket != "" {
+		key += ":" + bucket
+	}
+
+	tl.mu.Lock()
+	lim, ok := tl.limiters[key]
+	if !ok || lim.rate != r {
+		if !ok && len(tl.limiters) >= maxTierLimiters {
+			tl.expireLimiters(at)
+		}
+		lim = &tierLimiter{
+			rate:    r,
+			limiter: ratelimit.New(r),
+		}
+		tl.limiters[key] = lim
+	}
+	lim.lastUsed = at
+	tl.mu.Unlock()
+
+	return lim.limiter.AllowAt(at)
+}
+
+// expireLimiters removes the limiters that were not used for a whole window
+// of their rate, a new limiter allows them as much. When every limiter is in
+// use the limiters are reset, like the cached lookups. Callers must hold
+// tl.mu.
+func (tl *TierLimiters) expireLimiters(at time.Time) {
+	for key, lim := range tl.limiters {
+		if at.Sub(lim.lastUsed) >= lim.rate.OverTime {
+			delete(tl.limiters, key)
+		}
+	}
+	if len(tl.limiters) >= maxTierLimiters {
+		tl.limiters = make(map[string]*tierLimiter)
+	}
+}
+
+func tierLookupKey(projectID, organizationID uuid.UUID) string {
+	return projectID.String() + ":" + organizationID.String()
+}
+
+// cachedLookup returns the lookup of the project and organization, unless it
+// expired.
+func (tl *TierLimiters) cachedLookup(projectID, organizationID uuid.UUID, at time.Time) *tierLookup {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	lookup, ok := tl.lookups[tierLookupKey(projectID, organizationID)]
+	if !ok || !at.Before(lookup.expiresAt) {
+		return nil
+	}
+	return lookup
+}
+
+// cacheLookup stores the lookup of the project and organization.
+func (tl *TierLimiters) cacheLookup(projectID, organizationID uuid.UUID, lookup *tierLookup) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	if len(tl.lookups) >= maxTierLookups {
+		tl.lookups = make(map[string]*tierLookup)
+	}
+	tl.lookups[tierLookupKey(projectID, organizationID)] = lookup
+}
+
+// tenantParams are the tenant fields sent in the body of unauthenticated
+// requests such as /signup, /otp and /token.
+type tenantParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ProjectID      uuid.UUID `json:"project_id"`
+}
+
+// tierCaller is the tenant a request is rate limited as.
+type tierCaller struct {
+	ProjectID      uuid.UUID
+	OrganizationID uuid.UUID
+
+	// ID is the API key or the subject of the JWT, empty for requests
+	// identified by their body.
+	ID string
+
+	// FromBody is set when the tenant was read from the request body, which
+	// anyone can fill in.
+	FromBody bool
+}
+
+// requestTenant resolves the project and organization of the caller, first
+// from an API key, then from a valid JWT and then from the request body. A
+// verified JWT is kept in the returned context, so that the authentication
+// of the request does not parse it again. Admin tokens are never limited, so
+// no project is returned for them.
+func (a *API) requestTenant(r *http.Request) (context.Context, *tierCaller) {
+	ctx := r.Context()
+
+	if apiKey := getCallerAPIKey(ctx); apiKey != nil {
+		return ctx, &tierCaller{
+			ProjectID:      apiKey.ProjectID,
+			OrganizationID: apiKey.OrganizationID,
+			ID:             apiKey.ID.String(),
+		}
+	}
+
+	if bearer, err := a.extractBearerToken(r); err == nil && bearer != "" {
+		if parsed, err := a.parseJWTClaims(bearer, r); err == nil {
+			ctx = withParsedToken(ctx, getToken(parsed))
+			if claims := getClaims(parsed); claims != nil {
+				if slices.Contains(a.config.JWT.AdminRoles, claims.Role) {
+					return ctx, &tierCaller{}
+				}
+				if claims.ProjectID != uuid.Nil {
+					return ctx, &tierCaller{
+						ProjectID:      claims.ProjectID,
+						OrganizationID: claims.OrganizationID,
+						ID:             claims.Subject,
+					}
+				}
+			}
+		}
+	}
+
+	body, err := utilities.GetBodyBytes(r)
+	if err != nil || len(body) == 0 {
+		return ctx, &tierCaller{}
+	}
+
+	// Bodies that are not JSON (forms, SAML responses) carry no tenant.
+	params := &tenantParams{}
+	if err := json.Unmarshal(body, params); err != nil {
+		return ctx, &tierCaller{}
+	}
+
+	return ctx, &tierCaller{
+		ProjectID:      params.ProjectID,
+		OrganizationID: params.OrganizationID,
+		FromBody:       true,
+	}
+}
+
+// lookupTier returns the rate limits of the project and the tier of the
+// caller, from the cache when possible. Organizations named in a request
+// body that are not part of the project are ignored, so that callers cannot
+// pick the tier of another project.
+func (a *API) lookupTier(ctx context.Context, caller *tierCaller) (*tierLookup, error) {
+	now := a.Now()
+	tiers := a.limiterOpts.Tiers
+	if lookup := tiers.cachedLookup(caller.ProjectID, caller.OrganizationID, now); lookup != nil {
+		return lookup, nil
+	}
+
+	db := a.db.WithContext(ctx)
+
+	lookup := &tierLookup{
+		expiresAt: now.Add(tierLookupTTL),
+	}
+
+	project, err := models.FindProjectByID(db, caller.ProjectID)
+	if err != nil {
+		if models.IsNotFoundError(err) {
+			// Unknown projects have no limits
+			tiers.cacheLookup(caller.ProjectID, caller.OrganizationID, lookup)
+			return lookup, nil
+		}
+		return nil, apierrors.NewInternalServerError("Database error loading project").WithInternalError(err)
+	}
+	lookup.rateLimits = project.RateLimits
+
+	organizationID := caller.OrganizationID
+	if caller.FromBody && organizationID != uuid.Nil {
+		organization, err := a.findOrganization(ctx, organizationID)
+		if err != nil && !models.IsNotFoundError(err) {
+			return nil, apierrors.NewInternalServerError("Database error loading organization").WithInternalError(err)
+		}
+		if organization == nil || organization.ProjectID != project.ID {
+			organizationID = uuid.Nil
+		}
+	}
+
+	if len(project.RateLimits.RateLimits) > 0 {
+		effectiveTier, err := models.FindEffectiveTier(db, organizationID, project.ID, "")
+		if err != nil {
+			return nil, apierrors.NewInternalServerError("Database error loading tier").WithInternalError(err)
+		}
+		lookup.tier = effectiveTier.Tier
+	}
+
+	tiers.cacheLookup(caller.ProjectID, caller.OrganizationID, lookup)
+	return lookup, nil
+}
+
+// limitProjectTier enforces the per tier rate limits of the caller's project.
+// Requests that cannot be tied to a project, or whose tier has no limit in the
+// project, are not limited. With RateLimitDatabase the limit applies to each
+// caller and is shared by every instance, otherwise to the whole tier of each
+// instance. Requests identified by their body are limited per IP address in
+// both cases.
+func (a *API) limitProjectTier(w http.ResponseWriter, r *http.Request) (context.Context, error) {
+	ctx, caller := a.requestTenant(r)
+	if caller.ProjectID == uuid.Nil {
+		return ctx, nil
+	}
+
+	lookup, err := a.lookupTier(ctx, caller)
+	if err != nil {
+		return nil, err
+	}
+	tier := lookup.tier
+
+	value, ok := lookup.rateLimits.ForTier(tier)
+	if !ok {
+		return ctx, nil
+	}
+
+	var bucket string
+	if caller.FromBody {
+		bucket = "ip:" + utilities.GetIPAddress(r)
+	}
+
+	rate := conf.Rate{
+		Events:   float64(value.Limit),
+		OverTime: time.Duration(lookup.rateLimits.Seconds) * time.Second,
+	}
+	var allowed bool
+	if a.config.RateLimitDatabase {
+		// Requests without a caller share the limit of their tier
+		id := caller.ID
+		if id == "" {
+			id = "tier:" + tier
+			if bucket != "" {
+				id += ":" + bucket
+			}
+		}
+		allowed = ratelimit.NewDatabaseLimiter(a.db.WithContext(ctx), caller.ProjectID, id, rate).AllowAt(a.Now())
+	} else {
+		allowed = a.limiterOpts.Tiers.AllowAt(caller.ProjectID, tier, bucket, rate, a.Now())
+	}
+
+	if !allowed {
+		observability.LogEntrySetField(r, "rate_limit_tier", tier)
+		return nil, apierrors.NewTooManyRequestsError(apierrors.ErrorCodeOverRequestRateLimit, "Request rate limit for tier %q reached (%d requests per %d seconds)", tier, value.Limit, lookup.rateLimits.Seconds)
+	}
+
+	return ctx, nil
+}
@@ -0,0 +1,268 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+func TestTierLimiters(t *testing.T) {
+	now := time.Now()
+	projectID := uuid.Must(uuid.NewV4())
+	rate := conf.Rate{Events: 2, OverTime: time.Minute}
+
+	tl := NewTierLimiters()
+	require.True(t, tl.AllowAt(projectID, "free", "", rate, now))
+	require.True(t, tl.AllowAt(projectID, "free", "", rate, now))
+	require.False(t, tl.AllowAt(projectID, "free", "", rate, now))
+
+	// other tiers and projects have their own limiter
+	require.True(t, tl.AllowAt(projectID, "pro", "", rate, now))
+	require.True(t, tl.AllowAt(uuid.Must(uuid.NewV4()), "free", "", rate, now))
+
+	// buckets have their own limiter within the tier
+	require.True(t, tl.AllowAt(projectID, "free", "ip:192.0.2.1", rate, now))
+
+	// the window resets the limit
+	require.True(t, tl.AllowAt(projectID, "free", "", rate, now.Add(2*time.Minute)))
+
+	// a new rate replaces the limiter
+	require.True(t, tl.AllowAt(projectID, "free", "", conf.Rate{Events: 5, OverTime: time.Minute}, now))
+}
+
+func TestTierLimitersBounded(t *testing.T) {
+	now := time.Now()
+	projectID := uuid.Must(uuid.NewV4())
+	rate := conf.Rate{Events: 2, OverTime: time.Minute}
+
+	tl := NewTierLimiters()
+	for i := 0; i < maxTierLimiters+10; i++ {
+		tl.AllowAt(projectID, "free", fmt.Sprintf("ip:%d", i), rate, now)
+		require.LessOrEqual(t, len(tl.limiters), maxTierLimiters)
+	}
+
+	// limiters that were idle for a whole window are removed first
+	tl = NewTierLimiters()
+	for i := 0; i < maxTierLimiters-1; i++ {
+		tl.AllowAt(projectID, "free", fmt.Sprintf("ip:%d", i), rate, now)
+	}
+	require.True(t, tl.AllowAt(projectID, "free", "ip:busy", rate, now.Add(90*time.Second)))
+	require.True(t, tl.AllowAt(projectID, "free", "ip:new", rate, now.Add(2*time.Minute)))
+	require.Len(t, tl.limiters, 2)
+	require.Contains(t, tl.limiters, projectID.String()+":free:ip:busy")
+}
+
+type TierRateLimitTestSuite struct {
+	suite.Suite
+	API            *API
+	Config         *conf.GlobalConfiguration
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+}
+
+func TestTierRateLimit(t *testing.T) {
+	api, config, err := setupAPIForTest()
+	require.NoError(t, err)
+
+	ts := &TierRateLimitTestSuite{
+		API:    api,
+		Config: config,
+	}
+	defer api.db.Close()
+
+	suite.Run(t, ts)
+}
+
+func (ts *TierRateLimitTestSuite) SetupTest() {
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.API, ts.Config)
+	ts.API.limiterOpts.Tiers = NewTierLimiters()
+
+	project, err := models.FindProjectByID(ts.API.db, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), project.UpdateRateLimits(ts.API.db, models.RateLimit{
+		Seconds: 60,
+		RateLimits: []models.RateLimitValue{
+			{Tier: "free", Limit: 1},
+		},
+	}))
+}
+
+func (ts *TierRateLimitTestSuite) serve(req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	middlewareHandler(ts.API.limitProjectTier).handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})).ServeHTTP(w, req)
+	return w
+}
+
+func (ts *TierRateLimitTestSuite) TestLimitFromBody() {
+	makeRequest := func() *http.Request {
+		var buffer bytes.Buffer
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+			"email":           "test@example.com",
+			"organization_id": ts.OrganizationID,
+			"project_id":      ts.ProjectID,
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/otp", &buffer)
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	w := ts.serve(makeRequest())
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	w = ts.serve(makeRequest())
+	require.Equal(ts.T(), http.StatusTooManyRequests, w.Code)
+	assert.Contains(ts.T(), w.Body.String(), `tier \"free\"`)
+}
+
+func (ts *TierRateLimitTestSuite) TestLimitFromBodyPerIP() {
+	makeRequest := func(ip string) *http.Request {
+		var buffer bytes.Buffer
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+			"organization_id": ts.OrganizationID,
+			"project_id":      ts.ProjectID,
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/otp", &buffer)
+		req.Header.Set("X-Forwarded-For", ip)
+		return req
+	}
+
+	w := ts.serve(makeRequest("192.0.2.1"))
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	w = ts.serve(makeRequest("192.0.2.1"))
+	require.Equal(ts.T(), http.StatusTooManyRequests, w.Code)
+
+	w = ts.serve(makeRequest("192.0.2.2"))
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+}
+
+func (ts *TierRateLimitTestSuite) TestLimitFromBodyOtherProjectOrganization() {
+	// An organization of another project with a tier that has no limit
+	project, err := models.NewProject("other", "", models.RateLimit{})
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(project))
+
+	owner, err := models.NewUser("", "other-owner@example.com", "test", ts.Config.JWT.Aud, nil, uuid.Nil, project.ID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.API.db.Create(owner, "organization_id", "organization_role"))
+
+	organization, err := models.NewOrganization(project.ID, owner.ID, "other", "")
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), withoutRLS(ts.API.db, func(tx *storage.Connection) error {
+		return tx.Create(organization)
+	}))
+	otherOrganizationID := organization.ID
+
+	tier := models.NewOrganizationTier(otherOrganizationID)
+	tier.Tier = "pro"
+	require.NoError(ts.T(), models.SaveOrganizationTier(ts.API.db, tier))
+
+	for _, expectedCode := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		var buffer bytes.Buffer
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+			"organization_id": otherOrganizationID,
+			"project_id":      ts.ProjectID,
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/otp", &buffer)
+		w := ts.serve(req)
+		require.Equal(ts.T(), expectedCode, w.Code)
+	}
+}
+
+func (ts *TierRateLimitTestSuite) TestLimitFromJWT() {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+		Role:           "authenticated",
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
+	}).SignedString([]byte(ts.Config.JWT.Secret))
+	require.NoError(ts.T(), err)
+
+	makeRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/user", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return req
+	}
+
+	w := ts.serve(makeRequest())
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	w = ts.serve(makeRequest())
+	require.Equal(ts.T(), http.StatusTooManyRequests, w.Code)
+}
+
+func (ts *TierRateLimitTestSuite) TestTierWithoutLimit() {
+	tier := models.NewOrganizationTier(ts.OrganizationID)
+	tier.Tier = "pro"
+	require.NoError(ts.T(), models.SaveOrganizationTier(ts.API.db, tier))
+
+	for i := 0; i < 3; i++ {
+		var buffer bytes.Buffer
+		require.NoError(ts.T(), json.NewEncoder(&buffer).Encode(map[string]interface{}{
+			"organization_id": ts.OrganizationID,
+			"project_id":      ts.ProjectID,
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/otp", &buffer)
+		w := ts.serve(req)
+		require.Equal(ts.T(), http.StatusOK, w.Code)
+	}
+}
+
+func (ts *TierRateLimitTestSuite) TestDatabaseLimitPerUser() {
+	ts.Config.RateLimitDatabase = true
+	defer func() {
+		ts.Config.RateLimitDatabase = false
+	}()
+
+	makeRequest := func(subject uuid.UUID) *http.Request {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: subject.String(),
+			},
+			Role:           "authenticated",
+			OrganizationID: ts.OrganizationID,
+			ProjectID:      ts.ProjectID,
+		}).SignedString([]byte(ts.Config.JWT.Secret))
+		require.NoError(ts.T(), err)
+
+		req := httptest.NewRequest(http.MethodGet, "/user", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		return req
+	}
+
+	first := uuid.Must(uuid.NewV4())
+	second := uuid.Must(uuid.NewV4())
+
+	w := ts.serve(makeRequest(first))
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	w = ts.serve(makeRequest(first))
+	require.Equal(ts.T(), http.StatusTooManyRequests, w.Code)
+
+	// Every user has their own limit
+	w = ts.serve(makeRequest(second))
+	require.Equal(ts.T(), http.StatusOK, w.Code)
+
+	count, err := ts.API.db.Q().Where("project_id = ? and user_id = ?", ts.ProjectID, first.String()).Count(&models.ProjectRateLimit{})
+	require.NoError(ts.T(), err)
+	assert.Equal(ts.T(), 1, count)
+
+	// The window slides past old requests
+	allowed, err := models.AllowProjectRequest(ts.API.db, ts.ProjectID, first.String(), 1, time.Minute, time.Now().Add(2*time.Minute))
+	require.NoError(ts.T(), err)
+	assert.True(ts.T(), allowed)
+}
@@ -101,6 +101,9 @@ func (a *API) Token(w http.ResponseWriter, r *http.Request) error {
 	case "web3":
 		handler = a.Web3Grant
 		limiter = a.limiterOpts.Web3
+	case "passkey":
+		handler = a.PasskeyGrant
+		limiter = a.limiterOpts.FactorVerify
 	default:
 		return apierrors.NewBadRequestError(apierrors.ErrorCodeInvalidCredentials, "unsupported_grant_type")
 	}
@@ -376,38 +379,9 @@ func (a *API) updateMFASessionAndClaims(r *http.Request, tx *storage.Connection,
 		}
 
 		// issue a new refresh token on successful verification
-		if session.RefreshTokenHmacKey != nil && session.RefreshTokenCounter != nil {
-			signingKey, _, terr := session.GetRefreshTokenHmacKey(config.Security.DBEncryption)
-			if terr != nil {
-				return apierrors.NewInternalServerError("Failed to get session's refresh token key").WithInternalError(terr)
-			}
-
-			counter := *session.RefreshTokenCounter + 1
-			session.RefreshTokenCounter = &counter
-
-			issuedRefreshToken = (&crypto.RefreshToken{
-				Version:   0,
-				SessionID: session.ID,
-				Counter:   *session.RefreshTokenCounter,
-			}).Encode(signingKey)
-
-			if terr := session.UpdateOnlyRefreshToken(tx); terr != nil {
-				return apierrors.NewInternalServerError("Failed to update session").WithInternalError(terr)
-			}
-		} else {
-			// Legacy RTs: swap to ensure current token is the latest one
-
-			currentToken, terr := models.FindTokenBySessionID(tx, &session.ID)
-			if terr != nil {
-				return terr
-			}
-
-			refreshToken, terr := models.GrantRefreshTokenSwap(config.AuditLog, r, tx, user, currentToken)
-			if terr != nil {
-				return terr
-			}
-
-			issuedRefreshToken = refreshToken.Token
+		issuedRefreshToken, terr = a.rotateSessionRefreshToken(r, tx, user, session)
+		if terr != nil {
+			return terr
 		}
 
 		aal, _, terr := session.CalculateAALAndAMR(user)
@@ -446,3 +420,45 @@ func (a *API) updateMFASessionAndClaims(r *http.Request, tx *storage.Connection,
 		User:         user,
 	}, nil
 }
+
+// rotateSessionRefreshToken issues a new refresh token for the session,
+// invalidating the current one.
+func (a *API) rotateSessionRefreshToken(r *http.Request, tx *storage.Connection, user *models.User, session *models.Session) (string, error) {
+	config := a.config
+
+	if session.RefreshTokenHmacKey != nil && session.RefreshTokenCounter != nil {
+		signingKey, _, err := session.GetRefreshTokenHmacKey(config.Security.DBEncryption)
+		if err != nil {
+			return "", apierrors.NewInternalServerError("Failed to get session's refresh token key").WithInternalError(err)
+		}
+
+		counter := *session.RefreshTokenCounter + 1
+		session.RefreshTokenCounter = &counter
+
+		issuedRefreshToken := (&crypto.RefreshToken{
+			Version:   0,
+			SessionID: session.ID,
+			Counter:   *session.RefreshTokenCounter,
+		}).Encode(signingKey)
+
+		if err := session.UpdateOnlyRefreshToken(tx); err != nil {
+			return "", apierrors.NewInternalServerError("Failed to update session").WithInternalError(err)
+		}
+
+		return issuedRefreshToken, nil
+	}
+
+	// Legacy RTs: swap to ensure current token is the latest one
+
+	currentToken, err := models.FindTokenBySessionID(tx, &session.ID)
+	if err != nil {
+		return "", err
+	}
+
+	refreshToken, err := models.GrantRefreshTokenSwap(config.AuditLog, r, tx, user, currentToken)
+	if err != nil {
+		return "", err
+	}
+
+	return refreshToken.Token, nil
+}
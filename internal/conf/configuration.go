@@ -174,6 +174,26 @@ type MFAConfiguration struct {
 	Phone                       PhoneFactorTypeConfiguration `split_words:"true"`
 	TOTP                        TOTPFactorTypeConfiguration  `split_words:"true"`
 	WebAuthn                    MFAFactorTypeConfiguration   `split_words:"true"`
+	RecoveryCodes               RecoveryCodesConfiguration   `split_words:"true"`
+}
+
+// RecoveryCodesConfiguration holds the configuration of the single use codes
+// issued on the first MFA enrollment of a user.
+type RecoveryCodesConfiguration struct {
+	Enabled bool `json:"enabled" default:"false"`
+	Count   int  `json:"count" default:"10"`
+}
+
+// PasskeyConfiguration holds the configuration of passkey sign-ins, with
+// WebAuthn credentials the authenticator discovers without a user name.
+type PasskeyConfiguration struct {
+	Enabled                 bool    `json:"enabled" default:"false"`
+	ChallengeExpiryDuration float64 `json:"challenge_expiry_duration" default:"300" split_words:"true"`
+	MaxPasskeys             int     `json:"max_passkeys" split_words:"true" default:"10"`
+
+	// AAL2 treats passkeys as phishing resistant, sessions they start are
+	// at AAL2 without a second factor.
+	AAL2 bool `json:"aal2"`
 }
 
 type APIConfiguration struct {
@@ -343,6 +363,10 @@ type GlobalConfiguration struct {
 	RateLimitWeb3                       float64 `split_words:"true" default:"30"`
 	RateLimitOAuthDynamicClientRegister float64 `split_words:"true" default:"10"`
 
+	// RateLimitDatabase counts the tier rate limits of projects per user in
+	// the database, so that every instance enforces the same limits.
+	RateLimitDatabase bool `split_words:"true"`
+
 	SiteURL         string   `json:"site_url" split_words:"true" required:"true"`
 	URIAllowList    []string `json:"uri_allow_list" split_words:"true"`
 	URIAllowListMap map[string]glob.Glob
@@ -355,6 +379,7 @@ type GlobalConfiguration struct {
 	Security        SecurityConfiguration    `json:"security"`
 	Sessions        SessionsConfiguration    `json:"sessions"`
 	MFA             MFAConfiguration         `json:"MFA"`
+	Passkey         PasskeyConfiguration     `json:"passkey"`
 	SAML            SAMLConfiguration        `json:"saml"`
 	CORS            CORSConfiguration        `json:"cors"`
 	IndexWorker     IndexWorkerConfiguration `json:"index_worker" split_words:"true"`
@@ -397,6 +422,8 @@ type EmailContentConfiguration struct {
 	MagicLink        string `json:"magic_link" split_words:"true"`
 	Reauthentication string `json:"reauthentication"`
 
+	OrganizationInvite string `json:"organization_invite" split_words:"true"`
+
 	// Account Changes Notifications
 	PasswordChangedNotification     string `json:"password_changed_notification" split_words:"true"`
 	EmailChangedNotification        string `json:"email_changed_notification" split_words:"true"`
@@ -451,6 +478,10 @@ type ProviderConfiguration struct {
 	AllowedIdTokenIssuers   []string                       `json:"allowed_id_token_issuers" split_words:"true"`
 	FlowStateExpiryDuration time.Duration                  `json:"flow_state_expiry_duration" split_words:"true"`
 
+	// CustomOIDCAllowPrivateNetworks lets the custom OIDC providers of
+	// projects be reached on loopback, private and link-local addresses.
+	CustomOIDCAllowPrivateNetworks bool `json:"custom_oidc_allow_private_networks" split_words:"true"`
+
 	Web3Solana   SolanaConfiguration   `json:"web3_solana" split_words:"true"`
 	Web3Ethereum EthereumConfiguration `json:"web3_ethereum" split_words:"true"`
 }
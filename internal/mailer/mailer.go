@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/supabase/auth/internal/conf"
 	"github.com/supabase/auth/internal/models"
 )
 
@@ -18,6 +19,7 @@ const (
 	EmailChangeCurrentVerification = "email_change_current"
 	EmailChangeNewVerification     = "email_change_new"
 	ReauthenticationVerification   = "reauthentication"
+	OrganizationInviteVerification = "organization_invite"
 
 	// Account Changes Notifications
 	PasswordChangedNotification     = "password_changed_notification"
@@ -38,6 +40,7 @@ type Mailer interface {
 	EmailChangeMail(r *http.Request, user *models.User, otpNew, otpCurrent, referrerURL string, externalURL *url.URL) error
 	ReauthenticateMail(r *http.Request, user *models.User, otp string) error
 	GetEmailActionLink(user *models.User, actionType, referrerURL string, externalURL *url.URL) (string, error)
+	OrganizationInviteMail(r *http.Request, user *models.User, organization *models.Organization, role, token, referrerURL string) error
 
 	// Account Changes Notifications
 	PasswordChangedNotificationMail(r *http.Request, user *models.User) error
@@ -61,6 +64,21 @@ type Client interface {
 	) error
 }
 
+type smtpConfigKey struct{}
+
+// WithSMTPConfig returns a context with the SMTP configuration of the tenant
+// the mail is sent for. Clients built by templatemailer.FromConfig send
+// through it instead of the global configuration.
+func WithSMTPConfig(ctx context.Context, config *conf.SMTPConfiguration) context.Context {
+	return context.WithValue(ctx, smtpConfigKey{}, config)
+}
+
+// GetSMTPConfig returns the SMTP configuration set by WithSMTPConfig, or nil.
+func GetSMTPConfig(ctx context.Context) *conf.SMTPConfiguration {
+	config, _ := ctx.Value(smtpConfigKey{}).(*conf.SMTPConfiguration)
+	return config
+}
+
 type EmailData struct {
 	Token           string `json:"token"`
 	TokenHash       string `json:"token_hash"`
@@ -73,4 +91,8 @@ type EmailData struct {
 	OldPhone        string `json:"old_phone"`
 	Provider        string `json:"provider"`
 	FactorType      string `json:"factor_type"`
+
+	OrganizationID   string `json:"organization_id,omitempty"`
+	OrganizationName string `json:"organization_name,omitempty"`
+	OrganizationRole string `json:"organization_role,omitempty"`
 }
@@ -27,17 +27,23 @@ type Client struct {
 
 // New returns a new *Mailer based on the given configuration.
 func New(globalConfig *conf.GlobalConfiguration) *Client {
-	from := globalConfig.SMTP.FromAddress()
+	return NewWithSMTP(globalConfig, &globalConfig.SMTP)
+}
+
+// NewWithSMTP returns a new *Mailer that sends through the given SMTP
+// configuration instead of the global one.
+func NewWithSMTP(globalConfig *conf.GlobalConfiguration, smtp *conf.SMTPConfiguration) *Client {
+	from := smtp.FromAddress()
 	u, _ := url.ParseRequestURI(globalConfig.API.ExternalURL)
 	return &Client{
-		Host:        globalConfig.SMTP.Host,
-		Port:        globalConfig.SMTP.Port,
-		User:        globalConfig.SMTP.User,
-		Pass:        globalConfig.SMTP.Pass,
+		Host:        smtp.Host,
+		Port:        smtp.Port,
+		User:        smtp.User,
+		Pass:        smtp.Pass,
 		LocalName:   u.Hostname(),
 		From:        from,
 		Logger:      logrus.StandardLogger(),
-		MailLogging: globalConfig.SMTP.LoggingEnabled,
+		MailLogging: smtp.LoggingEnabled,
 	}
 }
 
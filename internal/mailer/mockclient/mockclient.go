@@ -16,6 +16,7 @@ type MockMailer struct {
 	EmailChangeMailCalls    []EmailChangeMailCall
 	ReauthenticateMailCalls []ReauthenticateMailCall
 	GetEmailActionLinkCalls []GetEmailActionLinkCall
+	OrganizationInviteCalls []OrganizationInviteMailCall
 
 	PasswordChangedMailCalls     []PasswordChangedMailCall
 	EmailChangedMailCalls        []EmailChangedMailCall
@@ -76,6 +77,14 @@ type GetEmailActionLinkCall struct {
 	Error       error
 }
 
+type OrganizationInviteMailCall struct {
+	User         *models.User
+	Organization *models.Organization
+	Role         string
+	Token        string
+	ReferrerURL  string
+}
+
 type PasswordChangedMailCall struct {
 	User *models.User
 }
@@ -237,8 +246,20 @@ func (m *MockMailer) MFAFactorUnenrolledNotificationMail(r *http.Request, user *
 	return nil
 }
 
+func (m *MockMailer) OrganizationInviteMail(r *http.Request, user *models.User, organization *models.Organization, role, token, referrerURL string) error {
+	m.OrganizationInviteCalls = append(m.OrganizationInviteCalls, OrganizationInviteMailCall{
+		User:         user,
+		Organization: organization,
+		Role:         role,
+		Token:        token,
+		ReferrerURL:  referrerURL,
+	})
+	return nil
+}
+
 func (m *MockMailer) Reset() {
 	m.InviteMailCalls = nil
+	m.OrganizationInviteCalls = nil
 	m.ConfirmationMailCalls = nil
 	m.RecoveryMailCalls = nil
 	m.MagicLinkMailCalls = nil
@@ -17,6 +17,7 @@ import (
 	"github.com/supabase/auth/internal/mailer/mailmeclient"
 	"github.com/supabase/auth/internal/mailer/noopclient"
 	"github.com/supabase/auth/internal/mailer/taskclient"
+	"github.com/supabase/auth/internal/mailer/tenantclient"
 	"github.com/supabase/auth/internal/mailer/validateclient"
 	"github.com/supabase/auth/internal/observability"
 	"golang.org/x/sync/singleflight"
@@ -46,6 +47,9 @@ func FromConfig(globalConfig *conf.GlobalConfiguration, tc *Cache) *Mailer {
 		mc = mailmeclient.New(globalConfig)
 	}
 
+	// Mail of tenants with their own SMTP server bypasses the global client
+	mc = tenantclient.New(globalConfig, mc)
+
 	// Wrap client with validation first
 	mc = validateclient.New(globalConfig, mc)
 
@@ -533,6 +537,8 @@ func lookupEmailContentConfig(
 		return cfg.Reauthentication, true
 	case MagicLinkTemplate:
 		return cfg.MagicLink, true
+	case OrganizationInviteTemplate:
+		return cfg.OrganizationInvite, true
 
 	// Account Changes Notifications
 	case PasswordChangedNotificationTemplate:
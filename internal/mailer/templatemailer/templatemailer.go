@@ -12,12 +12,13 @@ import (
 )
 
 const (
-	InviteTemplate           = "invite"
-	ConfirmationTemplate     = "confirmation"
-	RecoveryTemplate         = "recovery"
-	EmailChangeTemplate      = "email_change"
-	MagicLinkTemplate        = "magic_link"
-	ReauthenticationTemplate = "reauthentication"
+	InviteTemplate             = "invite"
+	ConfirmationTemplate       = "confirmation"
+	RecoveryTemplate           = "recovery"
+	EmailChangeTemplate        = "email_change"
+	MagicLinkTemplate          = "magic_link"
+	ReauthenticationTemplate   = "reauthentication"
+	OrganizationInviteTemplate = "organization_invite"
 
 	// Account Changes Notifications
 	PasswordChangedNotificationTemplate     = "password_changed_notification"
@@ -64,6 +65,11 @@ const defaultReauthenticateMail = `<h2>Confirm reauthentication</h2>
 
 <p>Enter the code: {{ .Token }}</p>`
 
+const defaultOrganizationInviteMail = `<h2>You have been invited to {{ .OrganizationName }}</h2>
+
+<p>You have been invited to join {{ .OrganizationName }} as {{ .Role }} on {{ .SiteURL }}. Follow this link to accept the invitation:</p>
+<p><a href="{{ .ConfirmationURL }}">Accept the invitation</a></p>`
+
 // Account Changes Notifications
 
 // #nosec G101 -- No hardcoded credentials.
@@ -116,6 +122,7 @@ var (
 		EmailChangeTemplate,
 		MagicLinkTemplate,
 		ReauthenticationTemplate,
+		OrganizationInviteTemplate,
 
 		// Account Changes Notifications
 		PasswordChangedNotificationTemplate,
@@ -127,12 +134,13 @@ var (
 		MFAFactorUnenrolledNotificationTemplate,
 	}
 	defaultTemplateSubjects = &conf.EmailContentConfiguration{
-		Invite:           "You have been invited",
-		Confirmation:     "Confirm Your Email",
-		Recovery:         "Reset Your Password",
-		MagicLink:        "Your Magic Link",
-		EmailChange:      "Confirm Email Change",
-		Reauthentication: "Confirm reauthentication",
+		Invite:             "You have been invited",
+		Confirmation:       "Confirm Your Email",
+		Recovery:           "Reset Your Password",
+		MagicLink:          "Your Magic Link",
+		EmailChange:        "Confirm Email Change",
+		Reauthentication:   "Confirm reauthentication",
+		OrganizationInvite: "You have been invited to an organization",
 
 		// Account Changes Notifications
 		PasswordChangedNotification:     "Your password has been changed",
@@ -144,12 +152,13 @@ var (
 		MFAFactorUnenrolledNotification: "An MFA factor has been unenrolled",
 	}
 	defaultTemplateBodies = &conf.EmailContentConfiguration{
-		Invite:           defaultInviteMail,
-		Confirmation:     defaultConfirmationMail,
-		Recovery:         defaultRecoveryMail,
-		MagicLink:        defaultMagicLinkMail,
-		EmailChange:      defaultEmailChangeMail,
-		Reauthentication: defaultReauthenticateMail,
+		Invite:             defaultInviteMail,
+		Confirmation:       defaultConfirmationMail,
+		Recovery:           defaultRecoveryMail,
+		MagicLink:          defaultMagicLinkMail,
+		EmailChange:        defaultEmailChangeMail,
+		Reauthentication:   defaultReauthenticateMail,
+		OrganizationInvite: defaultOrganizationInviteMail,
 
 		// Account Changes Notifications
 		PasswordChangedNotification:     defaultPasswordChangedNotificationMail,
@@ -486,6 +495,31 @@ func (m *Mailer) MFAFactorUnenrolledNotificationMail(r *http.Request, user *mode
 	return m.mail(r.Context(), m.cfg, MFAFactorUnenrolledNotificationTemplate, user.GetEmail(), data)
 }
 
+// OrganizationInviteMail sends an invitation to join an organization. The
+// link points at the redirect URL with the invitation token in the
+// invitation_token query param, for the application to accept it.
+func (m *Mailer) OrganizationInviteMail(r *http.Request, user *models.User, organization *models.Organization, role, token, referrerURL string) error {
+	link, err := url.Parse(referrerURL)
+	if err != nil {
+		return err
+	}
+	q := link.Query()
+	q.Set("invitation_token", token)
+	link.RawQuery = q.Encode()
+
+	data := map[string]any{
+		"SiteURL":          m.cfg.SiteURL,
+		"ConfirmationURL":  link.String(),
+		"Email":            user.GetEmail(),
+		"Token":            token,
+		"OrganizationID":   organization.ID,
+		"OrganizationName": organization.Name,
+		"Role":             role,
+		"RedirectTo":       referrerURL,
+	}
+	return m.mail(r.Context(), m.cfg, OrganizationInviteTemplate, user.GetEmail(), data)
+}
+
 type emailParams struct {
 	Token      string
 	Type       string
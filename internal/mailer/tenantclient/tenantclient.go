@@ -0,0 +1,50 @@
+// Package tenantclient provides an implementation of mailer.Client that sends
+// mail through the SMTP configuration of the tenant the mail is sent for.
+package tenantclient
+
+import (
+	"context"
+
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/mailer"
+	"github.com/supabase/auth/internal/mailer/mailmeclient"
+)
+
+// New will return a Client that sends mail through the SMTP configuration set
+// on the context with mailer.WithSMTPConfig, and through the given Client
+// when there is none.
+func New(globalConfig *conf.GlobalConfiguration, mc mailer.Client) mailer.Client {
+	return &tenantMailClient{
+		globalConfig: globalConfig,
+		mc:           mc,
+	}
+}
+
+type tenantMailClient struct {
+	globalConfig *conf.GlobalConfiguration
+	mc           mailer.Client
+}
+
+// Mail implements mailer.MailClient interface by picking the SMTP server of
+// the tenant, falling back to the wrapped mail client.
+func (o *tenantMailClient) Mail(
+	ctx context.Context,
+	to string,
+	subject string,
+	body string,
+	headers map[string][]string,
+	typ string,
+) error {
+	mc := o.mc
+	if smtp := mailer.GetSMTPConfig(ctx); smtp != nil {
+		mc = mailmeclient.NewWithSMTP(o.globalConfig, smtp)
+	}
+	return mc.Mail(
+		ctx,
+		to,
+		subject,
+		body,
+		headers,
+		typ,
+	)
+}
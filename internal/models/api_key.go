@@ -0,0 +1,170 @@
+package models
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/crypto"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// APIKeyPrefix starts every API key so that keys can be told apart from the
+// JWTs clients send in the apikey header.
+const APIKeyPrefix = "sak_"
+
+// APIKey is a machine credential of an organization within a project. Only
+// the hash of the key is stored, the key itself is returned once when it is
+// created or rotated.
+type APIKey struct {
+	ID             uuid.UUID          `json:"id" db:"id"`
+	OrganizationID uuid.UUID          `json:"organization_id" db:"organization_id"`
+	ProjectID      uuid.UUID          `json:"project_id" db:"project_id"`
+	Name           string             `json:"name" db:"name"`
+	Description    storage.NullString `json:"description" db:"description"`
+	TierModel      string             `json:"tier_model" db:"tier_model"`
+	TierTime       string             `json:"tier_time" db:"tier_time"`
+	TierUsage      string             `json:"tier_usage" db:"tier_usage"`
+	KeyHash        string             `json:"-" db:"key"`
+	CreatedAt      time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at" db:"updated_at"`
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// NewAPIKey initializes an API key of the organization and returns it
+// together with the plain key, which is only known at this point.
+func NewAPIKey(organization *Organization, name, description string) (*APIKey, string, error) {
+	if organization == nil || organization.ID == uuid.Nil {
+		return nil, "", errors.New("organization must be provided")
+	}
+	if name == "" {
+		return nil, "", errors.New("name must be provided")
+	}
+
+	apiKey := &APIKey{
+		ID:             uuid.Must(uuid.NewV4()),
+		OrganizationID: organization.ID,
+		ProjectID:      organization.ProjectID,
+		Name:           name,
+		Description:    storage.NullString(description),
+		TierModel:      "low",
+		TierTime:       "low",
+		TierUsage:      "low",
+	}
+	key := apiKey.regenerateKey()
+
+	return apiKey, key, nil
+}
+
+// HashAPIKey returns the value stored in the key column for a plain key.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAPIKey reports whether the value has the format of an API key.
+func IsAPIKey(value string) bool {
+	return strings.HasPrefix(value, APIKeyPrefix) && len(value) > len(APIKeyPrefix)
+}
+
+func (k *APIKey) regenerateKey() string {
+	key := APIKeyPrefix + crypto.SecureAlphanumeric(40)
+	k.KeyHash = HashAPIKey(key)
+	return key
+}
+
+// Validate checks that the tier values are accepted by the database enums.
+func (k *APIKey) Validate() error {
+	if k.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if !slices.Contains(TierModels, k.TierModel) {
+		return fmt.Errorf("tier_model must be one of %v", TierModels)
+	}
+	if !slices.Contains(TierTimes, k.TierTime) {
+		return fmt.Errorf("tier_time must be one of %v", TierTimes)
+	}
+	if !slices.Contains(TierUsages, k.TierUsage) {
+		return fmt.Errorf("tier_usage must be one of %v", TierUsages)
+	}
+	return nil
+}
+
+// Rotate replaces the key and returns the new plain key. The previous key
+// stops working.
+func (k *APIKey) Rotate(tx *storage.Connection) (string, error) {
+	key := k.regenerateKey()
+	if err := tx.UpdateOnly(k, "key", "updated_at"); err != nil {
+		return "", errors.Wrap(err, "error rotating API key")
+	}
+	return key, nil
+}
+
+func findAPIKey(tx *storage.Connection, query string, args ...interface{}) (*APIKey, error) {
+	obj := &APIKey{}
+	if err := tx.Q().Where(query, args...).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, APIKeyNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding API key")
+	}
+
+	return obj, nil
+}
+
+// FindAPIKeyByID finds an API key by its ID.
+func FindAPIKeyByID(tx *storage.Connection, id uuid.UUID) (*APIKey, error) {
+	return findAPIKey(tx, "id = ?", id)
+}
+
+// FindAPIKeyByKey finds an API key by its plain key.
+func FindAPIKeyByKey(tx *storage.Connection, key string) (*APIKey, error) {
+	return findAPIKey(tx, "key = ?", HashAPIKey(key))
+}
+
+// FindAPIKeyByName finds the oldest API key of the organization with the
+// name. Names are not unique, so this is only meant for idempotent seeding.
+func FindAPIKeyByName(tx *storage.Connection, organizationID uuid.UUID, name string) (*APIKey, error) {
+	obj := &APIKey{}
+	if err := tx.Q().Where("organization_id = ? and name = ?", organizationID, name).Order("created_at asc").First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, APIKeyNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding API key")
+	}
+
+	return obj, nil
+}
+
+// FindAPIKeys lists API keys, optionally restricted to a single project and
+// organization.
+func FindAPIKeys(tx *storage.Connection, projectID, organizationID uuid.UUID, pageParams *Pagination) ([]*APIKey, error) {
+	apiKeys := []*APIKey{}
+	q := tx.Q()
+	if projectID != uuid.Nil {
+		q = q.Where("project_id = ?", projectID)
+	}
+	if organizationID != uuid.Nil {
+		q = q.Where("organization_id = ?", organizationID)
+	}
+	q = q.Order("created_at desc")
+
+	var err error
+	if pageParams != nil {
+		err = q.Paginate(int(pageParams.Page), int(pageParams.PerPage)).All(&apiKeys) // #nosec G115
+		pageParams.Count = uint64(q.Paginator.TotalEntriesSize)                       // #nosec G115
+	} else {
+		err = q.All(&apiKeys)
+	}
+
+	return apiKeys, errors.Wrap(err, "error finding API keys")
+}
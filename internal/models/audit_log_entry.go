@@ -21,30 +21,63 @@ type AuditAction string
 type auditLogType string
 
 const (
-	LoginAction                     AuditAction = "login"
-	LogoutAction                    AuditAction = "logout"
-	InviteAcceptedAction            AuditAction = "invite_accepted"
-	UserSignedUpAction              AuditAction = "user_signedup"
-	UserInvitedAction               AuditAction = "user_invited"
-	UserDeletedAction               AuditAction = "user_deleted"
-	UserModifiedAction              AuditAction = "user_modified"
-	UserRecoveryRequestedAction     AuditAction = "user_recovery_requested"
-	UserReauthenticateAction        AuditAction = "user_reauthenticate_requested"
-	UserConfirmationRequestedAction AuditAction = "user_confirmation_requested"
-	UserRepeatedSignUpAction        AuditAction = "user_repeated_signup"
-	UserUpdatePasswordAction        AuditAction = "user_updated_password"
-	TokenRevokedAction              AuditAction = "token_revoked"
-	TokenRefreshedAction            AuditAction = "token_refreshed"
-	GenerateRecoveryCodesAction     AuditAction = "generate_recovery_codes"
-	EnrollFactorAction              AuditAction = "factor_in_progress"
-	UnenrollFactorAction            AuditAction = "factor_unenrolled"
-	CreateChallengeAction           AuditAction = "challenge_created"
-	VerifyFactorAction              AuditAction = "verification_attempted"
-	DeleteFactorAction              AuditAction = "factor_deleted"
-	DeleteRecoveryCodesAction       AuditAction = "recovery_codes_deleted"
-	UpdateFactorAction              AuditAction = "factor_updated"
-	MFACodeLoginAction              AuditAction = "mfa_code_login"
-	IdentityUnlinkAction            AuditAction = "identity_unlinked"
+	LoginAction                          AuditAction = "login"
+	LogoutAction                         AuditAction = "logout"
+	SessionRevokedAction                 AuditAction = "session_revoked"
+	InviteAcceptedAction                 AuditAction = "invite_accepted"
+	UserSignedUpAction                   AuditAction = "user_signedup"
+	UserInvitedAction                    AuditAction = "user_invited"
+	UserDeletedAction                    AuditAction = "user_deleted"
+	GenerateLinkAction                   AuditAction = "generate_link"
+	UserModifiedAction                   AuditAction = "user_modified"
+	UserRecoveryRequestedAction          AuditAction = "user_recovery_requested"
+	UserReauthenticateAction             AuditAction = "user_reauthenticate_requested"
+	UserConfirmationRequestedAction      AuditAction = "user_confirmation_requested"
+	UserRepeatedSignUpAction             AuditAction = "user_repeated_signup"
+	UserUpdatePasswordAction             AuditAction = "user_updated_password"
+	TokenRevokedAction                   AuditAction = "token_revoked"
+	TokenRefreshedAction                 AuditAction = "token_refreshed"
+	GenerateRecoveryCodesAction          AuditAction = "generate_recovery_codes"
+	EnrollFactorAction                   AuditAction = "factor_in_progress"
+	UnenrollFactorAction                 AuditAction = "factor_unenrolled"
+	CreateChallengeAction                AuditAction = "challenge_created"
+	VerifyFactorAction                   AuditAction = "verification_attempted"
+	DeleteFactorAction                   AuditAction = "factor_deleted"
+	DeleteRecoveryCodesAction            AuditAction = "recovery_codes_deleted"
+	UpdateFactorAction                   AuditAction = "factor_updated"
+	MFACodeLoginAction                   AuditAction = "mfa_code_login"
+	PasskeyRegisteredAction              AuditAction = "passkey_registered"
+	PasskeyUpdatedAction                 AuditAction = "passkey_updated"
+	PasskeyDeletedAction                 AuditAction = "passkey_deleted"
+	IdentityUnlinkAction                 AuditAction = "identity_unlinked"
+	OrganizationCreatedAction            AuditAction = "organization_created"
+	OrganizationModifiedAction           AuditAction = "organization_modified"
+	OrganizationDeletedAction            AuditAction = "organization_deleted"
+	OrganizationTierModifiedAction       AuditAction = "organization_tier_modified"
+	OrganizationSwitchedAction           AuditAction = "organization_switched"
+	OrganizationMemberAddedAction        AuditAction = "organization_member_added"
+	OrganizationMemberRemovedAction      AuditAction = "organization_member_removed"
+	OrganizationInvitationCreatedAction  AuditAction = "organization_invitation_created"
+	OrganizationInvitationResentAction   AuditAction = "organization_invitation_resent"
+	OrganizationInvitationRevokedAction  AuditAction = "organization_invitation_revoked"
+	OrganizationInvitationAcceptedAction AuditAction = "organization_invitation_accepted"
+	ProjectCreatedAction                 AuditAction = "project_created"
+	ProjectModifiedAction                AuditAction = "project_modified"
+	ProjectDeletedAction                 AuditAction = "project_deleted"
+	ProjectTierModifiedAction            AuditAction = "project_tier_modified"
+	ProjectTierDeletedAction             AuditAction = "project_tier_deleted"
+	ProjectSettingsModifiedAction        AuditAction = "project_settings_modified"
+	ProjectSettingsDeletedAction         AuditAction = "project_settings_deleted"
+	CustomOIDCProviderCreatedAction      AuditAction = "custom_oidc_provider_created"
+	CustomOIDCProviderModifiedAction     AuditAction = "custom_oidc_provider_modified"
+	CustomOIDCProviderDeletedAction      AuditAction = "custom_oidc_provider_deleted"
+	APIKeyCreatedAction                  AuditAction = "api_key_created"
+	APIKeyRotatedAction                  AuditAction = "api_key_rotated"
+	APIKeyRevokedAction                  AuditAction = "api_key_revoked"
+	SMTPConfigModifiedAction             AuditAction = "smtp_config_modified"
+	SMTPConfigDeletedAction              AuditAction = "smtp_config_deleted"
+	MFAPolicyModifiedAction              AuditAction = "mfa_policy_modified"
+	MFAPolicyDeletedAction               AuditAction = "mfa_policy_deleted"
 
 	account       auditLogType = "account"
 	team          auditLogType = "team"
@@ -52,31 +85,70 @@ const (
 	user          auditLogType = "user"
 	factor        auditLogType = "factor"
 	recoveryCodes auditLogType = "recovery_codes"
+	organization  auditLogType = "organization"
+	project       auditLogType = "project"
+	apiKey        auditLogType = "api_key"
+	smtpConfig    auditLogType = "smtp_config"
+	mfaPolicy     auditLogType = "mfa_policy"
+	passkey       auditLogType = "passkey"
 )
 
 var ActionLogTypeMap = map[AuditAction]auditLogType{
-	LoginAction:                     account,
-	LogoutAction:                    account,
-	InviteAcceptedAction:            account,
-	UserSignedUpAction:              team,
-	UserInvitedAction:               team,
-	UserDeletedAction:               team,
-	TokenRevokedAction:              token,
-	TokenRefreshedAction:            token,
-	UserModifiedAction:              user,
-	UserRecoveryRequestedAction:     user,
-	UserConfirmationRequestedAction: user,
-	UserRepeatedSignUpAction:        user,
-	UserUpdatePasswordAction:        user,
-	GenerateRecoveryCodesAction:     user,
-	EnrollFactorAction:              factor,
-	UnenrollFactorAction:            factor,
-	CreateChallengeAction:           factor,
-	VerifyFactorAction:              factor,
-	DeleteFactorAction:              factor,
-	UpdateFactorAction:              factor,
-	MFACodeLoginAction:              factor,
-	DeleteRecoveryCodesAction:       recoveryCodes,
+	LoginAction:                          account,
+	LogoutAction:                         account,
+	SessionRevokedAction:                 account,
+	InviteAcceptedAction:                 account,
+	UserSignedUpAction:                   team,
+	UserInvitedAction:                    team,
+	UserDeletedAction:                    team,
+	GenerateLinkAction:                   team,
+	TokenRevokedAction:                   token,
+	TokenRefreshedAction:                 token,
+	UserModifiedAction:                   user,
+	UserRecoveryRequestedAction:          user,
+	UserConfirmationRequestedAction:      user,
+	UserRepeatedSignUpAction:             user,
+	UserUpdatePasswordAction:             user,
+	GenerateRecoveryCodesAction:          user,
+	EnrollFactorAction:                   factor,
+	UnenrollFactorAction:                 factor,
+	CreateChallengeAction:                factor,
+	VerifyFactorAction:                   factor,
+	DeleteFactorAction:                   factor,
+	UpdateFactorAction:                   factor,
+	MFACodeLoginAction:                   factor,
+	PasskeyRegisteredAction:              passkey,
+	PasskeyUpdatedAction:                 passkey,
+	PasskeyDeletedAction:                 passkey,
+	DeleteRecoveryCodesAction:            recoveryCodes,
+	OrganizationCreatedAction:            organization,
+	OrganizationModifiedAction:           organization,
+	OrganizationDeletedAction:            organization,
+	OrganizationTierModifiedAction:       organization,
+	OrganizationSwitchedAction:           organization,
+	OrganizationMemberAddedAction:        organization,
+	OrganizationMemberRemovedAction:      organization,
+	OrganizationInvitationCreatedAction:  organization,
+	OrganizationInvitationResentAction:   organization,
+	OrganizationInvitationRevokedAction:  organization,
+	OrganizationInvitationAcceptedAction: organization,
+	ProjectCreatedAction:                 project,
+	ProjectModifiedAction:                project,
+	ProjectDeletedAction:                 project,
+	ProjectTierModifiedAction:            project,
+	ProjectTierDeletedAction:             project,
+	ProjectSettingsModifiedAction:        project,
+	ProjectSettingsDeletedAction:         project,
+	CustomOIDCProviderCreatedAction:      project,
+	CustomOIDCProviderModifiedAction:     project,
+	CustomOIDCProviderDeletedAction:      project,
+	APIKeyCreatedAction:                  apiKey,
+	APIKeyRotatedAction:                  apiKey,
+	APIKeyRevokedAction:                  apiKey,
+	SMTPConfigModifiedAction:             smtpConfig,
+	SMTPConfigDeletedAction:              smtpConfig,
+	MFAPolicyModifiedAction:              mfaPolicy,
+	MFAPolicyDeletedAction:               mfaPolicy,
 }
 
 // AuditLogEntry is the database model for audit log entries.
@@ -86,6 +158,9 @@ type AuditLogEntry struct {
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	IPAddress string    `json:"ip_address" db:"ip_address"`
 
+	OrganizationID uuid.NullUUID `json:"organization_id,omitempty" db:"organization_id"`
+	ProjectID      uuid.NullUUID `json:"project_id,omitempty" db:"project_id"`
+
 	DONTUSEINSTANCEID uuid.UUID `json:"-" db:"instance_id"`
 }
 
@@ -95,6 +170,14 @@ func (AuditLogEntry) TableName() string {
 }
 
 func NewAuditLogEntry(config conf.AuditLogConfiguration, r *http.Request, tx *storage.Connection, actor *User, action AuditAction, ipAddress string, traits map[string]interface{}) error {
+	return NewAuditLogEntryForUser(config, r, tx, actor, nil, action, ipAddress, traits)
+}
+
+// NewAuditLogEntryForUser records an action of actor on the target user. The
+// entry belongs to the organization and project named in the traits, if any,
+// otherwise to the tenant of the actor, or of the target when the actor has
+// none as is the case for service role tokens.
+func NewAuditLogEntryForUser(config conf.AuditLogConfiguration, r *http.Request, tx *storage.Connection, actor, target *User, action AuditAction, ipAddress string, traits map[string]interface{}) error {
 	id := uuid.Must(uuid.NewV4())
 
 	username := actor.GetEmail()
@@ -163,6 +246,9 @@ func NewAuditLogEntry(config conf.AuditLogConfiguration, r *http.Request, tx *st
 		Payload:   JSONMap(payload),
 		IPAddress: ipAddress,
 	}
+	if err := l.setTenant(tx, actor, target, traits); err != nil {
+		return err
+	}
 
 	if err := tx.Create(&l); err != nil {
 		return errors.Wrap(err, "Database error creating audit log entry")
@@ -171,20 +257,96 @@ func NewAuditLogEntry(config conf.AuditLogConfiguration, r *http.Request, tx *st
 	return nil
 }
 
-func FindAuditLogEntries(tx *storage.Connection, filterColumns []string, filterValue string, pageParams *Pagination) ([]*AuditLogEntry, error) {
+// setTenant fills in the organization and project of the entry.
+func (l *AuditLogEntry) setTenant(tx *storage.Connection, actor, target *User, traits map[string]interface{}) error {
+	source := actor
+	if source.ProjectID == uuid.Nil && target != nil {
+		source = target
+	}
+	if source.ProjectID != uuid.Nil {
+		l.OrganizationID = source.OrganizationID
+		l.ProjectID = uuid.NullUUID{UUID: source.ProjectID, Valid: true}
+	}
+
+	if id := auditLogTraitID(traits, "project_id"); id != uuid.Nil {
+		l.ProjectID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	if id := auditLogTraitID(traits, "organization_id"); id != uuid.Nil {
+		l.OrganizationID = uuid.NullUUID{UUID: id, Valid: true}
+
+		if organization, err := FindOrganizationByID(tx, id); err == nil {
+			l.ProjectID = uuid.NullUUID{UUID: organization.ProjectID, Valid: true}
+		} else if !IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func auditLogTraitID(traits map[string]interface{}, key string) uuid.UUID {
+	switch v := traits[key].(type) {
+	case uuid.UUID:
+		return v
+	case uuid.NullUUID:
+		return v.UUID
+	case string:
+		return uuid.FromStringOrNil(v)
+	}
+	return uuid.Nil
+}
+
+// AuditLogFilter narrows down the entries returned by FindAuditLogEntries.
+// Zero values do not filter.
+type AuditLogFilter struct {
+	OrganizationID uuid.UUID
+	ProjectID      uuid.UUID
+	ActorID        uuid.UUID
+	Action         string
+	From           *time.Time
+	To             *time.Time
+
+	// Columns are payload keys matched against Value with ILIKE.
+	Columns []string
+	Value   string
+}
+
+func FindAuditLogEntries(tx *storage.Connection, filter *AuditLogFilter, pageParams *Pagination) ([]*AuditLogEntry, error) {
 	q := tx.Q().Order("created_at desc").Where("instance_id = ?", uuid.Nil)
 
-	if len(filterColumns) > 0 && filterValue != "" {
-		lf := "%" + filterValue + "%"
+	if filter == nil {
+		filter = &AuditLogFilter{}
+	}
+	if filter.OrganizationID != uuid.Nil {
+		q = q.Where("organization_id = ?", filter.OrganizationID)
+	}
+	if filter.ProjectID != uuid.Nil {
+		q = q.Where("project_id = ?", filter.ProjectID)
+	}
+	if filter.ActorID != uuid.Nil {
+		q = q.Where("payload->>'actor_id' = ?", filter.ActorID.String())
+	}
+	if filter.Action != "" {
+		q = q.Where("payload->>'action' = ?", filter.Action)
+	}
+	if filter.From != nil {
+		q = q.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		q = q.Where("created_at < ?", *filter.To)
+	}
+
+	if len(filter.Columns) > 0 && filter.Value != "" {
+		lf := "%" + filter.Value + "%"
 
 		builder := bytes.NewBufferString("(")
-		values := make([]interface{}, len(filterColumns))
+		values := make([]interface{}, len(filter.Columns))
 
-		for idx, col := range filterColumns {
+		for idx, col := range filter.Columns {
 			builder.WriteString(fmt.Sprintf("payload->>'%s' ILIKE ?", col))
 			values[idx] = lf
 
-			if idx+1 < len(filterColumns) {
+			if idx+1 < len(filter.Columns) {
 				builder.WriteString(" OR ")
 			}
 		}
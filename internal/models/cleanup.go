@@ -67,6 +67,16 @@ func NewCleanup(config *conf.GlobalConfiguration) *Cleanup {
 		)
 	}
 
+	if config.RateLimitDatabase {
+		tableProjectRateLimits := ProjectRateLimit{}.TableName()
+		tableProjects := Project{}.TableName()
+
+		// requests are kept for the rate limit window of their project
+		c.cleanupStatements = append(c.cleanupStatements,
+			fmt.Sprintf("delete from %q where ctid in (select r.ctid from %q r join %q p on p.id = r.project_id where r.request_time < now() - make_interval(secs => coalesce((p.rate_limits->>'seconds')::int, 0)) limit 100 for update of r skip locked);", tableProjectRateLimits, tableProjectRateLimits, tableProjects),
+		)
+	}
+
 	if config.Sessions.Timebox != nil {
 		timeboxSeconds := int((*config.Sessions.Timebox).Seconds())
 
@@ -39,6 +39,8 @@ func TruncateAll(conn *storage.Connection) error {
 			(&pop.Model{Value: Project{}}).TableName(),
 			(&pop.Model{Value: Organization{}}).TableName(),
 			(&pop.Model{Value: OrganizationTier{}}).TableName(),
+			(&pop.Model{Value: OrganizationMember{}}).TableName(),
+			(&pop.Model{Value: OrganizationInvitation{}}).TableName(),
 			(&pop.Model{Value: User{}}).TableName(),
 			(&pop.Model{Value: Identity{}}).TableName(),
 			(&pop.Model{Value: RefreshToken{}}).TableName(),
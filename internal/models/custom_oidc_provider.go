@@ -0,0 +1,181 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/crypto"
+	"github.com/supabase/auth/internal/storage"
+)
+
+var customOIDCProviderSlugRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// CustomOIDCProvider is an OIDC provider registered by a project, used to
+// sign in with /authorize?provider=custom:<slug>. The client secret is
+// encrypted with the database encryption keys when encryption is enabled.
+type CustomOIDCProvider struct {
+	ID           uuid.UUID          `json:"id" db:"id"`
+	ProjectID    uuid.UUID          `json:"project_id" db:"project_id"`
+	Slug         string             `json:"slug" db:"slug"`
+	Issuer       string             `json:"issuer" db:"issuer"`
+	DiscoveryURL storage.NullString `json:"discovery_url" db:"discovery_url"`
+	ClientID     string             `json:"client_id" db:"client_id"`
+	ClientSecret string             `json:"-" db:"client_secret"`
+	Scopes       string             `json:"-" db:"scopes"`
+
+	// ClaimMappings maps standard claims, such as email, to the claims the
+	// provider returns them in.
+	ClaimMappings JSONMap `json:"claim_mappings" db:"claim_mappings"`
+	Enabled       bool    `json:"enabled" db:"enabled"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (CustomOIDCProvider) TableName() string {
+	return "custom_oidc_providers"
+}
+
+// NewCustomOIDCProvider initializes a custom OIDC provider of a project.
+func NewCustomOIDCProvider(projectID uuid.UUID, slug string) *CustomOIDCProvider {
+	return &CustomOIDCProvider{
+		ID:            uuid.Must(uuid.NewV4()),
+		ProjectID:     projectID,
+		Slug:          slug,
+		ClaimMappings: JSONMap{},
+		Enabled:       true,
+	}
+}
+
+// Name returns the provider name used with /authorize.
+func (p *CustomOIDCProvider) Name() string {
+	return "custom:" + p.Slug
+}
+
+// GetScopes returns the scopes as a slice
+func (p *CustomOIDCProvider) GetScopes() []string {
+	if p.Scopes == "" {
+		return []string{}
+	}
+	return strings.Split(p.Scopes, ",")
+}
+
+// SetScopes sets the scopes from a slice
+func (p *CustomOIDCProvider) SetScopes(scopes []string) {
+	p.Scopes = strings.Join(scopes, ",")
+}
+
+// GetClaimMappings returns the claim mappings with string values.
+func (p *CustomOIDCProvider) GetClaimMappings() map[string]string {
+	mappings := make(map[string]string, len(p.ClaimMappings))
+	for claim, source := range p.ClaimMappings {
+		if s, ok := source.(string); ok {
+			mappings[claim] = s
+		}
+	}
+	return mappings
+}
+
+// MarshalJSON returns the scopes as a list.
+func (p *CustomOIDCProvider) MarshalJSON() ([]byte, error) {
+	type provider CustomOIDCProvider
+	return json.Marshal(&struct {
+		*provider
+		Name   string   `json:"name"`
+		Scopes []string `json:"scopes"`
+	}{(*provider)(p), p.Name(), p.GetScopes()})
+}
+
+// Validate checks the slug, URLs, client and claim mappings of the provider.
+func (p *CustomOIDCProvider) Validate() error {
+	if !customOIDCProviderSlugRegexp.MatchString(p.Slug) {
+		return fmt.Errorf("slug must be lowercase letters, digits, dashes and underscores")
+	}
+	for name, value := range map[string]string{"issuer": p.Issuer, "discovery_url": p.DiscoveryURL.String()} {
+		if value == "" && name == "discovery_url" {
+			continue
+		}
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("%s must be an https URL", name)
+		}
+	}
+	if p.ClientID == "" {
+		return fmt.Errorf("client_id is required")
+	}
+	if p.ClientSecret == "" {
+		return fmt.Errorf("client_secret is required")
+	}
+	for claim, source := range p.ClaimMappings {
+		if s, ok := source.(string); !ok || s == "" {
+			return fmt.Errorf("claim_mappings.%s must name a claim", claim)
+		}
+	}
+	return nil
+}
+
+// SetClientSecret stores the client secret, encrypted when encryption is
+// enabled. The encrypted secret is bound to the provider ID.
+func (p *CustomOIDCProvider) SetClientSecret(secret string, dbEncryption conf.DatabaseEncryptionConfiguration) error {
+	p.ClientSecret = secret
+	if secret != "" && dbEncryption.Encrypt {
+		es, err := crypto.NewEncryptedString(p.ID.String(), []byte(secret), dbEncryption.EncryptionKeyID, dbEncryption.EncryptionKey)
+		if err != nil {
+			return err
+		}
+		p.ClientSecret = es.String()
+	}
+	return nil
+}
+
+// GetClientSecret returns the client secret, decrypting it if needed.
+func (p *CustomOIDCProvider) GetClientSecret(dbEncryption conf.DatabaseEncryptionConfiguration) (string, error) {
+	if es := crypto.ParseEncryptedString(p.ClientSecret); es != nil {
+		bytes, err := es.Decrypt(p.ID.String(), dbEncryption.DecryptionKeys)
+		if err != nil {
+			return "", err
+		}
+		return string(bytes), nil
+	}
+	return p.ClientSecret, nil
+}
+
+// FindCustomOIDCProviders returns the custom OIDC providers of a project.
+func FindCustomOIDCProviders(tx *storage.Connection, projectID uuid.UUID) ([]*CustomOIDCProvider, error) {
+	providers := []*CustomOIDCProvider{}
+	if err := tx.Q().Where("project_id = ?", projectID).Order("slug asc").All(&providers); err != nil {
+		return nil, errors.Wrap(err, "error finding custom OIDC providers")
+	}
+	return providers, nil
+}
+
+// FindCustomOIDCProviderByID finds a custom OIDC provider of a project by
+// its ID.
+func FindCustomOIDCProviderByID(tx *storage.Connection, projectID, id uuid.UUID) (*CustomOIDCProvider, error) {
+	return findCustomOIDCProvider(tx, "project_id = ? and id = ?", projectID, id)
+}
+
+// FindCustomOIDCProviderBySlug finds a custom OIDC provider of a project by
+// its slug.
+func FindCustomOIDCProviderBySlug(tx *storage.Connection, projectID uuid.UUID, slug string) (*CustomOIDCProvider, error) {
+	return findCustomOIDCProvider(tx, "project_id = ? and slug = ?", projectID, slug)
+}
+
+func findCustomOIDCProvider(tx *storage.Connection, query string, args ...interface{}) (*CustomOIDCProvider, error) {
+	obj := &CustomOIDCProvider{}
+	if err := tx.Q().Where(query, args...).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, CustomOIDCProviderNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding custom OIDC provider")
+	}
+	return obj, nil
+}
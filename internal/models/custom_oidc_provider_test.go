@@ -0,0 +1,49 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/storage"
+)
+
+func TestCustomOIDCProviderValidate(t *testing.T) {
+	p := NewCustomOIDCProvider(uuid.Must(uuid.NewV4()), "okta")
+	p.Issuer = "https://example.okta.com"
+	p.ClientID = "client"
+	p.ClientSecret = "secret"
+	p.ClaimMappings = JSONMap{"email": "upn"}
+	require.NoError(t, p.Validate())
+
+	p.DiscoveryURL = storage.NullString("http://example.okta.com/.well-known/openid-configuration")
+	require.Error(t, p.Validate())
+	p.DiscoveryURL = ""
+
+	p.Slug = "-okta"
+	require.Error(t, p.Validate())
+	p.Slug = "okta"
+
+	p.ClaimMappings = JSONMap{"email": 1}
+	require.Error(t, p.Validate())
+}
+
+func TestCustomOIDCProviderClientSecret(t *testing.T) {
+	dbEncryption := conf.DatabaseEncryptionConfiguration{
+		Encrypt:         true,
+		EncryptionKeyID: "key",
+		EncryptionKey:   "pwFoiPyybQMqNmYVN0gUnpbfpGQV2sDv9vp0ZAxi_Y4",
+		DecryptionKeys: map[string]string{
+			"key": "pwFoiPyybQMqNmYVN0gUnpbfpGQV2sDv9vp0ZAxi_Y4",
+		},
+	}
+
+	p := NewCustomOIDCProvider(uuid.Must(uuid.NewV4()), "okta")
+	require.NoError(t, p.SetClientSecret("secret", dbEncryption))
+	require.NotEqual(t, "secret", p.ClientSecret)
+
+	secret, err := p.GetClientSecret(dbEncryption)
+	require.NoError(t, err)
+	require.Equal(t, "secret", secret)
+}
@@ -5,6 +5,26 @@ func IsNotFoundError(err error) bool {
 	switch err.(type) {
 	case UserNotFoundError, *UserNotFoundError:
 		return true
+	case OrganizationNotFoundError, *OrganizationNotFoundError:
+		return true
+	case ProjectNotFoundError, *ProjectNotFoundError:
+		return true
+	case ProjectTierNotFoundError, *ProjectTierNotFoundError:
+		return true
+	case OrganizationMemberNotFoundError, *OrganizationMemberNotFoundError:
+		return true
+	case OrganizationInvitationNotFoundError, *OrganizationInvitationNotFoundError:
+		return true
+	case APIKeyNotFoundError, *APIKeyNotFoundError:
+		return true
+	case SMTPConfigNotFoundError, *SMTPConfigNotFoundError:
+		return true
+	case MFAPolicyNotFoundError, *MFAPolicyNotFoundError:
+		return true
+	case ProjectSettingsNotFoundError, *ProjectSettingsNotFoundError:
+		return true
+	case CustomOIDCProviderNotFoundError, *CustomOIDCProviderNotFoundError:
+		return true
 	case SessionNotFoundError, *SessionNotFoundError:
 		return true
 	case ConfirmationTokenNotFoundError, *ConfirmationTokenNotFoundError:
@@ -19,6 +39,12 @@ func IsNotFoundError(err error) bool {
 		return true
 	case FactorNotFoundError, *FactorNotFoundError:
 		return true
+	case RecoveryCodeNotFoundError, *RecoveryCodeNotFoundError:
+		return true
+	case PasskeyNotFoundError, *PasskeyNotFoundError:
+		return true
+	case PasskeyChallengeNotFoundError, *PasskeyChallengeNotFoundError:
+		return true
 	case SSOProviderNotFoundError, *SSOProviderNotFoundError:
 		return true
 	case SAMLRelayStateNotFoundError, *SAMLRelayStateNotFoundError:
@@ -55,6 +81,73 @@ func (e OrganizationNotFoundError) Error() string {
 	return "Organization not found"
 }
 
+// OrganizationMemberNotFoundError represents when a user is not a member of
+// an organization.
+type OrganizationMemberNotFoundError struct{}
+
+func (e OrganizationMemberNotFoundError) Error() string {
+	return "Organization member not found"
+}
+
+// OrganizationInvitationNotFoundError represents when an organization
+// invitation is not found.
+type OrganizationInvitationNotFoundError struct{}
+
+func (e OrganizationInvitationNotFoundError) Error() string {
+	return "Organization invitation not found"
+}
+
+// APIKeyNotFoundError represents when an API key is not found.
+type APIKeyNotFoundError struct{}
+
+func (e APIKeyNotFoundError) Error() string {
+	return "API key not found"
+}
+
+// SMTPConfigNotFoundError represents when an organization or project has no
+// SMTP configuration.
+type SMTPConfigNotFoundError struct{}
+
+func (e SMTPConfigNotFoundError) Error() string {
+	return "SMTP config not found"
+}
+
+// MFAPolicyNotFoundError represents when an organization has no MFA policy.
+type MFAPolicyNotFoundError struct{}
+
+func (e MFAPolicyNotFoundError) Error() string {
+	return "MFA policy not found"
+}
+
+// ProjectNotFoundError represents when a project is not found.
+type ProjectNotFoundError struct{}
+
+func (e ProjectNotFoundError) Error() string {
+	return "Project not found"
+}
+
+// ProjectSettingsNotFoundError represents when a project has no settings.
+type ProjectSettingsNotFoundError struct{}
+
+func (e ProjectSettingsNotFoundError) Error() string {
+	return "Project settings not found"
+}
+
+// CustomOIDCProviderNotFoundError represents when a custom OIDC provider is
+// not found.
+type CustomOIDCProviderNotFoundError struct{}
+
+func (e CustomOIDCProviderNotFoundError) Error() string {
+	return "Custom OIDC provider not found"
+}
+
+// ProjectTierNotFoundError represents when a project tier is not found.
+type ProjectTierNotFoundError struct{}
+
+func (e ProjectTierNotFoundError) Error() string {
+	return "Project tier not found"
+}
+
 // IdentityNotFoundError represents when an identity is not found.
 type IdentityNotFoundError struct{}
 
@@ -90,6 +183,29 @@ func (e FactorNotFoundError) Error() string {
 	return "Factor not found"
 }
 
+// RecoveryCodeNotFoundError represents when an unused recovery code is not
+// found.
+type RecoveryCodeNotFoundError struct{}
+
+func (e RecoveryCodeNotFoundError) Error() string {
+	return "Recovery code not found"
+}
+
+// PasskeyNotFoundError represents when a passkey is not found.
+type PasskeyNotFoundError struct{}
+
+func (e PasskeyNotFoundError) Error() string {
+	return "Passkey not found"
+}
+
+// PasskeyChallengeNotFoundError represents when a passkey challenge is not
+// found.
+type PasskeyChallengeNotFoundError struct{}
+
+func (e PasskeyChallengeNotFoundError) Error() string {
+	return "Passkey challenge not found"
+}
+
 // ChallengeNotFoundError represents when a user is not found.
 type ChallengeNotFoundError struct{}
 
@@ -36,6 +36,7 @@ func (factorState FactorState) String() string {
 const TOTP = "totp"
 const Phone = "phone"
 const WebAuthn = "webauthn"
+const RecoveryCode = "recovery_code"
 
 type AuthenticationMethod int
 
@@ -56,6 +57,8 @@ const (
 	Anonymous
 	Web3
 	OAuthProviderAuthorizationCode
+	PasskeySignIn
+	MFARecovery
 )
 
 func (authMethod AuthenticationMethod) String() string {
@@ -92,6 +95,10 @@ func (authMethod AuthenticationMethod) String() string {
 		return "web3"
 	case OAuthProviderAuthorizationCode:
 		return "oauth_provider/authorization_code"
+	case PasskeySignIn:
+		return "passkey"
+	case MFARecovery:
+		return "mfa/recovery_code"
 	}
 	return ""
 }
@@ -131,6 +138,10 @@ func ParseAuthenticationMethod(authMethod string) (AuthenticationMethod, error)
 		return Web3, nil
 	case "oauth_provider/authorization_code":
 		return OAuthProviderAuthorizationCode, nil
+	case "passkey":
+		return PasskeySignIn, nil
+	case "mfa/recovery_code":
+		return MFARecovery, nil
 
 	}
 	return 0, fmt.Errorf("unsupported authentication method %q", authMethod)
@@ -255,6 +266,12 @@ func NewWebAuthnFactor(user *User, friendlyName string) *Factor {
 	return factor
 }
 
+// NewRecoveryCodeFactor creates the factor holding the recovery codes of the
+// user, it is verified as the codes are only shown once.
+func NewRecoveryCodeFactor(user *User) *Factor {
+	return NewFactor(user, "", RecoveryCode, FactorStateVerified)
+}
+
 func (f *Factor) SetSecret(secret string, encrypt bool, encryptionKeyID, encryptionKey string) error {
 	f.Secret = secret
 	if encrypt {
@@ -409,6 +426,10 @@ func (f *Factor) IsPhoneFactor() bool {
 	return f.FactorType == Phone
 }
 
+func (f *Factor) IsRecoveryCodeFactor() bool {
+	return f.FactorType == RecoveryCode
+}
+
 func (f *Factor) FindChallengeByID(conn *storage.Connection, challengeID uuid.UUID) (*Challenge, error) {
 	var challenge Challenge
 	err := conn.Q().Where("id = ? and factor_id = ?", challengeID, f.ID).First(&challenge)
@@ -0,0 +1,160 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// MFAPolicyFactorTypes are the factor types an MFA policy can require.
+var MFAPolicyFactorTypes = []string{TOTP, Phone, WebAuthn}
+
+// OrganizationMFAPolicy requires the members of an organization to enroll a
+// factor. Members get EnrollmentGracePeriod seconds to enroll one of the
+// RequiredFactorTypes, counted from when they or the policy were created,
+// whichever is later. Sessions below MinimumAAL cannot be refreshed once the
+// member has a verified factor.
+type OrganizationMFAPolicy struct {
+	OrganizationID        uuid.UUID `json:"organization_id" db:"organization_id"`
+	RequiredFactorTypes   string    `json:"-" db:"required_factor_types"`
+	EnrollmentGracePeriod int       `json:"enrollment_grace_period" db:"enrollment_grace_period"`
+	MinimumAAL            string    `json:"minimum_aal" db:"minimum_aal"`
+	CreatedAt             time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (OrganizationMFAPolicy) TableName() string {
+	return "organization_mfa_policies"
+}
+
+// GetRequiredFactorTypes returns the required factor types as a slice
+func (p *OrganizationMFAPolicy) GetRequiredFactorTypes() []string {
+	if p.RequiredFactorTypes == "" {
+		return []string{}
+	}
+	return strings.Split(p.RequiredFactorTypes, ",")
+}
+
+// SetRequiredFactorTypes sets the required factor types from a slice
+func (p *OrganizationMFAPolicy) SetRequiredFactorTypes(factorTypes []string) {
+	p.RequiredFactorTypes = strings.Join(factorTypes, ",")
+}
+
+// MarshalJSON returns the required factor types as a list.
+func (p *OrganizationMFAPolicy) MarshalJSON() ([]byte, error) {
+	type policy OrganizationMFAPolicy
+	return json.Marshal(&struct {
+		*policy
+		RequiredFactorTypes []string `json:"required_factor_types"`
+	}{(*policy)(p), p.GetRequiredFactorTypes()})
+}
+
+// Validate checks the factor types, grace period and AAL of the policy.
+func (p *OrganizationMFAPolicy) Validate() error {
+	factorTypes := p.GetRequiredFactorTypes()
+	if len(factorTypes) == 0 {
+		return fmt.Errorf("required_factor_types must name at least one factor type")
+	}
+	for _, factorType := range factorTypes {
+		if !slices.Contains(MFAPolicyFactorTypes, factorType) {
+			return fmt.Errorf("required_factor_types must be one of %v", MFAPolicyFactorTypes)
+		}
+	}
+	if p.EnrollmentGracePeriod < 0 {
+		return fmt.Errorf("enrollment_grace_period cannot be negative")
+	}
+	if p.MinimumAAL != AAL1.String() && p.MinimumAAL != AAL2.String() {
+		return fmt.Errorf("minimum_aal must be one of %v", []string{AAL1.String(), AAL2.String()})
+	}
+	return nil
+}
+
+// IsEnrolled reports whether the user has a verified factor of one of the
+// required types. The factors of the user must be loaded.
+func (p *OrganizationMFAPolicy) IsEnrolled(user *User) bool {
+	factorTypes := p.GetRequiredFactorTypes()
+	for _, factor := range user.Factors {
+		if factor.IsVerified() && slices.Contains(factorTypes, factor.FactorType) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnrollmentDeadline returns when the grace period of the user ends.
+func (p *OrganizationMFAPolicy) EnrollmentDeadline(user *User) time.Time {
+	start := p.CreatedAt
+	if user.CreatedAt.After(start) {
+		start = user.CreatedAt
+	}
+	return start.Add(time.Duration(p.EnrollmentGracePeriod) * time.Second)
+}
+
+// EnrollmentRequired reports whether the grace period of a user that has not
+// enrolled a required factor is over. The sessions of such users are limited
+// to enrolling a factor.
+func (p *OrganizationMFAPolicy) EnrollmentRequired(user *User, now time.Time) bool {
+	return !p.IsEnrolled(user) && now.After(p.EnrollmentDeadline(user))
+}
+
+// RefreshAllowed reports whether a session of the user with the given AAL
+// can be refreshed. Users without a verified factor cannot reach a higher
+// AAL, they keep refreshing their sessions so that they can enroll one.
+func (p *OrganizationMFAPolicy) RefreshAllowed(user *User, aal AuthenticatorAssuranceLevel) bool {
+	return CompareAAL(aal, ParseAAL(&p.MinimumAAL)) >= 0 || user.HighestPossibleAAL() == AAL1
+}
+
+// FindOrganizationMFAPolicy finds the MFA policy of an organization.
+func FindOrganizationMFAPolicy(tx *storage.Connection, organizationID uuid.UUID) (*OrganizationMFAPolicy, error) {
+	obj := &OrganizationMFAPolicy{}
+	if err := tx.Q().Where("organization_id = ?", organizationID).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, MFAPolicyNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding organization MFA policy")
+	}
+	return obj, nil
+}
+
+// SaveOrganizationMFAPolicy inserts the MFA policy of an organization or
+// replaces the one it already has.
+func SaveOrganizationMFAPolicy(tx *storage.Connection, policy *OrganizationMFAPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`insert into %q (organization_id, required_factor_types, enrollment_grace_period, minimum_aal)
+values (?, ?, ?, ?)
+on conflict (organization_id) do update set
+	required_factor_types = excluded.required_factor_types,
+	enrollment_grace_period = excluded.enrollment_grace_period,
+	minimum_aal = excluded.minimum_aal,
+	updated_at = now()
+returning *`, policy.TableName())
+
+	if err := tx.RawQuery(query,
+		policy.OrganizationID,
+		policy.RequiredFactorTypes,
+		policy.EnrollmentGracePeriod,
+		policy.MinimumAAL,
+	).First(policy); err != nil {
+		return errors.Wrap(err, "error saving organization MFA policy")
+	}
+	return nil
+}
+
+// DeleteOrganizationMFAPolicy removes the MFA policy of an organization.
+func DeleteOrganizationMFAPolicy(tx *storage.Connection, organizationID uuid.UUID) error {
+	query := fmt.Sprintf(`delete from %q where organization_id = ?`, OrganizationMFAPolicy{}.TableName())
+	if err := tx.RawQuery(query, organizationID).Exec(); err != nil {
+		return errors.Wrap(err, "error deleting organization MFA policy")
+	}
+	return nil
+}
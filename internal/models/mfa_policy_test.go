@@ -0,0 +1,66 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationMFAPolicyEnrollmentRequired(t *testing.T) {
+	now := time.Now()
+	policy := &OrganizationMFAPolicy{
+		RequiredFactorTypes:   TOTP,
+		EnrollmentGracePeriod: 3600,
+		MinimumAAL:            AAL2.String(),
+		CreatedAt:             now.Add(-2 * time.Hour),
+	}
+
+	user := &User{CreatedAt: now.Add(-3 * time.Hour)}
+	require.True(t, policy.EnrollmentRequired(user, now))
+
+	// The grace period of users created after the policy starts with them
+	user.CreatedAt = now.Add(-30 * time.Minute)
+	require.False(t, policy.EnrollmentRequired(user, now))
+	require.True(t, policy.EnrollmentRequired(user, now.Add(time.Hour)))
+
+	// Unverified factors and factors of other types do not count
+	user.Factors = []Factor{
+		{FactorType: TOTP, Status: FactorStateUnverified.String()},
+		{FactorType: Phone, Status: FactorStateVerified.String()},
+	}
+	require.True(t, policy.EnrollmentRequired(user, now.Add(time.Hour)))
+
+	user.Factors = append(user.Factors, Factor{FactorType: TOTP, Status: FactorStateVerified.String()})
+	require.False(t, policy.EnrollmentRequired(user, now.Add(time.Hour)))
+}
+
+func TestOrganizationMFAPolicyRefreshAllowed(t *testing.T) {
+	policy := &OrganizationMFAPolicy{
+		RequiredFactorTypes: TOTP,
+		MinimumAAL:          AAL2.String(),
+	}
+
+	// Users without a verified factor keep refreshing so that they can enroll one
+	user := &User{}
+	require.True(t, policy.RefreshAllowed(user, AAL1))
+
+	user.Factors = []Factor{{FactorType: TOTP, Status: FactorStateVerified.String()}}
+	require.False(t, policy.RefreshAllowed(user, AAL1))
+	require.True(t, policy.RefreshAllowed(user, AAL2))
+}
+
+func TestOrganizationMFAPolicyValidate(t *testing.T) {
+	policy := &OrganizationMFAPolicy{MinimumAAL: AAL1.String()}
+	require.Error(t, policy.Validate())
+
+	policy.SetRequiredFactorTypes([]string{TOTP, WebAuthn})
+	require.NoError(t, policy.Validate())
+
+	policy.SetRequiredFactorTypes([]string{"sms"})
+	require.Error(t, policy.Validate())
+
+	policy.SetRequiredFactorTypes([]string{TOTP})
+	policy.MinimumAAL = AAL3.String()
+	require.Error(t, policy.Validate())
+}
@@ -33,6 +33,11 @@ type OAuthServerClient struct {
 	RegistrationType string    `json:"registration_type" db:"registration_type"`
 	ClientType       string    `json:"client_type" db:"client_type"`
 
+	// ProjectID is the project whose users the client may authorize. When
+	// OrganizationID is set, only users of that organization are allowed.
+	ProjectID      uuid.UUID     `json:"project_id" db:"project_id"`
+	OrganizationID uuid.NullUUID `json:"organization_id,omitempty" db:"organization_id"`
+
 	RedirectURIs string     `json:"-" db:"redirect_uris"`
 	GrantTypes   string     `json:"grant_types" db:"grant_types"`
 	ClientName   *string    `json:"client_name,omitempty" db:"client_name"`
@@ -82,6 +87,10 @@ func (c *OAuthServerClient) Validate() error {
 		return fmt.Errorf("client_secret is not allowed for public clients, use PKCE instead")
 	}
 
+	if c.ProjectID == uuid.Nil {
+		return fmt.Errorf("project_id is required")
+	}
+
 	return nil
 }
 
@@ -121,6 +130,25 @@ func (c *OAuthServerClient) IsConfidential() bool {
 	return c.ClientType == OAuthServerClientTypeConfidential
 }
 
+// AllowsUser reports whether the user belongs to the tenant of the client,
+// either its project or, for organization clients, its organization.
+func (c *OAuthServerClient) AllowsUser(tx *storage.Connection, user *User) (bool, error) {
+	if user.ProjectID != c.ProjectID {
+		return false, nil
+	}
+	if !c.OrganizationID.Valid || user.OrganizationID.UUID == c.OrganizationID.UUID {
+		return true, nil
+	}
+
+	if _, err := user.FindOrganizationMembership(tx, c.OrganizationID.UUID); err != nil {
+		if IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // IsGrantTypeAllowed returns true if the client is allowed to use the specified grant type
 func (c *OAuthServerClient) IsGrantTypeAllowed(grantType string) bool {
 	allowedTypes := c.GetGrantTypes()
@@ -17,7 +17,10 @@ import (
 
 type OAuthServerClientTestSuite struct {
 	suite.Suite
-	db *storage.Connection
+	db             *storage.Connection
+	config         *conf.GlobalConfiguration
+	ProjectID      uuid.UUID
+	OrganizationID uuid.UUID
 }
 
 // testHashClientSecret is a test helper that hashes a client secret using the same method as the service
@@ -27,7 +30,7 @@ func testHashClientSecret(secret string) (string, error) {
 }
 
 func (ts *OAuthServerClientTestSuite) SetupTest() {
-	_ = TruncateAll(ts.db)
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.db, ts.config)
 }
 
 func TestOAuthServerClient(t *testing.T) {
@@ -38,7 +41,8 @@ func TestOAuthServerClient(t *testing.T) {
 	require.NoError(t, err)
 
 	ts := &OAuthServerClientTestSuite{
-		db: conn,
+		db:     conn,
+		config: globalConfig,
 	}
 	defer ts.db.Close()
 
@@ -55,6 +59,7 @@ func (ts *OAuthServerClientTestSuite) TestOAuthServerClientValidation() {
 		ClientType:       OAuthServerClientTypeConfidential,
 		ClientSecretHash: testSecretHash,
 		RedirectURIs:     "https://example.com/callback",
+		ProjectID:        ts.ProjectID,
 		GrantTypes:       "authorization_code,refresh_token",
 	}
 
@@ -82,6 +87,13 @@ func (ts *OAuthServerClientTestSuite) TestOAuthServerClientValidation() {
 	err = invalidClient.Validate()
 	assert.Error(ts.T(), err)
 	assert.Contains(ts.T(), err.Error(), "at least one redirect_uri is required")
+
+	// Test missing project
+	invalidClient = *validClient
+	invalidClient.ProjectID = uuid.Nil
+	err = invalidClient.Validate()
+	assert.Error(ts.T(), err)
+	assert.Contains(ts.T(), err.Error(), "project_id is required")
 }
 
 func (ts *OAuthServerClientTestSuite) TestRedirectURIValidation() {
@@ -154,6 +166,7 @@ func (ts *OAuthServerClientTestSuite) TestCreateOAuthServerClient() {
 		ClientType:       OAuthServerClientTypeConfidential,
 		ClientSecretHash: testSecretHash,
 		RedirectURIs:     "https://example.com/callback",
+		ProjectID:        ts.ProjectID,
 	}
 
 	err := CreateOAuthServerClient(ts.db, client)
@@ -188,6 +201,7 @@ func (ts *OAuthServerClientTestSuite) TestFindOAuthServerClientByID() {
 		ClientType:       OAuthServerClientTypeConfidential,
 		ClientSecretHash: testSecretHash,
 		RedirectURIs:     "https://example.com/callback",
+		ProjectID:        ts.ProjectID,
 	}
 
 	err := CreateOAuthServerClient(ts.db, client)
@@ -217,6 +231,7 @@ func (ts *OAuthServerClientTestSuite) TestFindOAuthServerClientByClientID() {
 		ClientType:       OAuthServerClientTypeConfidential,
 		ClientSecretHash: testSecretHash,
 		RedirectURIs:     "https://example.com/callback",
+		ProjectID:        ts.ProjectID,
 	}
 
 	err := CreateOAuthServerClient(ts.db, client)
@@ -246,6 +261,7 @@ func (ts *OAuthServerClientTestSuite) TestUpdateOAuthServerClient() {
 		ClientType:       OAuthServerClientTypeConfidential,
 		ClientSecretHash: testSecretHash,
 		RedirectURIs:     "https://example.com/callback",
+		ProjectID:        ts.ProjectID,
 	}
 
 	err := CreateOAuthServerClient(ts.db, client)
@@ -300,6 +316,7 @@ func (ts *OAuthServerClientTestSuite) TestSoftDelete() {
 		ClientType:       OAuthServerClientTypeConfidential,
 		ClientSecretHash: testSecretHash,
 		RedirectURIs:     "https://example.com/callback",
+		ProjectID:        ts.ProjectID,
 	}
 
 	err := CreateOAuthServerClient(ts.db, client)
@@ -317,3 +334,35 @@ func (ts *OAuthServerClientTestSuite) TestSoftDelete() {
 	assert.Error(ts.T(), err)
 	assert.True(ts.T(), IsNotFoundError(err))
 }
+
+func (ts *OAuthServerClientTestSuite) TestAllowsUser() {
+	client := &OAuthServerClient{ProjectID: ts.ProjectID}
+
+	user, err := NewUser("", "user@example.com", "test", ts.config.JWT.Aud, nil, uuid.Nil, ts.ProjectID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.db.Create(user, "organization_id", "organization_role"))
+
+	allowed, err := client.AllowsUser(ts.db, user)
+	require.NoError(ts.T(), err)
+	assert.True(ts.T(), allowed)
+
+	// organization clients only allow members of the organization
+	client.OrganizationID = uuid.NullUUID{UUID: ts.OrganizationID, Valid: true}
+	allowed, err = client.AllowsUser(ts.db, user)
+	require.NoError(ts.T(), err)
+	assert.False(ts.T(), allowed)
+
+	member, err := NewOrganizationMember(ts.OrganizationID, user.ID, OrganizationRoleClient)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), SaveOrganizationMember(ts.db, member))
+
+	allowed, err = client.AllowsUser(ts.db, user)
+	require.NoError(ts.T(), err)
+	assert.True(ts.T(), allowed)
+
+	// users of other projects are never allowed
+	client = &OAuthServerClient{ProjectID: uuid.Must(uuid.NewV4())}
+	allowed, err = client.AllowsUser(ts.db, user)
+	require.NoError(ts.T(), err)
+	assert.False(ts.T(), allowed)
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"database/sql"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/supabase/auth/internal/storage"
@@ -10,13 +12,34 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Values accepted by the auth.organization_roles enum.
+const (
+	OrganizationRoleAdmin        = "admin"
+	OrganizationRoleClient       = "client"
+	OrganizationRoleAPIKey       = "api_key"
+	OrganizationRoleProjectAdmin = "project_admin"
+)
+
+// DefaultTier is the tier of callers whose organization and project have no
+// tier.
+const DefaultTier = "free"
+
+// Values accepted by the public.tier_models, public.tier_times and
+// public.tier_usages enums.
+var (
+	TierModels = []string{"free", "low", "medium", "high"}
+	TierTimes  = []string{"free", "low", "medium", "high", "batch"}
+	TierUsages = []string{"free", "low", "medium", "high"}
+)
+
 type Organization struct {
-	ID        uuid.UUID `json:"id" db:"id"`
-	ProjectID uuid.UUID `json:"project_id" db:"project_id"`
-	AdminID   uuid.UUID `json:"admin_id" db:"admin_id"`
-	Name      string    `json:"name" db:"name"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID          uuid.UUID          `json:"id" db:"id"`
+	ProjectID   uuid.UUID          `json:"project_id" db:"project_id"`
+	AdminID     uuid.UUID          `json:"admin_id" db:"admin_id"`
+	Name        string             `json:"name" db:"name"`
+	Description storage.NullString `json:"description" db:"description"`
+	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at" db:"updated_at"`
 }
 
 type OrganizationTier struct {
@@ -42,48 +65,192 @@ func (OrganizationTier) TableName() string {
 	return "organizations_tier"
 }
 
-func findOrganizationTier(tx *storage.Connection, query string, args ...interface{}) (*OrganizationTier, error) {
-	obj := &OrganizationTier{}
-	if err := tx.Eager().Q().Where(query, args...).First(obj); err != nil {
+// NewOrganization initializes a new organization owned by the given admin user.
+func NewOrganization(projectID, adminID uuid.UUID, name, description string) (*Organization, error) {
+	if projectID == uuid.Nil {
+		return nil, errors.New("project_id must be provided")
+	}
+	if adminID == uuid.Nil {
+		return nil, errors.New("admin_id must be provided")
+	}
+
+	id := uuid.Must(uuid.NewV4())
+
+	organization := &Organization{
+		ID:          id,
+		ProjectID:   projectID,
+		AdminID:     adminID,
+		Name:        name,
+		Description: storage.NullString(description),
+	}
+	return organization, nil
+}
+
+// FindOrganizationByID finds an organization matching the provided ID.
+func FindOrganizationByID(tx *storage.Connection, id uuid.UUID) (*Organization, error) {
+	obj := &Organization{}
+	if err := tx.Q().Where("id = ?", id).First(obj); err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
-			return nil, nil // Or handle as needed
+			return nil, OrganizationNotFoundError{}
 		}
-		return nil, errors.Wrap(err, "error finding organization tier")
+		return nil, errors.Wrap(err, "error finding organization")
 	}
 
 	return obj, nil
 }
 
-func FindTiersByOrganizationIDAndOrganizationRole(tx *storage.Connection, organization_id uuid.UUID, organization_role string) (string, string, string, error) {
+// FindOrganizationByAdminID finds the organization owned by the user.
+func FindOrganizationByAdminID(tx *storage.Connection, adminID uuid.UUID) (*Organization, error) {
+	obj := &Organization{}
+	if err := tx.Q().Where("admin_id = ?", adminID).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, OrganizationNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding organization")
+	}
 
-	var tier_model string = "free"
-	var tier_time string = "free"
-	var tier_usage string = "free"
-	var query string
-	var args []interface{}
+	return obj, nil
+}
 
-	if organization_id != uuid.Nil {
-		query = "organization_id = ?"
-		args = append(args, organization_id)
-		organizationTier, err := findOrganizationTier(tx, query, args...)
+// FindOrganizations finds organizations, optionally restricted to a single
+// project and to organizations whose name matches the filter.
+func FindOrganizations(tx *storage.Connection, project_id uuid.UUID, pageParams *Pagination, sortParams *SortParams, filter string) ([]*Organization, error) {
+	organizations := []*Organization{}
+	q := tx.Q()
 
-		if err != nil {
-			return "", "", "", err
-		}
+	if project_id != uuid.Nil {
+		q = q.Where("project_id = ?", project_id)
+	}
 
-		if organizationTier == nil {
-			return tier_model, tier_time, tier_usage, nil
+	if filter != "" {
+		q = q.Where("name ILIKE ?", "%"+filter+"%")
+	}
+
+	if sortParams != nil && len(sortParams.Fields) > 0 {
+		for _, field := range sortParams.Fields {
+			q = q.Order(field.Name + " " + string(field.Dir))
 		}
+	}
+
+	var err error
+	if pageParams != nil {
+		err = q.Paginate(int(pageParams.Page), int(pageParams.PerPage)).All(&organizations) // #nosec G115
+		pageParams.Count = uint64(q.Paginator.TotalEntriesSize)                             // #nosec G115
+	} else {
+		err = q.All(&organizations)
+	}
+
+	return organizations, err
+}
+
+// UpdateInfo updates the name and description of the organization and
+// returns the columns that changed. Nothing is written when neither changed.
+func (o *Organization) UpdateInfo(tx *storage.Connection, name, description string) ([]string, error) {
+	changed := []string{}
+	if name != o.Name {
+		o.Name = name
+		changed = append(changed, "name")
+	}
+	if description != o.Description.String() {
+		o.Description = storage.NullString(description)
+		changed = append(changed, "description")
+	}
+	if len(changed) == 0 {
+		return changed, nil
+	}
+	return changed, tx.UpdateOnly(o, append(changed, "updated_at")...)
+}
+
+// NewOrganizationTier returns the default tier assigned to a newly created
+// organization.
+func NewOrganizationTier(organizationID uuid.UUID) *OrganizationTier {
+	return &OrganizationTier{
+		OrganizationID:  organizationID,
+		Tier:            DefaultTier,
+		AdminTierModel:  "low",
+		ClientTierModel: "low",
+		AdminTierTime:   "low",
+		ClientTierTime:  "low",
+		AdminTierUsage:  "low",
+		ClientTierUsage: "low",
+	}
+}
+
+// Validate checks that the tier values are accepted by the database enums.
+func (t *OrganizationTier) Validate() error {
+	if t.Tier == "" {
+		return fmt.Errorf("tier is required")
+	}
+	if !slices.Contains(TierModels, t.AdminTierModel) || !slices.Contains(TierModels, t.ClientTierModel) {
+		return fmt.Errorf("tier models must be one of %v", TierModels)
+	}
+	if !slices.Contains(TierTimes, t.AdminTierTime) || !slices.Contains(TierTimes, t.ClientTierTime) {
+		return fmt.Errorf("tier times must be one of %v", TierTimes)
+	}
+	if !slices.Contains(TierUsages, t.AdminTierUsage) || !slices.Contains(TierUsages, t.ClientTierUsage) {
+		return fmt.Errorf("tier usages must be one of %v", TierUsages)
+	}
+	return nil
+}
+
+// FindOrganizationTierByOrganizationID returns the tier of the organization,
+// or nil if no tier has been assigned yet.
+func FindOrganizationTierByOrganizationID(tx *storage.Connection, organization_id uuid.UUID) (*OrganizationTier, error) {
+	return findOrganizationTier(tx, "organization_id = ?", organization_id)
+}
+
+// SaveOrganizationTier inserts the tier of an organization or replaces the
+// one it already has.
+//
+// The row is created first and updated afterwards because the
+// trigger_enforce_free_tier trigger resets the tier of every inserted row to
+// free.
+func SaveOrganizationTier(tx *storage.Connection, tier *OrganizationTier) error {
+	if err := tier.Validate(); err != nil {
+		return err
+	}
+
+	tableName := tier.TableName()
+	if err := tx.RawQuery(fmt.Sprintf(`insert into %q (organization_id) values (?) on conflict (organization_id) do nothing`, tableName), tier.OrganizationID).Exec(); err != nil {
+		return errors.Wrap(err, "error saving organization tier")
+	}
+
+	query := fmt.Sprintf(`update %q set
+	tier = ?,
+	admin_tier_model = ?,
+	client_tier_model = ?,
+	admin_tier_time = ?,
+	client_tier_time = ?,
+	admin_tier_usage = ?,
+	client_tier_usage = ?,
+	updated_at = now()
+where organization_id = ?
+returning *`, tableName)
+
+	if err := tx.RawQuery(query,
+		tier.Tier,
+		tier.AdminTierModel,
+		tier.ClientTierModel,
+		tier.AdminTierTime,
+		tier.ClientTierTime,
+		tier.AdminTierUsage,
+		tier.ClientTierUsage,
+		tier.OrganizationID,
+	).First(tier); err != nil {
+		return errors.Wrap(err, "error saving organization tier")
+	}
+
+	return nil
+}
 
-		if organization_role == "admin" || organization_role == "project_admin" {
-			tier_model = organizationTier.AdminTierModel
-			tier_time = organizationTier.AdminTierTime
-			tier_usage = organizationTier.AdminTierUsage
-		} else {
-			tier_model = organizationTier.ClientTierModel
-			tier_time = organizationTier.ClientTierTime
-			tier_usage = organizationTier.ClientTierUsage
+func findOrganizationTier(tx *storage.Connection, query string, args ...interface{}) (*OrganizationTier, error) {
+	obj := &OrganizationTier{}
+	if err := tx.Eager().Q().Where(query, args...).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, nil // Or handle as needed
 		}
+		return nil, errors.Wrap(err, "error finding organization tier")
 	}
-	return tier_model, tier_time, tier_usage, nil
+
+	return obj, nil
 }
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/crypto"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// OrganizationInvitationRoles are the roles that can be granted through an
+// invitation.
+var OrganizationInvitationRoles = []string{
+	OrganizationRoleAdmin,
+	OrganizationRoleClient,
+}
+
+// OrganizationInvitation is a pending or accepted invitation of an email
+// address into an organization. Only the hash of the invitation token is
+// stored.
+type OrganizationInvitation struct {
+	ID             uuid.UUID     `json:"id" db:"id"`
+	OrganizationID uuid.UUID     `json:"organization_id" db:"organization_id"`
+	Email          string        `json:"email" db:"email"`
+	Role           string        `json:"role" db:"role"`
+	TokenHash      string        `json:"-" db:"token_hash"`
+	InvitedBy      uuid.NullUUID `json:"invited_by,omitempty" db:"invited_by"`
+	ExpiresAt      time.Time     `json:"expires_at" db:"expires_at"`
+	SentAt         *time.Time    `json:"sent_at,omitempty" db:"sent_at"`
+	AcceptedAt     *time.Time    `json:"accepted_at,omitempty" db:"accepted_at"`
+	CreatedAt      time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at" db:"updated_at"`
+}
+
+func (OrganizationInvitation) TableName() string {
+	return "organization_invitations"
+}
+
+// NewOrganizationInvitation initializes an invitation and returns it together
+// with the plain invitation token, which is only known at this point.
+func NewOrganizationInvitation(organizationID uuid.UUID, email, role string, invitedBy uuid.UUID, expiresIn time.Duration) (*OrganizationInvitation, string, error) {
+	if organizationID == uuid.Nil {
+		return nil, "", errors.New("organization_id must be provided")
+	}
+	if email == "" {
+		return nil, "", errors.New("email must be provided")
+	}
+	if !slices.Contains(OrganizationInvitationRoles, role) {
+		return nil, "", errors.Errorf("invalid invitation role %q", role)
+	}
+
+	invitation := &OrganizationInvitation{
+		ID:             uuid.Must(uuid.NewV4()),
+		OrganizationID: organizationID,
+		Email:          strings.ToLower(email),
+		Role:           role,
+		InvitedBy:      uuid.NullUUID{UUID: invitedBy, Valid: invitedBy != uuid.Nil},
+	}
+	token := invitation.regenerateToken(expiresIn)
+
+	return invitation, token, nil
+}
+
+// HashOrganizationInvitationToken returns the value stored in token_hash for
+// an invitation token.
+func HashOrganizationInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (i *OrganizationInvitation) regenerateToken(expiresIn time.Duration) string {
+	token := crypto.SecureAlphanumeric(32)
+	i.TokenHash = HashOrganizationInvitationToken(token)
+	i.ExpiresAt = time.Now().Add(expiresIn)
+	return token
+}
+
+// IsExpired reports whether the invitation can no longer be accepted.
+func (i *OrganizationInvitation) IsExpired() bool {
+	return time.Now().After(i.ExpiresAt)
+}
+
+// IsAccepted reports whether the invitation has been accepted.
+func (i *OrganizationInvitation) IsAccepted() bool {
+	return i.AcceptedAt != nil
+}
+
+// Renew issues a new token for the invitation and extends its expiry. The
+// previous token stops working.
+func (i *OrganizationInvitation) Renew(tx *storage.Connection, expiresIn time.Duration) (string, error) {
+	token := i.regenerateToken(expiresIn)
+	if err := tx.UpdateOnly(i, "token_hash", "expires_at", "updated_at"); err != nil {
+		return "", errors.Wrap(err, "error renewing organization invitation")
+	}
+	return token, nil
+}
+
+// MarkSent records when the invitation email was sent.
+func (i *OrganizationInvitation) MarkSent(tx *storage.Connection) error {
+	now := time.Now()
+	i.SentAt = &now
+	return tx.UpdateOnly(i, "sent_at", "updated_at")
+}
+
+// Accept marks the invitation as accepted.
+func (i *OrganizationInvitation) Accept(tx *storage.Connection) error {
+	now := time.Now()
+	i.AcceptedAt = &now
+	return tx.UpdateOnly(i, "accepted_at", "updated_at")
+}
+
+func findOrganizationInvitation(tx *storage.Connection, query string, args ...interface{}) (*OrganizationInvitation, error) {
+	obj := &OrganizationInvitation{}
+	if err := tx.Q().Where(query, args...).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, OrganizationInvitationNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding organization invitation")
+	}
+
+	return obj, nil
+}
+
+// FindOrganizationInvitationByID finds an invitation by its ID.
+func FindOrganizationInvitationByID(tx *storage.Connection, id uuid.UUID) (*OrganizationInvitation, error) {
+	return findOrganizationInvitation(tx, "id = ?", id)
+}
+
+// FindOrganizationInvitationByToken finds an invitation by its plain token.
+func FindOrganizationInvitationByToken(tx *storage.Connection, token string) (*OrganizationInvitation, error) {
+	return findOrganizationInvitation(tx, "token_hash = ?", HashOrganizationInvitationToken(token))
+}
+
+// FindPendingOrganizationInvitation finds the invitation of the email into
+// the organization that has not been accepted yet.
+func FindPendingOrganizationInvitation(tx *storage.Connection, organizationID uuid.UUID, email string) (*OrganizationInvitation, error) {
+	return findOrganizationInvitation(tx, "organization_id = ? and lower(email) = ? and accepted_at is null", organizationID, strings.ToLower(email))
+}
+
+// FindPendingOrganizationInvitations lists the invitations of an organization
+// that have not been accepted yet, including expired ones.
+func FindPendingOrganizationInvitations(tx *storage.Connection, organizationID uuid.UUID, pageParams *Pagination) ([]*OrganizationInvitation, error) {
+	invitations := []*OrganizationInvitation{}
+	q := tx.Q().Where("organization_id = ? and accepted_at is null", organizationID).Order("created_at desc")
+
+	var err error
+	if pageParams != nil {
+		err = q.Paginate(int(pageParams.Page), int(pageParams.PerPage)).All(&invitations) // #nosec G115
+		pageParams.Count = uint64(q.Paginator.TotalEntriesSize)                           // #nosec G115
+	} else {
+		err = q.All(&invitations)
+	}
+
+	return invitations, errors.Wrap(err, "error finding organization invitations")
+}
@@ -0,0 +1,178 @@
+package models
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// OrganizationRoles are the values accepted by the auth.organization_roles
+// enum.
+var OrganizationRoles = []string{
+	OrganizationRoleAdmin,
+	OrganizationRoleClient,
+	OrganizationRoleAPIKey,
+	OrganizationRoleProjectAdmin,
+}
+
+// OrganizationMemberRoles are the roles that can be granted to a member of an
+// organization. Project admins and API keys are not members of an
+// organization.
+var OrganizationMemberRoles = []string{
+	OrganizationRoleAdmin,
+	OrganizationRoleClient,
+}
+
+// OrganizationMember is the membership of a user in an organization. The
+// organization_id and organization_role columns of a user are its home
+// membership; the other memberships only exist in this table.
+type OrganizationMember struct {
+	ID             uuid.UUID `json:"id" db:"id"`
+	OrganizationID uuid.UUID `json:"organization_id" db:"organization_id"`
+	UserID         uuid.UUID `json:"user_id" db:"user_id"`
+	Role           string    `json:"role" db:"role"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (OrganizationMember) TableName() string {
+	return "organization_members"
+}
+
+// NewOrganizationMember initializes the membership of a user in an
+// organization.
+func NewOrganizationMember(organizationID, userID uuid.UUID, role string) (*OrganizationMember, error) {
+	if organizationID == uuid.Nil {
+		return nil, errors.New("organization_id must be provided")
+	}
+	if userID == uuid.Nil {
+		return nil, errors.New("user_id must be provided")
+	}
+	if !slices.Contains(OrganizationRoles, role) {
+		return nil, errors.Errorf("invalid organization role %q", role)
+	}
+
+	member := &OrganizationMember{
+		ID:             uuid.Must(uuid.NewV4()),
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           role,
+	}
+	return member, nil
+}
+
+func findOrganizationMember(tx *storage.Connection, query string, args ...interface{}) (*OrganizationMember, error) {
+	obj := &OrganizationMember{}
+	if err := tx.Q().Where(query, args...).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, OrganizationMemberNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding organization member")
+	}
+
+	return obj, nil
+}
+
+// FindOrganizationMember finds the membership of the user in the
+// organization.
+func FindOrganizationMember(tx *storage.Connection, organizationID, userID uuid.UUID) (*OrganizationMember, error) {
+	return findOrganizationMember(tx, "organization_id = ? and user_id = ?", organizationID, userID)
+}
+
+// FindOrganizationMembersByUserID finds all memberships of a user, oldest
+// first.
+func FindOrganizationMembersByUserID(tx *storage.Connection, userID uuid.UUID) ([]*OrganizationMember, error) {
+	members := []*OrganizationMember{}
+	if err := tx.Q().Where("user_id = ?", userID).Order("created_at asc").All(&members); err != nil {
+		return nil, errors.Wrap(err, "error finding organization members")
+	}
+	return members, nil
+}
+
+// FindOrganizationMembersByOrganizationID finds all members of an
+// organization.
+func FindOrganizationMembersByOrganizationID(tx *storage.Connection, organizationID uuid.UUID, pageParams *Pagination) ([]*OrganizationMember, error) {
+	members := []*OrganizationMember{}
+	q := tx.Q().Where("organization_id = ?", organizationID).Order("created_at asc")
+
+	var err error
+	if pageParams != nil {
+		err = q.Paginate(int(pageParams.Page), int(pageParams.PerPage)).All(&members) // #nosec G115
+		pageParams.Count = uint64(q.Paginator.TotalEntriesSize)                       // #nosec G115
+	} else {
+		err = q.All(&members)
+	}
+
+	return members, errors.Wrap(err, "error finding organization members")
+}
+
+// SaveOrganizationMember inserts the membership or updates the role of an
+// existing one.
+func SaveOrganizationMember(tx *storage.Connection, member *OrganizationMember) error {
+	if !slices.Contains(OrganizationRoles, member.Role) {
+		return errors.Errorf("invalid organization role %q", member.Role)
+	}
+
+	tableName := member.TableName()
+	query := fmt.Sprintf(`insert into %q (id, organization_id, user_id, role)
+values (?, ?, ?, ?)
+on conflict (organization_id, user_id) do update set
+	role = excluded.role,
+	updated_at = now()
+returning *`, tableName)
+
+	if err := tx.RawQuery(query, member.ID, member.OrganizationID, member.UserID, member.Role).First(member); err != nil {
+		return errors.Wrap(err, "error saving organization member")
+	}
+	return nil
+}
+
+// OrganizationMemberships returns every membership of the user. The home
+// membership from the user's organization_id is included even when it has
+// no row in organization_members.
+func (u *User) OrganizationMemberships(tx *storage.Connection) ([]*OrganizationMember, error) {
+	members, err := FindOrganizationMembersByUserID(tx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if home := u.homeOrganizationMember(); home != nil {
+		if !slices.ContainsFunc(members, func(m *OrganizationMember) bool {
+			return m.OrganizationID == home.OrganizationID
+		}) {
+			members = append([]*OrganizationMember{home}, members...)
+		}
+	}
+
+	return members, nil
+}
+
+// FindOrganizationMembership finds the membership of the user in the given
+// organization, including the home membership.
+func (u *User) FindOrganizationMembership(tx *storage.Connection, organizationID uuid.UUID) (*OrganizationMember, error) {
+	member, err := FindOrganizationMember(tx, organizationID, u.ID)
+	if err != nil && IsNotFoundError(err) {
+		if home := u.homeOrganizationMember(); home != nil && home.OrganizationID == organizationID {
+			return home, nil
+		}
+	}
+	return member, err
+}
+
+func (u *User) homeOrganizationMember() *OrganizationMember {
+	if !u.OrganizationID.Valid || u.OrganizationID.UUID == uuid.Nil {
+		return nil
+	}
+	return &OrganizationMember{
+		OrganizationID: u.OrganizationID.UUID,
+		UserID:         u.ID,
+		Role:           u.OrganizationRole,
+		CreatedAt:      u.CreatedAt,
+		UpdatedAt:      u.UpdatedAt,
+	}
+}
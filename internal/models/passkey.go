@@ -0,0 +1,193 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// Passkey is a discoverable WebAuthn credential a user signs in with,
+// without a password or an existing session.
+type Passkey struct {
+	ID           uuid.UUID           `json:"id" db:"id"`
+	UserID       uuid.UUID           `json:"-" db:"user_id"`
+	FriendlyName string              `json:"friendly_name" db:"friendly_name"`
+	CredentialID string              `json:"-" db:"credential_id"`
+	Credential   *WebAuthnCredential `json:"-" db:"credential"`
+	AAGUID       *uuid.UUID          `json:"aaguid,omitempty" db:"aaguid"`
+	LastUsedAt   *time.Time          `json:"last_used_at" db:"last_used_at"`
+	CreatedAt    time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at" db:"updated_at"`
+}
+
+func (Passkey) TableName() string {
+	return "passkeys"
+}
+
+// EncodeCredentialID returns the lookup key of a WebAuthn credential ID.
+func EncodeCredentialID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
+// NewPasskey creates a passkey for the user from a registered credential.
+func NewPasskey(user *User, friendlyName string, credential *webauthn.Credential) (*Passkey, error) {
+	passkey := &Passkey{
+		ID:           uuid.Must(uuid.NewV4()),
+		UserID:       user.ID,
+		FriendlyName: friendlyName,
+		CredentialID: EncodeCredentialID(credential.ID),
+		Credential:   &WebAuthnCredential{Credential: *credential},
+	}
+
+	if len(credential.Authenticator.AAGUID) > 0 {
+		aaguid, err := uuid.FromBytes(credential.Authenticator.AAGUID)
+		if err != nil {
+			return nil, errors.Wrap(err, "WebAuthn authenticator AAGUID is not UUID")
+		}
+		passkey.AAGUID = &aaguid
+	}
+	return passkey, nil
+}
+
+// UpdateFriendlyName renames the passkey.
+func (p *Passkey) UpdateFriendlyName(tx *storage.Connection, friendlyName string) error {
+	p.FriendlyName = friendlyName
+	return tx.UpdateOnly(p, "friendly_name", "updated_at")
+}
+
+// UpdateLastUsed records a sign-in with the passkey and the new state of the
+// authenticator, such as its signature counter.
+func (p *Passkey) UpdateLastUsed(tx *storage.Connection, credential *webauthn.Credential) error {
+	now := time.Now()
+	p.LastUsedAt = &now
+	p.Credential.Authenticator = credential.Authenticator
+	p.Credential.Flags = credential.Flags
+	return tx.UpdateOnly(p, "credential", "last_used_at", "updated_at")
+}
+
+// FindPasskeysByUser returns the passkeys of a user.
+func FindPasskeysByUser(tx *storage.Connection, user *User) ([]*Passkey, error) {
+	passkeys := []*Passkey{}
+	if err := tx.Q().Where("user_id = ?", user.ID).Order("created_at asc").All(&passkeys); err != nil {
+		return nil, errors.Wrap(err, "error finding passkeys")
+	}
+	return passkeys, nil
+}
+
+// FindOwnedPasskeyByID finds a passkey of a user by its ID.
+func FindOwnedPasskeyByID(tx *storage.Connection, user *User, id uuid.UUID) (*Passkey, error) {
+	return findPasskey(tx, "user_id = ? and id = ?", user.ID, id)
+}
+
+// FindPasskeyByCredentialID finds a passkey by the ID of its WebAuthn
+// credential.
+func FindPasskeyByCredentialID(tx *storage.Connection, credentialID []byte) (*Passkey, error) {
+	return findPasskey(tx, "credential_id = ?", EncodeCredentialID(credentialID))
+}
+
+func findPasskey(tx *storage.Connection, query string, args ...interface{}) (*Passkey, error) {
+	obj := &Passkey{}
+	if err := tx.Q().Where(query, args...).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, PasskeyNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding passkey")
+	}
+	return obj, nil
+}
+
+// PasskeyUser is a user with the credentials of their passkeys, instead of
+// the ones of their MFA factors.
+type PasskeyUser struct {
+	*User
+	Passkeys []*Passkey
+}
+
+// WebAuthnName returns the name the authenticator shows for the passkey,
+// users without an email address or phone number are shown by their ID.
+func (u *PasskeyUser) WebAuthnName() string {
+	switch {
+	case u.GetEmail() != "":
+		return u.GetEmail()
+	case u.GetPhone() != "":
+		return u.GetPhone()
+	default:
+		return u.ID.String()
+	}
+}
+
+// WebAuthnDisplayName returns the same name as WebAuthnName.
+func (u *PasskeyUser) WebAuthnDisplayName() string {
+	return u.WebAuthnName()
+}
+
+// WebAuthnCredentials returns the credentials of the passkeys.
+func (u *PasskeyUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(u.Passkeys))
+	for _, passkey := range u.Passkeys {
+		credentials = append(credentials, passkey.Credential.Credential)
+	}
+	return credentials
+}
+
+// PasskeyChallenge holds the WebAuthn session of a passkey registration, for
+// the user registering it, or of a passkey sign-in, for any user.
+type PasskeyChallenge struct {
+	ID                  uuid.UUID            `json:"id" db:"id"`
+	UserID              uuid.NullUUID        `json:"-" db:"user_id"`
+	IPAddress           string               `json:"-" db:"ip_address"`
+	WebAuthnSessionData *WebAuthnSessionData `json:"-" db:"web_authn_session_data"`
+	CreatedAt           time.Time            `json:"created_at" db:"created_at"`
+}
+
+func (PasskeyChallenge) TableName() string {
+	return "passkey_challenges"
+}
+
+// NewPasskeyChallenge creates the challenge of a WebAuthn session, user is
+// nil for sign-ins.
+func NewPasskeyChallenge(user *User, ipAddress string, session *webauthn.SessionData) *PasskeyChallenge {
+	challenge := &PasskeyChallenge{
+		ID:                  uuid.Must(uuid.NewV4()),
+		IPAddress:           ipAddress,
+		WebAuthnSessionData: &WebAuthnSessionData{SessionData: session},
+	}
+	if user != nil {
+		challenge.UserID = uuid.NullUUID{UUID: user.ID, Valid: true}
+	}
+	return challenge
+}
+
+// GetExpiryTime returns when the challenge expires.
+func (c *PasskeyChallenge) GetExpiryTime(expiryDuration float64) time.Time {
+	return c.CreatedAt.Add(time.Second * time.Duration(expiryDuration))
+}
+
+// HasExpired reports whether the challenge has expired.
+func (c *PasskeyChallenge) HasExpired(expiryDuration float64) bool {
+	return time.Now().After(c.GetExpiryTime(expiryDuration))
+}
+
+// FindPasskeyChallengeByID finds a passkey challenge by its ID.
+func FindPasskeyChallengeByID(tx *storage.Connection, id uuid.UUID) (*PasskeyChallenge, error) {
+	obj := &PasskeyChallenge{}
+	if err := tx.Find(obj, id); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, PasskeyChallengeNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding passkey challenge")
+	}
+	return obj, nil
+}
+
+// DeleteExpiredPasskeyChallenges removes the challenges older than the
+// expiry duration.
+func DeleteExpiredPasskeyChallenges(tx *storage.Connection, expiryDuration float64) error {
+	threshold := time.Now().Add(-time.Second * time.Duration(expiryDuration))
+	return tx.RawQuery("delete from "+PasskeyChallenge{}.TableName()+" where created_at < ?", threshold).Exec()
+}
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// Values accepted by the auth.role_permissions enum.
+const (
+	PermissionUsersRead         = "users:read"
+	PermissionUsersWrite        = "users:write"
+	PermissionInvitationsManage = "invitations:manage"
+	PermissionSSOManage         = "sso:manage"
+	PermissionKeysManage        = "keys:manage"
+	PermissionSMTPManage        = "smtp:manage"
+	PermissionOAuthManage       = "oauth:manage"
+	PermissionAuditRead         = "audit:read"
+	PermissionMFAManage         = "mfa:manage"
+	PermissionSettingsManage    = "settings:manage"
+)
+
+// Permissions is the registry of every permission a role can be granted.
+var Permissions = []string{
+	PermissionUsersRead,
+	PermissionUsersWrite,
+	PermissionInvitationsManage,
+	PermissionSSOManage,
+	PermissionKeysManage,
+	PermissionSMTPManage,
+	PermissionOAuthManage,
+	PermissionAuditRead,
+	PermissionMFAManage,
+	PermissionSettingsManage,
+}
+
+// DefaultRolePermissions are the permissions of the roles that have no rows
+// in auth.organization_roles_permissions.
+var DefaultRolePermissions = map[string][]string{
+	OrganizationRoleAdmin:        Permissions,
+	OrganizationRoleProjectAdmin: Permissions,
+	OrganizationRoleAPIKey: {
+		PermissionUsersRead,
+		PermissionUsersWrite,
+		PermissionInvitationsManage,
+		PermissionAuditRead,
+	},
+	OrganizationRoleClient: {},
+}
+
+// rolePermissionsTTL is how long the permissions of a role are cached before
+// they are read from the database again.
+const rolePermissionsTTL = time.Minute
+
+type OrganizationRolePermission struct {
+	ID               int64     `json:"id" db:"id"`
+	OrganizationRole string    `json:"organization_role" db:"organization_role"`
+	Permission       string    `json:"permission" db:"permissions"`
+	CreatedAt        time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (OrganizationRolePermission) TableName() string {
+	return "organization_roles_permissions"
+}
+
+type rolePermissionsEntry struct {
+	permissions []string
+	expiresAt   time.Time
+}
+
+var rolePermissionsCache = struct {
+	sync.RWMutex
+	entries map[string]rolePermissionsEntry
+}{
+	entries: make(map[string]rolePermissionsEntry),
+}
+
+// ClearRolePermissionsCache drops the cached permissions so that the next
+// lookup of every role reads the database.
+func ClearRolePermissionsCache() {
+	rolePermissionsCache.Lock()
+	defer rolePermissionsCache.Unlock()
+	rolePermissionsCache.entries = make(map[string]rolePermissionsEntry)
+}
+
+// FindPermissionsByOrganizationRole returns the permissions granted to an
+// organization role. Permissions are cached for a minute, so changes to
+// auth.organization_roles_permissions take up to that long to apply.
+func FindPermissionsByOrganizationRole(tx *storage.Connection, organizationRole string) ([]string, error) {
+	if !slices.Contains(OrganizationRoles, organizationRole) {
+		return nil, nil
+	}
+
+	now := time.Now()
+
+	rolePermissionsCache.RLock()
+	entry, ok := rolePermissionsCache.entries[organizationRole]
+	rolePermissionsCache.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.permissions, nil
+	}
+
+	rows := []*OrganizationRolePermission{}
+	if err := tx.Q().Where("organization_role = ?", organizationRole).Order("id asc").All(&rows); err != nil {
+		return nil, errors.Wrap(err, "error finding organization role permissions")
+	}
+
+	permissions := []string{}
+	for _, row := range rows {
+		// Permissions unknown to this version are ignored
+		if slices.Contains(Permissions, row.Permission) {
+			permissions = append(permissions, row.Permission)
+		}
+	}
+	if len(rows) == 0 {
+		permissions = DefaultRolePermissions[organizationRole]
+	}
+
+	rolePermissionsCache.Lock()
+	rolePermissionsCache.entries[organizationRole] = rolePermissionsEntry{
+		permissions: permissions,
+		expiresAt:   now.Add(rolePermissionsTTL),
+	}
+	rolePermissionsCache.Unlock()
+
+	return permissions, nil
+}
@@ -1,18 +1,21 @@
 package models
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/storage"
 )
 
 type Project struct {
-	ID          uuid.UUID `json:"id" db:"id"`
-	Name        string    `json:"name" db:"name"`
-	Description string    `json:"description" db:"description"`
-	RateLimits  RateLimit `json:"rate_limits" db:"rate_limits"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID          uuid.UUID          `json:"id" db:"id"`
+	Name        string             `json:"name" db:"name"`
+	Description storage.NullString `json:"description" db:"description"`
+	RateLimits  RateLimit          `json:"rate_limits" db:"rate_limits"`
+	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at" db:"updated_at"`
 }
 
 // TableName overrides the table name used by pop
@@ -20,3 +23,94 @@ func (Project) TableName() string {
 	tableName := "projects"
 	return tableName
 }
+
+// NewProject initializes a new project.
+func NewProject(name, description string, rateLimits RateLimit) (*Project, error) {
+	if name == "" {
+		return nil, errors.New("name must be provided")
+	}
+	if err := rateLimits.Validate(); err != nil {
+		return nil, err
+	}
+
+	id := uuid.Must(uuid.NewV4())
+
+	project := &Project{
+		ID:          id,
+		Name:        name,
+		Description: storage.NullString(description),
+		RateLimits:  rateLimits,
+	}
+	return project, nil
+}
+
+func findProject(tx *storage.Connection, query string, args ...interface{}) (*Project, error) {
+	obj := &Project{}
+	if err := tx.Q().Where(query, args...).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, ProjectNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding project")
+	}
+
+	return obj, nil
+}
+
+// FindProjectByID finds a project matching the provided ID.
+func FindProjectByID(tx *storage.Connection, id uuid.UUID) (*Project, error) {
+	return findProject(tx, "id = ?", id)
+}
+
+// FindProjectByName finds a project matching the provided name.
+func FindProjectByName(tx *storage.Connection, name string) (*Project, error) {
+	return findProject(tx, "name = ?", name)
+}
+
+// FindProjects finds projects whose name matches the filter.
+func FindProjects(tx *storage.Connection, pageParams *Pagination, sortParams *SortParams, filter string) ([]*Project, error) {
+	projects := []*Project{}
+	q := tx.Q()
+
+	if filter != "" {
+		q = q.Where("name ILIKE ?", "%"+filter+"%")
+	}
+
+	if sortParams != nil && len(sortParams.Fields) > 0 {
+		for _, field := range sortParams.Fields {
+			q = q.Order(field.Name + " " + string(field.Dir))
+		}
+	}
+
+	var err error
+	if pageParams != nil {
+		err = q.Paginate(int(pageParams.Page), int(pageParams.PerPage)).All(&projects) // #nosec G115
+		pageParams.Count = uint64(q.Paginator.TotalEntriesSize)                        // #nosec G115
+	} else {
+		err = q.All(&projects)
+	}
+
+	return projects, err
+}
+
+// CountUsersInProject counts the users, including soft deleted ones, that
+// belong to the project.
+func CountUsersInProject(tx *storage.Connection, project_id uuid.UUID) (int, error) {
+	count, err := tx.Q().Where("project_id = ?", project_id).Count(&User{})
+	return count, errors.Wrap(err, "error counting users in project")
+}
+
+// UpdateInfo updates the name and description of the project.
+func (p *Project) UpdateInfo(tx *storage.Connection, name, description string) error {
+	p.Name = name
+	p.Description = storage.NullString(description)
+	return tx.UpdateOnly(p, "name", "description", "updated_at")
+}
+
+// UpdateRateLimits replaces the per tier rate limits of the project.
+func (p *Project) UpdateRateLimits(tx *storage.Connection, rateLimits RateLimit) error {
+	if err := rateLimits.Validate(); err != nil {
+		return err
+	}
+	p.RateLimits = rateLimits
+	return tx.UpdateOnly(p, "rate_limits", "updated_at")
+}
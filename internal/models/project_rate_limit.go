@@ -0,0 +1,53 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// ProjectRateLimit is a request of a user of a project, counted by the
+// database rate limiter.
+type ProjectRateLimit struct {
+	ProjectID   uuid.UUID `json:"project_id" db:"project_id"`
+	UserID      string    `json:"user_id" db:"user_id"`
+	RequestTime time.Time `json:"request_time" db:"request_time"`
+}
+
+func (ProjectRateLimit) TableName() string {
+	return "project_rate_limits"
+}
+
+// AllowProjectRequest records a request of the user at the given time if the
+// user made fewer than limit requests in the window before it. Requests of
+// the same user are serialized with an advisory lock, so that concurrent
+// requests on other instances cannot exceed the limit.
+func AllowProjectRequest(tx *storage.Connection, projectID uuid.UUID, userID string, limit int, window time.Duration, at time.Time) (bool, error) {
+	tableName := ProjectRateLimit{}.TableName()
+	allowed := false
+
+	err := tx.Transaction(func(tx *storage.Connection) error {
+		if err := tx.RawQuery("select pg_advisory_xact_lock(hashtext(?))", tableName+":"+projectID.String()+":"+userID).Exec(); err != nil {
+			return err
+		}
+
+		count, err := tx.Q().Where("project_id = ? and user_id = ? and request_time > ?", projectID, userID, at.Add(-window)).Count(&ProjectRateLimit{})
+		if err != nil {
+			return err
+		}
+		if count >= limit {
+			return nil
+		}
+
+		allowed = true
+		return tx.RawQuery(fmt.Sprintf("insert into %q (project_id, user_id, request_time) values (?, ?, ?)", tableName), projectID, userID, at).Exec()
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "error recording project request")
+	}
+
+	return allowed, nil
+}
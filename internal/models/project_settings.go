@@ -0,0 +1,266 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gobwas/glob"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// ProjectSettings overrides the global signup settings for a project. Every
+// setting left NULL inherits the global configuration.
+type ProjectSettings struct {
+	ProjectID uuid.UUID `json:"project_id" db:"project_id"`
+
+	// EnabledProviders restricts sign in to the listed providers. A provider
+	// must also be enabled globally to be used.
+	EnabledProviders  *string `json:"-" db:"enabled_providers"`
+	DisableSignup     *bool   `json:"disable_signup" db:"disable_signup"`
+	MailerAutoconfirm *bool   `json:"mailer_autoconfirm" db:"mailer_autoconfirm"`
+	PhoneAutoconfirm  *bool   `json:"phone_autoconfirm" db:"phone_autoconfirm"`
+	AnonymousUsers    *bool   `json:"anonymous_users" db:"anonymous_users"`
+
+	// URIAllowList replaces the global list of redirect URLs.
+	URIAllowList *string `json:"-" db:"uri_allow_list"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (ProjectSettings) TableName() string {
+	return "project_settings"
+}
+
+// splitList returns a comma separated column as a slice, nil when NULL.
+func splitList(value *string) []string {
+	if value == nil {
+		return nil
+	}
+	if *value == "" {
+		return []string{}
+	}
+	return strings.Split(*value, ",")
+}
+
+// joinList returns a slice as a comma separated column, NULL when nil.
+func joinList(values []string) *string {
+	if values == nil {
+		return nil
+	}
+	value := strings.Join(values, ",")
+	return &value
+}
+
+// GetEnabledProviders returns the enabled providers as a slice, nil when the
+// global providers apply.
+func (s *ProjectSettings) GetEnabledProviders() []string {
+	return splitList(s.EnabledProviders)
+}
+
+// SetEnabledProviders sets the enabled providers from a slice, nil inherits
+// the global providers.
+func (s *ProjectSettings) SetEnabledProviders(providers []string) {
+	s.EnabledProviders = joinList(providers)
+}
+
+// GetURIAllowList returns the redirect URLs as a slice, nil when the global
+// list applies.
+func (s *ProjectSettings) GetURIAllowList() []string {
+	return splitList(s.URIAllowList)
+}
+
+// SetURIAllowList sets the redirect URLs from a slice, nil inherits the global
+// list.
+func (s *ProjectSettings) SetURIAllowList(uris []string) {
+	s.URIAllowList = joinList(uris)
+}
+
+// MarshalJSON returns the enabled providers and redirect URLs as lists.
+func (s *ProjectSettings) MarshalJSON() ([]byte, error) {
+	type settings ProjectSettings
+	return json.Marshal(&struct {
+		*settings
+		EnabledProviders []string `json:"enabled_providers"`
+		URIAllowList     []string `json:"uri_allow_list"`
+	}{(*settings)(s), s.GetEnabledProviders(), s.GetURIAllowList()})
+}
+
+// providerFlags returns the enabled flag of every provider a project can
+// restrict, by provider name.
+func providerFlags(external *conf.ProviderConfiguration) map[string]*bool {
+	return map[string]*bool{
+		"apple":              &external.Apple.Enabled,
+		"azure":              &external.Azure.Enabled,
+		"bitbucket":          &external.Bitbucket.Enabled,
+		"discord":            &external.Discord.Enabled,
+		"email":              &external.Email.Enabled,
+		"facebook":           &external.Facebook.Enabled,
+		"figma":              &external.Figma.Enabled,
+		"fly":                &external.Fly.Enabled,
+		"github":             &external.Github.Enabled,
+		"gitlab":             &external.Gitlab.Enabled,
+		"google":             &external.Google.Enabled,
+		"kakao":              &external.Kakao.Enabled,
+		"keycloak":           &external.Keycloak.Enabled,
+		"linkedin":           &external.Linkedin.Enabled,
+		"linkedin_oidc":      &external.LinkedinOIDC.Enabled,
+		"notion":             &external.Notion.Enabled,
+		"phone":              &external.Phone.Enabled,
+		"slack":              &external.Slack.Enabled,
+		"slack_oidc":         &external.SlackOIDC.Enabled,
+		"snapchat":           &external.Snapchat.Enabled,
+		"spotify":            &external.Spotify.Enabled,
+		"twitch":             &external.Twitch.Enabled,
+		"twitter":            &external.Twitter.Enabled,
+		"vercel_marketplace": &external.VercelMarketplace.Enabled,
+		"workos":             &external.WorkOS.Enabled,
+		"zoom":               &external.Zoom.Enabled,
+	}
+}
+
+// ProjectSettingsProviders are the providers a project can enable.
+var ProjectSettingsProviders = slices.Sorted(maps.Keys(providerFlags(&conf.ProviderConfiguration{})))
+
+// IsProviderEnabled reports whether the provider is enabled in the
+// configuration. Providers a project cannot restrict are left to their own
+// configuration and reported as enabled.
+func IsProviderEnabled(external *conf.ProviderConfiguration, name string) bool {
+	enabled, ok := providerFlags(external)[strings.ToLower(name)]
+	return !ok || *enabled
+}
+
+// Validate checks the provider names and redirect URL patterns.
+func (s *ProjectSettings) Validate() error {
+	for _, provider := range s.GetEnabledProviders() {
+		if !slices.Contains(ProjectSettingsProviders, provider) {
+			return fmt.Errorf("enabled_providers must be one of %v", ProjectSettingsProviders)
+		}
+	}
+	for _, uri := range s.GetURIAllowList() {
+		if uri == "" {
+			return fmt.Errorf("uri_allow_list cannot contain empty URLs")
+		}
+		if _, err := glob.Compile(uri, '.', '/'); err != nil {
+			return fmt.Errorf("uri_allow_list contains an invalid pattern %q: %v", uri, err)
+		}
+	}
+	return nil
+}
+
+// Apply returns a copy of the global configuration with the settings of the
+// project applied.
+func (s *ProjectSettings) Apply(global *conf.GlobalConfiguration) *conf.GlobalConfiguration {
+	config := *global
+
+	if s.DisableSignup != nil {
+		config.DisableSignup = *s.DisableSignup
+	}
+	if s.MailerAutoconfirm != nil {
+		config.Mailer.Autoconfirm = *s.MailerAutoconfirm
+	}
+	if s.PhoneAutoconfirm != nil {
+		config.Sms.Autoconfirm = *s.PhoneAutoconfirm
+	}
+	if s.AnonymousUsers != nil {
+		config.External.AnonymousUsers.Enabled = *s.AnonymousUsers
+	}
+
+	if providers := s.GetEnabledProviders(); providers != nil {
+		for name, enabled := range providerFlags(&config.External) {
+			*enabled = *enabled && slices.Contains(providers, name)
+		}
+	}
+
+	if uris := s.GetURIAllowList(); uris != nil {
+		config.URIAllowList = uris
+		config.URIAllowListMap = make(map[string]glob.Glob)
+		for _, uri := range uris {
+			if g, err := glob.Compile(uri, '.', '/'); err == nil {
+				config.URIAllowListMap[uri] = g
+			}
+		}
+	}
+
+	return &config
+}
+
+// FindProjectSettings finds the settings of a project.
+func FindProjectSettings(tx *storage.Connection, projectID uuid.UUID) (*ProjectSettings, error) {
+	obj := &ProjectSettings{}
+	if err := tx.Q().Where("project_id = ?", projectID).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, ProjectSettingsNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding project settings")
+	}
+	return obj, nil
+}
+
+// FindProjectConfiguration returns the global configuration with the
+// settings of the project applied, or the global configuration itself when
+// the project has none.
+func FindProjectConfiguration(tx *storage.Connection, projectID uuid.UUID, global *conf.GlobalConfiguration) (*conf.GlobalConfiguration, error) {
+	if projectID == uuid.Nil {
+		return global, nil
+	}
+
+	settings, err := FindProjectSettings(tx, projectID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return global, nil
+		}
+		return nil, err
+	}
+	return settings.Apply(global), nil
+}
+
+// SaveProjectSettings inserts the settings of a project or replaces the ones
+// it already has.
+func SaveProjectSettings(tx *storage.Connection, settings *ProjectSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`insert into %q (project_id, enabled_providers, disable_signup, mailer_autoconfirm, phone_autoconfirm, anonymous_users, uri_allow_list)
+values (?, ?, ?, ?, ?, ?, ?)
+on conflict (project_id) do update set
+	enabled_providers = excluded.enabled_providers,
+	disable_signup = excluded.disable_signup,
+	mailer_autoconfirm = excluded.mailer_autoconfirm,
+	phone_autoconfirm = excluded.phone_autoconfirm,
+	anonymous_users = excluded.anonymous_users,
+	uri_allow_list = excluded.uri_allow_list,
+	updated_at = now()
+returning *`, settings.TableName())
+
+	if err := tx.RawQuery(query,
+		settings.ProjectID,
+		settings.EnabledProviders,
+		settings.DisableSignup,
+		settings.MailerAutoconfirm,
+		settings.PhoneAutoconfirm,
+		settings.AnonymousUsers,
+		settings.URIAllowList,
+	).First(settings); err != nil {
+		return errors.Wrap(err, "error saving project settings")
+	}
+	return nil
+}
+
+// DeleteProjectSettings removes the settings of a project.
+func DeleteProjectSettings(tx *storage.Connection, projectID uuid.UUID) error {
+	query := fmt.Sprintf(`delete from %q where project_id = ?`, ProjectSettings{}.TableName())
+	if err := tx.RawQuery(query, projectID).Exec(); err != nil {
+		return errors.Wrap(err, "error deleting project settings")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/supabase/auth/internal/conf"
+)
+
+func TestProjectSettingsApply(t *testing.T) {
+	global := &conf.GlobalConfiguration{}
+	global.External.Email.Enabled = true
+	global.External.Github.Enabled = true
+	global.External.AnonymousUsers.Enabled = true
+	global.URIAllowList = []string{"https://global.example.com"}
+
+	// Settings left NULL inherit the global configuration
+	settings := &ProjectSettings{}
+	config := settings.Apply(global)
+	require.Equal(t, global.External, config.External)
+	require.Equal(t, global.URIAllowList, config.URIAllowList)
+
+	enabled, disabled := true, false
+	settings = &ProjectSettings{
+		DisableSignup:     &enabled,
+		MailerAutoconfirm: &enabled,
+		AnonymousUsers:    &disabled,
+	}
+	settings.SetEnabledProviders([]string{"github", "google"})
+	settings.SetURIAllowList([]string{"https://app.example.com/**"})
+
+	config = settings.Apply(global)
+	require.True(t, config.DisableSignup)
+	require.True(t, config.Mailer.Autoconfirm)
+	require.False(t, config.Sms.Autoconfirm)
+	require.False(t, config.External.AnonymousUsers.Enabled)
+	require.False(t, config.External.Email.Enabled)
+	require.True(t, config.External.Github.Enabled)
+
+	// Providers must also be enabled globally
+	require.False(t, config.External.Google.Enabled)
+	require.False(t, IsProviderEnabled(&config.External, "google"))
+	require.True(t, IsProviderEnabled(&config.External, "GitHub"))
+
+	require.Equal(t, []string{"https://app.example.com/**"}, config.URIAllowList)
+	require.Contains(t, config.URIAllowListMap, "https://app.example.com/**")
+
+	// The global configuration is left untouched
+	require.True(t, global.External.Email.Enabled)
+	require.False(t, global.DisableSignup)
+	require.Equal(t, []string{"https://global.example.com"}, global.URIAllowList)
+}
+
+func TestProjectSettingsValidate(t *testing.T) {
+	settings := &ProjectSettings{}
+	require.NoError(t, settings.Validate())
+
+	settings.SetEnabledProviders([]string{})
+	require.NoError(t, settings.Validate())
+
+	settings.SetEnabledProviders([]string{"email", "myspace"})
+	require.Error(t, settings.Validate())
+
+	settings.SetEnabledProviders([]string{"email"})
+	settings.SetURIAllowList([]string{"https://[example.com"})
+	require.Error(t, settings.Validate())
+}
@@ -0,0 +1,172 @@
+package models
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// Sources of an effective tier, from the most to the least specific.
+const (
+	TierSourceOrganization = "organization"
+	TierSourceProject      = "project"
+	TierSourceDefault      = "default"
+)
+
+// ProjectTier is a tier offered by a project. The default tier of a project
+// applies to its users whose organization has no tier, including users that
+// do not belong to an organization.
+type ProjectTier struct {
+	ID        int64     `json:"-" db:"id"`
+	ProjectID uuid.UUID `json:"project_id" db:"project_id"`
+	Tier      string    `json:"tier" db:"tier"`
+	TierModel string    `json:"tier_model" db:"tier_model"`
+	TierTime  string    `json:"tier_time" db:"tier_time"`
+	TierUsage string    `json:"tier_usage" db:"tier_usage"`
+	IsDefault bool      `json:"is_default" db:"is_default"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (ProjectTier) TableName() string {
+	return "projects_tiers"
+}
+
+// Validate checks that the tier values are accepted by the database enums.
+func (t *ProjectTier) Validate() error {
+	if t.Tier == "" {
+		return fmt.Errorf("tier is required")
+	}
+	if !slices.Contains(TierModels, t.TierModel) {
+		return fmt.Errorf("tier_model must be one of %v", TierModels)
+	}
+	if !slices.Contains(TierTimes, t.TierTime) {
+		return fmt.Errorf("tier_time must be one of %v", TierTimes)
+	}
+	if !slices.Contains(TierUsages, t.TierUsage) {
+		return fmt.Errorf("tier_usage must be one of %v", TierUsages)
+	}
+	return nil
+}
+
+// FindProjectTiers returns the tiers offered by a project.
+func FindProjectTiers(tx *storage.Connection, projectID uuid.UUID) ([]*ProjectTier, error) {
+	tiers := []*ProjectTier{}
+	if err := tx.Q().Where("project_id = ?", projectID).Order("id asc").All(&tiers); err != nil {
+		return nil, errors.Wrap(err, "error finding project tiers")
+	}
+	return tiers, nil
+}
+
+// FindProjectTier finds a tier of a project by its name.
+func FindProjectTier(tx *storage.Connection, projectID uuid.UUID, tier string) (*ProjectTier, error) {
+	obj := &ProjectTier{}
+	if err := tx.Q().Where("project_id = ? and tier = ?", projectID, tier).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, ProjectTierNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding project tier")
+	}
+	return obj, nil
+}
+
+// FindDefaultProjectTier returns the default tier of a project, or nil if the
+// project has none.
+func FindDefaultProjectTier(tx *storage.Connection, projectID uuid.UUID) (*ProjectTier, error) {
+	obj := &ProjectTier{}
+	if err := tx.Q().Where("project_id = ? and is_default", projectID).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "error finding default project tier")
+	}
+	return obj, nil
+}
+
+// SaveProjectTier creates or updates a tier of a project. A tier saved as the
+// default replaces the previous default tier of the project.
+func SaveProjectTier(tx *storage.Connection, tier *ProjectTier) error {
+	if err := tier.Validate(); err != nil {
+		return err
+	}
+
+	if tier.IsDefault {
+		if err := tx.RawQuery(fmt.Sprintf(`update %q set is_default = false where project_id = ? and tier <> ? and is_default`, tier.TableName()), tier.ProjectID, tier.Tier).Exec(); err != nil {
+			return errors.Wrap(err, "error saving project tier")
+		}
+	}
+
+	if err := tx.Save(tier); err != nil {
+		return errors.Wrap(err, "error saving project tier")
+	}
+	return nil
+}
+
+// EffectiveTier is the tier that applies to a caller and where it came from.
+type EffectiveTier struct {
+	Tier      string `json:"tier"`
+	TierModel string `json:"tier_model"`
+	TierTime  string `json:"tier_time"`
+	TierUsage string `json:"tier_usage"`
+	Source    string `json:"source"`
+}
+
+// FindEffectiveTier resolves the tier of a caller: the tier of the
+// organization, with the admin or client values depending on the role, then
+// the default tier of the project, then DefaultTier.
+func FindEffectiveTier(tx *storage.Connection, organizationID, projectID uuid.UUID, organizationRole string) (*EffectiveTier, error) {
+	if organizationID != uuid.Nil {
+		organizationTier, err := FindOrganizationTierByOrganizationID(tx, organizationID)
+		if err != nil {
+			return nil, err
+		}
+		if organizationTier != nil {
+			tier := &EffectiveTier{
+				Tier:   organizationTier.Tier,
+				Source: TierSourceOrganization,
+			}
+			if organizationRole == OrganizationRoleAdmin || organizationRole == OrganizationRoleProjectAdmin {
+				tier.TierModel = organizationTier.AdminTierModel
+				tier.TierTime = organizationTier.AdminTierTime
+				tier.TierUsage = organizationTier.AdminTierUsage
+			} else {
+				tier.TierModel = organizationTier.ClientTierModel
+				tier.TierTime = organizationTier.ClientTierTime
+				tier.TierUsage = organizationTier.ClientTierUsage
+			}
+			if tier.Tier == "" {
+				tier.Tier = DefaultTier
+			}
+			return tier, nil
+		}
+	}
+
+	if projectID != uuid.Nil {
+		projectTier, err := FindDefaultProjectTier(tx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		if projectTier != nil {
+			return &EffectiveTier{
+				Tier:      projectTier.Tier,
+				TierModel: projectTier.TierModel,
+				TierTime:  projectTier.TierTime,
+				TierUsage: projectTier.TierUsage,
+				Source:    TierSourceProject,
+			}, nil
+		}
+	}
+
+	return &EffectiveTier{
+		Tier:      DefaultTier,
+		TierModel: "free",
+		TierTime:  "free",
+		TierUsage: "free",
+		Source:    TierSourceDefault,
+	}, nil
+}
@@ -1,5 +1,12 @@
 package models
 
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
 type RateLimitValue struct {
 	Tier  string `json:"tier" db:"tier"`
 	Limit int    `json:"limit" db:"limit"`
@@ -10,3 +17,73 @@ type RateLimit struct {
 
 	RateLimits []RateLimitValue `json:"rate_limits" db:"rate_limits"`
 }
+
+// Validate checks that the rate limit window is positive and that every tier
+// is limited at most once.
+func (r *RateLimit) Validate() error {
+	if len(r.RateLimits) == 0 {
+		if r.Seconds < 0 {
+			return fmt.Errorf("seconds must not be negative")
+		}
+		return nil
+	}
+
+	if r.Seconds <= 0 {
+		return fmt.Errorf("seconds must be greater than 0")
+	}
+
+	tiers := make(map[string]bool, len(r.RateLimits))
+	for _, value := range r.RateLimits {
+		if value.Tier == "" {
+			return fmt.Errorf("tier is required for every rate limit")
+		}
+		if value.Limit < 0 {
+			return fmt.Errorf("limit for tier %q must not be negative", value.Tier)
+		}
+		if tiers[value.Tier] {
+			return fmt.Errorf("tier %q has more than one rate limit", value.Tier)
+		}
+		tiers[value.Tier] = true
+	}
+
+	return nil
+}
+
+// ForTier returns the limit configured for the tier, if any.
+func (r *RateLimit) ForTier(tier string) (RateLimitValue, bool) {
+	for _, value := range r.RateLimits {
+		if value.Tier == tier {
+			return value, true
+		}
+	}
+	return RateLimitValue{}, false
+}
+
+func (r *RateLimit) Scan(src interface{}) error {
+	var source []byte
+	switch v := src.(type) {
+	case string:
+		source = []byte(v)
+	case []byte:
+		source = v
+	case nil:
+		*r = RateLimit{}
+		return nil
+	default:
+		return errors.New("invalid data type for RateLimit")
+	}
+
+	if len(source) == 0 {
+		*r = RateLimit{}
+		return nil
+	}
+	return json.Unmarshal(source, r)
+}
+
+func (r RateLimit) Value() (driver.Value, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
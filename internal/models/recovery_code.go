@@ -0,0 +1,117 @@
+package models
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/crypto"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// recoveryCodeLength is the number of characters of a recovery code, shown
+// in two groups of five.
+const recoveryCodeLength = 10
+
+// MFARecoveryCode is a single use code of a recovery code factor. Only the
+// hash of the code is stored.
+type MFARecoveryCode struct {
+	ID        uuid.UUID  `json:"-" db:"id"`
+	FactorID  uuid.UUID  `json:"-" db:"factor_id"`
+	CodeHash  string     `json:"-" db:"code_hash"`
+	UsedAt    *time.Time `json:"-" db:"used_at"`
+	CreatedAt time.Time  `json:"-" db:"created_at"`
+}
+
+func (MFARecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
+
+// hashRecoveryCode hashes a recovery code, ignoring its case and the
+// separators users type it with.
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(code)))
+}
+
+// GenerateRecoveryCodes replaces the recovery codes of the factor with count
+// new ones and returns them, they cannot be read back afterwards.
+func GenerateRecoveryCodes(tx *storage.Connection, factor *Factor, count int) ([]string, error) {
+	if err := DeleteRecoveryCodes(tx, factor); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		code := crypto.SecureAlphanumeric(recoveryCodeLength)
+		code = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+
+		if err := tx.Create(&MFARecoveryCode{
+			ID:       uuid.Must(uuid.NewV4()),
+			FactorID: factor.ID,
+			CodeHash: hashRecoveryCode(code),
+		}); err != nil {
+			return nil, errors.Wrap(err, "error creating recovery code")
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+// FindUnusedRecoveryCode finds the recovery code of the factor matching code,
+// unless it was already used.
+func FindUnusedRecoveryCode(tx *storage.Connection, factor *Factor, code string) (*MFARecoveryCode, error) {
+	obj := &MFARecoveryCode{}
+	if err := tx.Q().Where("factor_id = ? and code_hash = ? and used_at is null", factor.ID, hashRecoveryCode(code)).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, RecoveryCodeNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding recovery code")
+	}
+	return obj, nil
+}
+
+// CountUnusedRecoveryCodes counts the recovery codes of the factor left to
+// use.
+func CountUnusedRecoveryCodes(tx *storage.Connection, factor *Factor) (int, error) {
+	count, err := tx.Q().Where("factor_id = ? and used_at is null", factor.ID).Count(&MFARecoveryCode{})
+	return count, errors.Wrap(err, "error counting recovery codes")
+}
+
+// DeleteRecoveryCodes removes the recovery codes of the factor.
+func DeleteRecoveryCodes(tx *storage.Connection, factor *Factor) error {
+	return tx.RawQuery("DELETE FROM "+(&pop.Model{Value: MFARecoveryCode{}}).TableName()+" WHERE factor_id = ?", factor.ID).Exec()
+}
+
+// Use marks the recovery code as used. Only one of several requests racing
+// to use the same code succeeds, the others get a RecoveryCodeNotFoundError.
+func (c *MFARecoveryCode) Use(tx *storage.Connection) error {
+	now := time.Now()
+	count, err := tx.RawQuery("UPDATE "+(&pop.Model{Value: MFARecoveryCode{}}).TableName()+" SET used_at = ? WHERE id = ? AND used_at IS NULL", now, c.ID).ExecWithCount()
+	if err != nil {
+		return errors.Wrap(err, "error using recovery code")
+	}
+	if count == 0 {
+		return RecoveryCodeNotFoundError{}
+	}
+	c.UsedAt = &now
+	return nil
+}
+
+// FindRecoveryCodeFactor finds the factor holding the recovery codes of the
+// user.
+func FindRecoveryCodeFactor(tx *storage.Connection, user *User) (*Factor, error) {
+	factor := &Factor{}
+	if err := tx.Q().Where("user_id = ? and factor_type = ?", user.ID, RecoveryCode).First(factor); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, FactorNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding recovery code factor")
+	}
+	return factor, nil
+}
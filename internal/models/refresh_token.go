@@ -51,6 +51,10 @@ type GrantParams struct {
 
 	UserAgent string
 	IP        string
+
+	// AAL2 starts the session at AAL2, for sign-ins with a phishing
+	// resistant credential.
+	AAL2 bool
 }
 
 func (g *GrantParams) FillGrantParams(r *http.Request) {
@@ -123,6 +127,10 @@ func FindTokenBySessionID(tx *storage.Connection, sessionId *uuid.UUID) (*Refres
 func (s *Session) ApplyGrantParams(params *GrantParams) {
 	s.FactorID = params.FactorID
 
+	if params.AAL2 {
+		s.AAL = AAL2.PointerString()
+	}
+
 	if params.SessionNotAfter != nil {
 		s.NotAfter = params.SessionNotAfter
 	}
@@ -98,6 +98,10 @@ type Session struct {
 
 	RefreshTokenHmacKey *string `json:"-" db:"refresh_token_hmac_key"`
 	RefreshTokenCounter *int64  `json:"-" db:"refresh_token_counter"`
+
+	// ActiveOrganizationID is the organization chosen by the user for this
+	// session. When nil the user's own organization is used.
+	ActiveOrganizationID *uuid.UUID `json:"active_organization_id,omitempty" db:"active_organization_id"`
 }
 
 func (Session) TableName() string {
@@ -294,6 +298,18 @@ func FindSessionByUserID(tx *storage.Connection, userId uuid.UUID) (*Session, er
 	return session, nil
 }
 
+// FindOwnedSessionByID finds a session of a user by its ID.
+func FindOwnedSessionByID(tx *storage.Connection, user *User, id uuid.UUID) (*Session, error) {
+	session := &Session{}
+	if err := tx.Q().Where("user_id = ? and id = ?", user.ID, id).First(session); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, SessionNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding session")
+	}
+	return session, nil
+}
+
 func FindSessionsByFactorID(tx *storage.Connection, factorID uuid.UUID) ([]*Session, error) {
 	sessions := []*Session{}
 	if err := tx.Q().Where("factor_id = ?", factorID).All(&sessions); err != nil {
@@ -359,6 +375,34 @@ func RevokeOAuthSessions(tx *storage.Connection, userID uuid.UUID, oauthClientID
 	return tx.RawQuery("DELETE FROM "+(&pop.Model{Value: Session{}}).TableName()+" WHERE user_id = ? AND oauth_client_id = ?", userID, oauthClientID).Exec()
 }
 
+// SetActiveOrganization changes the organization used for the access tokens
+// of this session.
+func (s *Session) SetActiveOrganization(tx *storage.Connection, organizationID uuid.UUID) error {
+	s.ActiveOrganizationID = &organizationID
+	return tx.UpdateOnly(s, "active_organization_id", "updated_at")
+}
+
+// ActiveOrganization returns the organization and organization role the
+// session acts with. The active organization of the session takes precedence
+// over the user's own organization as long as the user is still a member of
+// it.
+func (s *Session) ActiveOrganization(tx *storage.Connection, user *User) (uuid.UUID, string, error) {
+	organizationID := user.OrganizationID.UUID
+	organizationRole := user.OrganizationRole
+
+	if s.ActiveOrganizationID != nil && *s.ActiveOrganizationID != organizationID {
+		member, err := user.FindOrganizationMembership(tx, *s.ActiveOrganizationID)
+		if err != nil && !IsNotFoundError(err) {
+			return uuid.Nil, "", err
+		}
+		if member != nil {
+			organizationID = member.OrganizationID
+			organizationRole = member.Role
+		}
+	}
+	return organizationID, organizationRole, nil
+}
+
 func (s *Session) UpdateAALAndAssociatedFactor(tx *storage.Connection, aal AuthenticatorAssuranceLevel, factorID *uuid.UUID) error {
 	s.FactorID = factorID
 	aalAsString := aal.String()
@@ -371,6 +415,9 @@ func (s *Session) CalculateAALAndAMR(user *User) (aal AuthenticatorAssuranceLeve
 	for _, claim := range s.AMRClaims {
 		if claim.IsAAL2Claim() {
 			aal = AAL2
+		} else if claim.GetAuthenticationMethod() == PasskeySignIn.String() && s.IsAAL2() {
+			// Passkey sign-ins configured as phishing resistant start at AAL2
+			aal = AAL2
 		}
 		entry := AMREntry{Method: claim.GetAuthenticationMethod(), Timestamp: claim.UpdatedAt.Unix()}
 		if entry.Method == SSOSAML.String() {
@@ -117,6 +117,25 @@ func (ts *SessionsTestSuite) TestCalculateAALAndAMR() {
 	require.True(ts.T(), found)
 }
 
+func TestCalculateAALAndAMRPasskey(t *testing.T) {
+	method := PasskeySignIn.String()
+	session := &Session{
+		AMRClaims: []AMRClaim{{AuthenticationMethod: &method}},
+	}
+
+	aal, amr, err := session.CalculateAALAndAMR(&User{})
+	require.NoError(t, err)
+	require.Equal(t, AAL1, aal)
+	require.Len(t, amr, 1)
+	require.Equal(t, "passkey", amr[0].Method)
+
+	// Passkey sign-ins issued as phishing resistant stay at AAL2
+	session.AAL = AAL2.PointerString()
+	aal, _, err = session.CalculateAALAndAMR(&User{})
+	require.NoError(t, err)
+	require.Equal(t, AAL2, aal)
+}
+
 func pointerDuration(value time.Duration) *time.Duration {
 	return &value
 }
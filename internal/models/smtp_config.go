@@ -0,0 +1,165 @@
+package models
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/crypto"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// SMTPSettings is the SMTP server an organization or project sends its mail
+// through instead of the global one. The password is encrypted with the
+// database encryption keys when encryption is enabled.
+type SMTPSettings struct {
+	Host       string             `json:"host" db:"host"`
+	Port       int                `json:"port" db:"port"`
+	User       storage.NullString `json:"user" db:"username"`
+	Pass       storage.NullString `json:"-" db:"password"`
+	AdminEmail string             `json:"admin_email" db:"admin_email"`
+	SenderName storage.NullString `json:"sender_name" db:"sender_name"`
+	Domain     storage.NullString `json:"domain" db:"domain"`
+}
+
+// Validate checks that the settings describe a server mail can be sent
+// through. When a domain is set the sender address must belong to it.
+func (s *SMTPSettings) Validate() error {
+	if s.Host == "" {
+		return fmt.Errorf("host is required")
+	}
+	if s.Port <= 0 || s.Port > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535")
+	}
+	if s.AdminEmail == "" {
+		return fmt.Errorf("admin_email is required")
+	}
+	if domain := s.Domain.String(); domain != "" && !strings.HasSuffix(strings.ToLower(s.AdminEmail), "@"+strings.ToLower(domain)) {
+		return fmt.Errorf("admin_email must belong to the domain %s", domain)
+	}
+	return nil
+}
+
+// SetPassword stores the SMTP password, encrypted when encryption is enabled.
+// The id binds the encrypted password to its organization or project.
+func (s *SMTPSettings) SetPassword(id, password string, dbEncryption conf.DatabaseEncryptionConfiguration) error {
+	s.Pass = storage.NullString(password)
+	if password != "" && dbEncryption.Encrypt {
+		es, err := crypto.NewEncryptedString(id, []byte(password), dbEncryption.EncryptionKeyID, dbEncryption.EncryptionKey)
+		if err != nil {
+			return err
+		}
+		s.Pass = storage.NullString(es.String())
+	}
+	return nil
+}
+
+// Configuration returns the settings as a SMTP configuration. Everything but
+// the server, credentials and sender comes from the global configuration.
+func (s *SMTPSettings) Configuration(id string, global conf.SMTPConfiguration, dbEncryption conf.DatabaseEncryptionConfiguration) (*conf.SMTPConfiguration, error) {
+	password := s.Pass.String()
+	if es := crypto.ParseEncryptedString(password); es != nil {
+		bytes, err := es.Decrypt(id, dbEncryption.DecryptionKeys)
+		if err != nil {
+			return nil, err
+		}
+		password = string(bytes)
+	}
+
+	config := &conf.SMTPConfiguration{
+		MaxFrequency:   global.MaxFrequency,
+		Host:           s.Host,
+		Port:           s.Port,
+		User:           s.User.String(),
+		Pass:           password,
+		AdminEmail:     s.AdminEmail,
+		SenderName:     s.SenderName.String(),
+		Headers:        global.Headers,
+		LoggingEnabled: global.LoggingEnabled,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// OrganizationSMTPConfig is the SMTP server of an organization.
+type OrganizationSMTPConfig struct {
+	ID             int64     `json:"-" db:"id"`
+	OrganizationID uuid.UUID `json:"organization_id" db:"organization_id"`
+	SMTPSettings
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (OrganizationSMTPConfig) TableName() string {
+	return "smtp_configs_organizations"
+}
+
+// ProjectSMTPConfig is the SMTP server of a project, used for users whose
+// organization has none.
+type ProjectSMTPConfig struct {
+	ID        int64     `json:"-" db:"id"`
+	ProjectID uuid.UUID `json:"project_id" db:"project_id"`
+	SMTPSettings
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (ProjectSMTPConfig) TableName() string {
+	return "smtp_configs_projects"
+}
+
+// FindOrganizationSMTPConfig finds the SMTP configuration of an organization.
+func FindOrganizationSMTPConfig(tx *storage.Connection, organizationID uuid.UUID) (*OrganizationSMTPConfig, error) {
+	obj := &OrganizationSMTPConfig{}
+	if err := tx.Q().Where("organization_id = ?", organizationID).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, SMTPConfigNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding organization SMTP config")
+	}
+	return obj, nil
+}
+
+// FindProjectSMTPConfig finds the SMTP configuration of a project.
+func FindProjectSMTPConfig(tx *storage.Connection, projectID uuid.UUID) (*ProjectSMTPConfig, error) {
+	obj := &ProjectSMTPConfig{}
+	if err := tx.Q().Where("project_id = ?", projectID).First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, SMTPConfigNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "error finding project SMTP config")
+	}
+	return obj, nil
+}
+
+// FindSMTPConfiguration returns the SMTP configuration mail to a user of the
+// organization and project is sent through: the one of the organization,
+// then the one of the project. It returns nil when neither has one, in which
+// case the global configuration applies.
+func FindSMTPConfiguration(tx *storage.Connection, organizationID, projectID uuid.UUID, global conf.SMTPConfiguration, dbEncryption conf.DatabaseEncryptionConfiguration) (*conf.SMTPConfiguration, error) {
+	if organizationID != uuid.Nil {
+		config, err := FindOrganizationSMTPConfig(tx, organizationID)
+		if err == nil {
+			return config.Configuration(organizationID.String(), global, dbEncryption)
+		} else if !IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	if projectID != uuid.Nil {
+		config, err := FindProjectSMTPConfig(tx, projectID)
+		if err == nil {
+			return config.Configuration(projectID.String(), global, dbEncryption)
+		} else if !IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/crypto"
+	"github.com/supabase/auth/internal/storage"
+)
+
+func TestSMTPSettingsValidate(t *testing.T) {
+	cases := []struct {
+		desc     string
+		settings SMTPSettings
+		err      string
+	}{
+		{
+			desc:     "Valid",
+			settings: SMTPSettings{Host: "smtp.example.com", Port: 587, AdminEmail: "noreply@example.com", Domain: "example.com"},
+		},
+		{
+			desc:     "Missing host",
+			settings: SMTPSettings{Port: 587, AdminEmail: "noreply@example.com"},
+			err:      "host is required",
+		},
+		{
+			desc:     "Invalid port",
+			settings: SMTPSettings{Host: "smtp.example.com", Port: 70000, AdminEmail: "noreply@example.com"},
+			err:      "port must be between 1 and 65535",
+		},
+		{
+			desc:     "Sender outside of the domain",
+			settings: SMTPSettings{Host: "smtp.example.com", Port: 587, AdminEmail: "noreply@other.com", Domain: "example.com"},
+			err:      "admin_email must belong to the domain example.com",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.settings.Validate()
+			if c.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, c.err)
+			}
+		})
+	}
+}
+
+func TestSMTPSettingsConfiguration(t *testing.T) {
+	dbEncryption := conf.DatabaseEncryptionConfiguration{
+		Encrypt:         true,
+		EncryptionKeyID: "key-id",
+		EncryptionKey:   "pwFoiPyybQMqNmYVN0gUnpbfpGQV2sDv9vp0ZAxi_Y4",
+		DecryptionKeys: map[string]string{
+			"key-id": "pwFoiPyybQMqNmYVN0gUnpbfpGQV2sDv9vp0ZAxi_Y4",
+		},
+	}
+	global := conf.SMTPConfiguration{LoggingEnabled: true}
+
+	settings := SMTPSettings{
+		Host:       "smtp.example.com",
+		Port:       465,
+		User:       storage.NullString("mailer"),
+		AdminEmail: "noreply@example.com",
+		SenderName: storage.NullString("Example"),
+	}
+	require.NoError(t, settings.SetPassword("tenant-id", "secret", dbEncryption))
+	assert.NotEqual(t, "secret", settings.Pass.String())
+	assert.NotNil(t, crypto.ParseEncryptedString(settings.Pass.String()))
+
+	config, err := settings.Configuration("tenant-id", global, dbEncryption)
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com", config.Host)
+	assert.Equal(t, 465, config.Port)
+	assert.Equal(t, "mailer", config.User)
+	assert.Equal(t, "secret", config.Pass)
+	assert.Equal(t, `"Example" <noreply@example.com>`, config.FromAddress())
+	assert.True(t, config.LoggingEnabled)
+
+	// The password is bound to its tenant
+	_, err = settings.Configuration("other-id", global, dbEncryption)
+	assert.Error(t, err)
+
+	// Passwords stored without encryption are used as is
+	require.NoError(t, settings.SetPassword("tenant-id", "plain", conf.DatabaseEncryptionConfiguration{}))
+	config, err = settings.Configuration("tenant-id", global, dbEncryption)
+	require.NoError(t, err)
+	assert.Equal(t, "plain", config.Pass)
+}
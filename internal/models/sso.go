@@ -11,17 +11,23 @@ import (
 
 	"github.com/crewjam/saml"
 	"github.com/crewjam/saml/samlsp"
+	"github.com/gobuffalo/pop/v6"
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"github.com/supabase/auth/internal/storage"
 )
 
+// SSOProvider is an identity provider owned by an organization. Users signing
+// in through it are created in, or linked within, the organization and project
+// of the provider.
 type SSOProvider struct {
-	ID           uuid.UUID    `db:"id" json:"id"`
-	ResourceID   *string      `db:"resource_id" json:"resource_id,omitempty"`
-	Disabled     *bool        `db:"disabled" json:"disabled"`
-	SAMLProvider SAMLProvider `has_one:"saml_providers" fk_id:"sso_provider_id" json:"saml,omitempty"`
-	SSODomains   []SSODomain  `has_many:"sso_domains" fk_id:"sso_provider_id" json:"domains"`
+	ID             uuid.UUID    `db:"id" json:"id"`
+	OrganizationID uuid.UUID    `db:"organization_id" json:"organization_id"`
+	ProjectID      uuid.UUID    `db:"project_id" json:"project_id"`
+	ResourceID     *string      `db:"resource_id" json:"resource_id,omitempty"`
+	Disabled       *bool        `db:"disabled" json:"disabled"`
+	SAMLProvider   SAMLProvider `has_one:"saml_providers" fk_id:"sso_provider_id" json:"saml,omitempty"`
+	SSODomains     []SSODomain  `has_many:"sso_domains" fk_id:"sso_provider_id" json:"domains"`
 
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
@@ -39,6 +45,15 @@ func (p SSOProvider) Type() string {
 	return "saml"
 }
 
+// BeforeCreate copies the project of the provider into its domains, which
+// are created together with it.
+func (p *SSOProvider) BeforeCreate(tx *pop.Connection) error {
+	for i := range p.SSODomains {
+		p.SSODomains[i].ProjectID = p.ProjectID
+	}
+	return nil
+}
+
 type SAMLAttribute struct {
 	Name    string      `json:"name,omitempty"`
 	Names   []string    `json:"names,omitempty"`
@@ -147,6 +162,10 @@ type SSODomain struct {
 	SSOProvider   *SSOProvider `belongs_to:"sso_providers" json:"-"`
 	SSOProviderID uuid.UUID    `db:"sso_provider_id" json:"-"`
 
+	// ProjectID is copied from the provider so that a domain is unique
+	// within a project.
+	ProjectID uuid.UUID `db:"project_id" json:"-"`
+
 	Domain string `db:"domain" json:"domain"`
 
 	CreatedAt time.Time `db:"created_at" json:"-"`
@@ -208,10 +227,10 @@ func FindSSOProviderByID(tx *storage.Connection, id uuid.UUID) (*SSOProvider, er
 	return &ssoProvider, nil
 }
 
-func FindSSOProviderByResourceID(tx *storage.Connection, id string) (*SSOProvider, error) {
+func FindSSOProviderByResourceID(tx *storage.Connection, id string, projectID uuid.UUID) (*SSOProvider, error) {
 	var ssoProvider SSOProvider
 
-	if err := tx.Eager().Q().Where("resource_id = ?", id).First(&ssoProvider); err != nil {
+	if err := tx.Eager().Q().Where("resource_id = ? and project_id = ?", id, projectID).First(&ssoProvider); err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
 			return nil, SSOProviderNotFoundError{}
 		}
@@ -222,17 +241,19 @@ func FindSSOProviderByResourceID(tx *storage.Connection, id string) (*SSOProvide
 	return &ssoProvider, nil
 }
 
-func FindSSOProviderForEmailAddress(tx *storage.Connection, emailAddress string) (*SSOProvider, error) {
+func FindSSOProviderForEmailAddress(tx *storage.Connection, emailAddress string, projectID uuid.UUID) (*SSOProvider, error) {
 	parts := strings.Split(emailAddress, "@")
 	emailDomain := strings.ToLower(parts[1])
 
-	return FindSSOProviderByDomain(tx, emailDomain)
+	return FindSSOProviderByDomain(tx, emailDomain, projectID)
 }
 
-func FindSSOProviderByDomain(tx *storage.Connection, domain string) (*SSOProvider, error) {
+// FindSSOProviderByDomain finds the SSO provider a domain is assigned to in
+// the project. The same domain may be assigned in several projects.
+func FindSSOProviderByDomain(tx *storage.Connection, domain string, projectID uuid.UUID) (*SSOProvider, error) {
 	var ssoDomain SSODomain
 
-	if err := tx.Q().Where("domain = ?", domain).First(&ssoDomain); err != nil {
+	if err := tx.Q().Where("lower(domain) = ? and project_id = ?", strings.ToLower(domain), projectID).First(&ssoDomain); err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
 			return nil, SSOProviderNotFoundError{}
 		}
@@ -272,13 +293,22 @@ const (
 )
 
 // FindAllSSOProvidersByFilter finds SSO Providers with the matching filter.
+// The organization and project are only used when not nil.
 func FindAllSSOProvidersByFilter(
 	tx *storage.Connection,
 	queryValues url.Values,
+	organizationID uuid.UUID,
+	projectID uuid.UUID,
 ) ([]*SSOProvider, error) {
 	ssoProviders := []*SSOProvider{}
 
 	q := tx.Eager().Q()
+	if organizationID != uuid.Nil {
+		q = q.Where("organization_id = ?", organizationID)
+	}
+	if projectID != uuid.Nil {
+		q = q.Where("project_id = ?", projectID)
+	}
 	if v := queryValues.Get(resourceIDFilter); v != "" {
 		q = q.Where("resource_id = ?", v)
 	} else if v := queryValues.Get(resourceIDPrefixFilter); v != "" {
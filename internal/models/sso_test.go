@@ -1,11 +1,11 @@
 package models
 
 import (
+	"context"
 	"net/url"
 	"slices"
 	"testing"
 
-	"github.com/gobuffalo/pop/v6"
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -17,30 +17,34 @@ import (
 type SSOTestSuite struct {
 	suite.Suite
 
-	db     *storage.Connection
-	config *conf.GlobalConfiguration
+	db             *storage.Connection
+	config         *conf.GlobalConfiguration
+	ProjectID      uuid.UUID
+	OrganizationID uuid.UUID
 }
 
 func (ts *SSOTestSuite) SetupTest() {
-	// Connect as the postgres superuser to truncate tables
-	superuserURL := ts.config.DB.URL
-	if u, err := url.Parse(ts.config.DB.URL); err == nil {
-		u.User = url.UserPassword("postgres", "root")
-		superuserURL = u.String()
-	}
-
-	superuserDeets := &pop.ConnectionDetails{
-		Dialect: ts.config.DB.Driver,
-		URL:     superuserURL,
-	}
+	ts.ProjectID, ts.OrganizationID, _ = InitializeTestDatabase(ts.T(), ts.db, ts.config)
+}
 
-	superuserDB, err := pop.NewConnection(superuserDeets)
-	require.NoError(ts.T(), err, "Should be able to connect as postgres superuser")
-	require.NoError(ts.T(), superuserDB.Open())
-	defer superuserDB.Close()
+// otherTenant creates an organization in a new project.
+func (ts *SSOTestSuite) otherTenant() (uuid.UUID, uuid.UUID) {
+	project, err := NewProject("other", "", RateLimit{})
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.db.Create(project))
+
+	owner, err := NewUser("", "owner@example.com", "test", ts.config.JWT.Aud, nil, uuid.Nil, project.ID)
+	require.NoError(ts.T(), err)
+	require.NoError(ts.T(), ts.db.Create(owner, "organization_id", "organization_role"))
+
+	organization, err := NewOrganization(project.ID, owner.ID, "other", "")
+	require.NoError(ts.T(), err)
+	db := ts.db.WithContext(storage.WithSessionVariables(context.Background(), storage.BypassRLS))
+	require.NoError(ts.T(), db.Transaction(func(tx *storage.Connection) error {
+		return tx.Create(organization)
+	}))
 
-	setup_db := &storage.Connection{Connection: superuserDB}
-	TruncateAll(setup_db)
+	return project.ID, organization.ID
 }
 
 func TestSSO(t *testing.T) {
@@ -67,6 +71,8 @@ func (ts *SSOTestSuite) TestConstraints() {
 	examples := []exampleSpec{
 		{
 			Provider: &SSOProvider{
+				OrganizationID: ts.OrganizationID,
+				ProjectID:      ts.ProjectID,
 				SAMLProvider: SAMLProvider{
 					EntityID:    "",
 					MetadataXML: "<example />",
@@ -75,6 +81,8 @@ func (ts *SSOTestSuite) TestConstraints() {
 		},
 		{
 			Provider: &SSOProvider{
+				OrganizationID: ts.OrganizationID,
+				ProjectID:      ts.ProjectID,
 				SAMLProvider: SAMLProvider{
 					EntityID:    "https://example.com/saml/metadata",
 					MetadataXML: "",
@@ -83,6 +91,8 @@ func (ts *SSOTestSuite) TestConstraints() {
 		},
 		{
 			Provider: &SSOProvider{
+				OrganizationID: ts.OrganizationID,
+				ProjectID:      ts.ProjectID,
 				SAMLProvider: SAMLProvider{
 					EntityID:    "https://example.com/saml/metadata",
 					MetadataXML: "<example />",
@@ -103,6 +113,8 @@ func (ts *SSOTestSuite) TestConstraints() {
 
 func (ts *SSOTestSuite) TestDomainUniqueness() {
 	require.NoError(ts.T(), ts.db.Eager().Create(&SSOProvider{
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
 		SAMLProvider: SAMLProvider{
 			EntityID:    "https://example.com/saml/metadata1",
 			MetadataXML: "<example />",
@@ -115,6 +127,8 @@ func (ts *SSOTestSuite) TestDomainUniqueness() {
 	}))
 
 	require.Error(ts.T(), ts.db.Eager().Create(&SSOProvider{
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
 		SAMLProvider: SAMLProvider{
 			EntityID:    "https://example.com/saml/metadata2",
 			MetadataXML: "<example />",
@@ -125,10 +139,33 @@ func (ts *SSOTestSuite) TestDomainUniqueness() {
 			},
 		},
 	}))
+
+	// the same domain can be assigned in another project
+	projectID, organizationID := ts.otherTenant()
+	provider := &SSOProvider{
+		OrganizationID: organizationID,
+		ProjectID:      projectID,
+		SAMLProvider: SAMLProvider{
+			EntityID:    "https://example.com/saml/metadata3",
+			MetadataXML: "<example />",
+		},
+		SSODomains: []SSODomain{
+			{
+				Domain: "example.com",
+			},
+		},
+	}
+	require.NoError(ts.T(), ts.db.Eager().Create(provider))
+
+	found, err := FindSSOProviderByDomain(ts.db, "example.com", projectID)
+	require.NoError(ts.T(), err)
+	require.Equal(ts.T(), provider.ID, found.ID)
 }
 
 func (ts *SSOTestSuite) TestEntityIDUniqueness() {
 	require.NoError(ts.T(), ts.db.Eager().Create(&SSOProvider{
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
 		SAMLProvider: SAMLProvider{
 			EntityID:    "https://example.com/saml/metadata",
 			MetadataXML: "<example />",
@@ -141,6 +178,8 @@ func (ts *SSOTestSuite) TestEntityIDUniqueness() {
 	}))
 
 	require.Error(ts.T(), ts.db.Eager().Create(&SSOProvider{
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
 		SAMLProvider: SAMLProvider{
 			EntityID:    "https://example.com/saml/metadata",
 			MetadataXML: "<example />",
@@ -155,6 +194,8 @@ func (ts *SSOTestSuite) TestEntityIDUniqueness() {
 
 func (ts *SSOTestSuite) TestFindSSOProviderForEmailAddress() {
 	provider := &SSOProvider{
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
 		SAMLProvider: SAMLProvider{
 			EntityID:    "https://example.com/saml/metadata",
 			MetadataXML: "<example />",
@@ -192,7 +233,7 @@ func (ts *SSOTestSuite) TestFindSSOProviderForEmailAddress() {
 	}
 
 	for i, example := range examples {
-		rp, err := FindSSOProviderForEmailAddress(ts.db, example.Address)
+		rp, err := FindSSOProviderForEmailAddress(ts.db, example.Address, ts.ProjectID)
 
 		if nil == example.Provider {
 			require.Nil(ts.T(), rp)
@@ -206,6 +247,8 @@ func (ts *SSOTestSuite) TestFindSSOProviderForEmailAddress() {
 
 func (ts *SSOTestSuite) TestFindSAMLProviderByEntityID() {
 	provider := &SSOProvider{
+		OrganizationID: ts.OrganizationID,
+		ProjectID:      ts.ProjectID,
 		SAMLProvider: SAMLProvider{
 			EntityID:    "https://example.com/saml/metadata",
 			MetadataXML: "<example />",
@@ -273,6 +316,8 @@ func (ts *SSOTestSuite) TestFindSSOProviderByResourceID() {
 	genProvider := func(resourceID string) *SSOProvider {
 		str := genStr()
 		pr := &SSOProvider{
+			OrganizationID: ts.OrganizationID,
+			ProjectID:      ts.ProjectID,
 			SAMLProvider: SAMLProvider{
 				EntityID:    "https://example.com/saml/metadata/" + str,
 				MetadataXML: "<example />",
@@ -442,7 +487,7 @@ func (ts *SSOTestSuite) TestFindSSOProviderByResourceID() {
 
 	for _, test := range tests {
 		ts.Run("FindAllSSOProvidersByFilter/query='"+test.query.Encode()+"'", func() {
-			prs, err := FindAllSSOProvidersByFilter(ts.db, test.query)
+			prs, err := FindAllSSOProvidersByFilter(ts.db, test.query, uuid.Nil, ts.ProjectID)
 			require.NoError(ts.T(), err)
 			require.NotNil(ts.T(), prs)
 			check(ts.T(), test.exp, prs)
@@ -459,14 +504,14 @@ func (ts *SSOTestSuite) TestFindSSOProviderByResourceID() {
 		}
 
 		if exp.ResourceID != nil {
-			got, err := FindSSOProviderByResourceID(ts.db, *exp.ResourceID)
+			got, err := FindSSOProviderByResourceID(ts.db, *exp.ResourceID, ts.ProjectID)
 			require.NoError(ts.T(), err)
 			require.NotNil(ts.T(), got)
 			check(ts.T(), []*SSOProvider{exp}, []*SSOProvider{got})
 		}
 
 		for _, domain := range exp.SSODomains {
-			got, err := FindSSOProviderByDomain(ts.db, domain.Domain)
+			got, err := FindSSOProviderByDomain(ts.db, domain.Domain, ts.ProjectID)
 			require.NoError(ts.T(), err)
 			require.NotNil(ts.T(), got)
 			check(ts.T(), []*SSOProvider{exp}, []*SSOProvider{got})
@@ -474,7 +519,7 @@ func (ts *SSOTestSuite) TestFindSSOProviderByResourceID() {
 	}
 
 	{
-		got, err := FindSSOProviderByResourceID(ts.db, "")
+		got, err := FindSSOProviderByResourceID(ts.db, "", ts.ProjectID)
 		require.Error(ts.T(), err)
 		require.Nil(ts.T(), got)
 	}
@@ -486,7 +531,7 @@ func (ts *SSOTestSuite) TestFindSSOProviderByResourceID() {
 	}
 
 	{
-		got, err := FindSSOProviderByDomain(ts.db, "_test_invalid_")
+		got, err := FindSSOProviderByDomain(ts.db, "_test_invalid_", ts.ProjectID)
 		require.Error(ts.T(), err)
 		require.Nil(ts.T(), got)
 	}
@@ -227,6 +227,25 @@ func (u *User) SetRole(tx *storage.Connection, roleName string) error {
 	return tx.UpdateOnly(u, "role")
 }
 
+// SetOrganization moves the user into the organization with the given
+// organization role.
+func (u *User) SetOrganization(tx *storage.Connection, organizationID uuid.UUID, organizationRole string) error {
+	u.OrganizationID = uuid.NullUUID{UUID: organizationID, Valid: organizationID != uuid.Nil}
+	u.OrganizationRole = organizationRole
+	if err := tx.UpdateOnly(u, "organization_id", "organization_role"); err != nil {
+		return err
+	}
+	if !u.OrganizationID.Valid {
+		return nil
+	}
+
+	member, err := NewOrganizationMember(organizationID, u.ID, organizationRole)
+	if err != nil {
+		return err
+	}
+	return SaveOrganizationMember(tx, member)
+}
+
 // HasRole returns true when the users role is set to roleName
 func (u *User) HasRole(roleName string) bool {
 	return u.Role == roleName
@@ -819,8 +838,15 @@ func FindUsersInAudience(tx *storage.Connection, aud string, pageParams *Paginat
 	}
 
 	if organization_id != uuid.Nil {
-		args = append(args, organization_id)
-		query += " and organization_id = ?"
+		// Users belong to their home organization and to every organization
+		// they are a member of.
+		args = append(args, organization_id, organization_id)
+		query += " and (organization_id = ? or id in (select user_id from organization_members where organization_id = ?))"
+	}
+
+	if project_id != uuid.Nil {
+		args = append(args, project_id)
+		query += " and project_id = ?"
 	}
 	q := tx.Q().Where(query, args...)
 
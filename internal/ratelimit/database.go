@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/supabase/auth/internal/conf"
+	"github.com/supabase/auth/internal/models"
+	"github.com/supabase/auth/internal/storage"
+)
+
+// DatabaseLimiter is a sliding window limiter of the requests of a user of a
+// project, stored in the auth.project_rate_limits table. Unlike the other
+// limiters its state is shared by every instance using the same database.
+type DatabaseLimiter struct {
+	db        *storage.Connection
+	projectID uuid.UUID
+	userID    string
+	limit     int
+	window    time.Duration
+}
+
+// NewDatabaseLimiter returns a limiter allowing r.Events requests of the user
+// in any window of r.OverTime.
+func NewDatabaseLimiter(db *storage.Connection, projectID uuid.UUID, userID string, r conf.Rate) *DatabaseLimiter {
+	window := r.OverTime
+	if window <= 0 {
+		window = defaultOverTime
+	}
+
+	return &DatabaseLimiter{
+		db:        db,
+		projectID: projectID,
+		userID:    userID,
+		limit:     int(r.Events),
+		window:    window,
+	}
+}
+
+// Allow implements Limiter by calling AllowAt with the current time.
+func (l *DatabaseLimiter) Allow() bool {
+	return l.AllowAt(time.Now())
+}
+
+// AllowAt implements Limiter by counting the requests of the user in the
+// window ending at the given time. Requests are allowed when the database
+// cannot be reached, so that an outage of the limiter does not become an
+// outage of the API.
+func (l *DatabaseLimiter) AllowAt(at time.Time) bool {
+	allowed, err := models.AllowProjectRequest(l.db, l.projectID, l.userID, l.limit, l.window, at)
+	if err != nil {
+		logrus.WithError(err).WithField("project_id", l.projectID).Error("database rate limiter failed")
+		return true
+	}
+	return allowed
+}
@@ -379,6 +379,12 @@ func (c *Connection) Transaction(fn func(*Connection) error) error {
 			conn := c.Copy()
 			conn.Connection = tx
 
+			if vars := GetSessionVariables(tx.Context()); vars != nil {
+				if err := vars.apply(tx); err != nil {
+					return err
+				}
+			}
+
 			err := fn(conn)
 			switch err.(type) {
 			case *CommitWithError:
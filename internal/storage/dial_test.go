@@ -65,6 +65,53 @@ func TestTransaction(t *testing.T) {
 	require.Empty(t, data)
 }
 
+func TestTransactionSessionVariables(t *testing.T) {
+	apiTestConfig := "../../hack/test.env"
+	config, err := conf.LoadGlobal(apiTestConfig)
+	require.NoError(t, err)
+	conn, err := Dial(config)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	vars := &SessionVariables{
+		OrganizationID:   uuid.Must(uuid.NewV4()),
+		OrganizationRole: "admin",
+	}
+	ctx := WithSessionVariables(context.Background(), vars)
+
+	type settings struct {
+		Organization string `db:"organization"`
+		Project      string `db:"project"`
+		Role         string `db:"role"`
+		Bypass       string `db:"bypass"`
+	}
+	query := `select
+		coalesce(current_setting('app.current_organization', true), '') as organization,
+		coalesce(current_setting('app.current_project', true), '') as project,
+		coalesce(current_setting('app.organization_role', true), '') as role,
+		coalesce(current_setting('app.bypass_rls', true), '') as bypass`
+
+	err = conn.WithContext(ctx).Transaction(func(tx *Connection) error {
+		s := settings{}
+		require.NoError(t, tx.RawQuery(query).First(&s))
+		require.Equal(t, vars.OrganizationID.String(), s.Organization)
+		require.Empty(t, s.Project)
+		require.Equal(t, "admin", s.Role)
+		require.Equal(t, "off", s.Bypass)
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = conn.WithContext(WithSessionVariables(context.Background(), BypassRLS)).Transaction(func(tx *Connection) error {
+		s := settings{}
+		require.NoError(t, tx.RawQuery(query).First(&s))
+		require.Empty(t, s.Organization)
+		require.Equal(t, "on", s.Bypass)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestPopConnToStd(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
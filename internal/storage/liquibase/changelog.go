@@ -0,0 +1,207 @@
+// Package liquibase applies the Liquibase changelogs of the tenant schema
+// without the Liquibase CLI. Applied change sets are recorded in the
+// databasechangelog table of Liquibase, so the CLI skips them afterwards.
+package liquibase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Changelogs are the changelog files of liquibase_migrations, in the order
+// liquibase_migrations.sh applies them.
+var Changelogs = []string{
+	"changelog-public.sql",
+	"changelog-auth.sql",
+	"changelog-rls-auth.sql",
+	"changelog-index-auth.sql",
+	"changelog-grants-auth.sql",
+	"changelog-procedures-auth.xml",
+}
+
+const (
+	formattedSQLHeader = "--liquibase formatted sql"
+	changeSetPrefix    = "--changeset "
+	commentPrefix      = "--comment:"
+	rollbackPrefix     = "--rollback"
+
+	rawChangeSetID     = "raw"
+	rawChangeSetAuthor = "includeAll"
+)
+
+// ChangeSet is a single change set of a changelog. Change sets are
+// identified by their ID and author, like Liquibase does.
+type ChangeSet struct {
+	ID               string
+	Author           string
+	Filename         string
+	Description      string
+	Comment          string
+	SQL              string
+	RunInTransaction bool
+}
+
+// Load reads the Changelogs from fsys and returns their change sets in the
+// order they are applied.
+func Load(fsys fs.FS) ([]*ChangeSet, error) {
+	changeSets := []*ChangeSet{}
+	for _, name := range Changelogs {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading changelog %s", name)
+		}
+
+		parsed, err := Parse(name, data)
+		if err != nil {
+			return nil, err
+		}
+		changeSets = append(changeSets, parsed...)
+	}
+	return changeSets, nil
+}
+
+// Parse parses a formatted SQL or an XML changelog, depending on the
+// extension of name.
+func Parse(name string, data []byte) ([]*ChangeSet, error) {
+	switch path.Ext(name) {
+	case ".sql":
+		return parseSQL(name, data)
+	case ".xml":
+		return parseXML(name, data)
+	default:
+		return nil, errors.Errorf("unsupported changelog format %s", name)
+	}
+}
+
+func parseSQL(name string, data []byte) ([]*ChangeSet, error) {
+	// Liquibase runs SQL files without the header as a single raw change set
+	if first, _, _ := strings.Cut(strings.TrimSpace(string(data)), "\n"); strings.TrimSpace(first) != formattedSQLHeader {
+		return []*ChangeSet{{
+			ID:               rawChangeSetID,
+			Author:           rawChangeSetAuthor,
+			Filename:         name,
+			Description:      "sql",
+			SQL:              strings.TrimSpace(string(data)),
+			RunInTransaction: true,
+		}}, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	changeSets := []*ChangeSet{}
+	var current *ChangeSet
+	var body strings.Builder
+	flush := func() {
+		if current != nil {
+			current.SQL = strings.TrimSpace(body.String())
+			changeSets = append(changeSets, current)
+		}
+		body.Reset()
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, changeSetPrefix):
+			flush()
+
+			fields := strings.Fields(strings.TrimPrefix(line, changeSetPrefix))
+			if len(fields) == 0 {
+				return nil, errors.Errorf("changelog %s has a change set without an author and ID", name)
+			}
+			author, id, ok := strings.Cut(fields[0], ":")
+			if !ok || author == "" || id == "" {
+				return nil, errors.Errorf("changelog %s has a change set %q that is not in the author:id format", name, fields[0])
+			}
+
+			current = &ChangeSet{
+				ID:               id,
+				Author:           author,
+				Filename:         name,
+				Description:      "sql",
+				RunInTransaction: true,
+			}
+			for _, attribute := range fields[1:] {
+				if attribute == "runInTransaction:false" {
+					current.RunInTransaction = false
+				}
+			}
+		case current == nil:
+			// Lines before the first change set belong to none of them
+		case strings.HasPrefix(line, commentPrefix):
+			current.Comment = strings.TrimSpace(strings.TrimPrefix(line, commentPrefix))
+		case strings.HasPrefix(line, rollbackPrefix):
+			// Rollbacks are only run by the Liquibase CLI
+		default:
+			body.WriteString(line)
+			body.WriteByte('\n')
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "reading changelog %s", name)
+	}
+	flush()
+
+	return changeSets, nil
+}
+
+type xmlChangeLog struct {
+	ChangeSets []xmlChangeSet `xml:"changeSet"`
+}
+
+type xmlChangeSet struct {
+	ID               string      `xml:"id,attr"`
+	Author           string      `xml:"author,attr"`
+	RunInTransaction *bool       `xml:"runInTransaction,attr"`
+	Changes          []xmlChange `xml:",any"`
+}
+
+type xmlChange struct {
+	XMLName xml.Name
+	Text    string `xml:",chardata"`
+}
+
+func parseXML(name string, data []byte) ([]*ChangeSet, error) {
+	var changeLog xmlChangeLog
+	if err := xml.Unmarshal(data, &changeLog); err != nil {
+		return nil, errors.Wrapf(err, "parsing changelog %s", name)
+	}
+
+	changeSets := []*ChangeSet{}
+	for _, c := range changeLog.ChangeSets {
+		changeSet := &ChangeSet{
+			ID:               c.ID,
+			Author:           c.Author,
+			Filename:         name,
+			RunInTransaction: c.RunInTransaction == nil || *c.RunInTransaction,
+		}
+
+		statements := []string{}
+		for _, change := range c.Changes {
+			switch change.XMLName.Local {
+			case "comment":
+				changeSet.Comment = strings.TrimSpace(change.Text)
+			case "rollback":
+				// Rollbacks are only run by the Liquibase CLI
+			case "sql", "createProcedure":
+				if changeSet.Description == "" {
+					changeSet.Description = change.XMLName.Local
+				}
+				statements = append(statements, strings.TrimSpace(change.Text))
+			default:
+				return nil, errors.Errorf("changelog %s uses the unsupported change type %s in change set %s", name, change.XMLName.Local, c.ID)
+			}
+		}
+		changeSet.SQL = strings.Join(statements, "\n\n")
+
+		changeSets = append(changeSets, changeSet)
+	}
+	return changeSets, nil
+}
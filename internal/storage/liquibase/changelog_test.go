@@ -0,0 +1,110 @@
+package liquibase
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSQL(t *testing.T) {
+	changelog := `--liquibase formatted sql
+
+--------------------------- enums ---------------------------
+--changeset solomon.auth:grant:1 labels:auth context:auth
+--comment: grant select on api_keys
+GRANT SELECT ON "auth".api_keys TO solomon_auth_user_role;
+--rollback REVOKE SELECT ON "auth".api_keys FROM solomon_auth_user_role;
+
+--changeset solomon.auth:2 labels:auth context:auth runInTransaction:false
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'users:read';
+`
+
+	changeSets, err := Parse("changelog-grants-auth.sql", []byte(changelog))
+	require.NoError(t, err)
+	require.Len(t, changeSets, 2)
+
+	require.Equal(t, &ChangeSet{
+		ID:               "grant:1",
+		Author:           "solomon.auth",
+		Filename:         "changelog-grants-auth.sql",
+		Description:      "sql",
+		Comment:          "grant select on api_keys",
+		SQL:              `GRANT SELECT ON "auth".api_keys TO solomon_auth_user_role;`,
+		RunInTransaction: true,
+	}, changeSets[0])
+
+	require.Equal(t, "2", changeSets[1].ID)
+	require.False(t, changeSets[1].RunInTransaction)
+	require.Equal(t, `ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'users:read';`, changeSets[1].SQL)
+}
+
+func TestParseRawSQL(t *testing.T) {
+	changeSets, err := Parse("changelog-public.sql", []byte("\n--changeset solomon.public:1\nSELECT 1;\n"))
+	require.NoError(t, err)
+	require.Len(t, changeSets, 1)
+	require.Equal(t, "raw", changeSets[0].ID)
+	require.Equal(t, "includeAll", changeSets[0].Author)
+	require.Equal(t, "--changeset solomon.public:1\nSELECT 1;", changeSets[0].SQL)
+}
+
+func TestParseXML(t *testing.T) {
+	changelog := `<databaseChangeLog xmlns="http://www.liquibase.org/xml/ns/dbchangelog">
+<changeSet author="admin" id="solomon.auth.functions:4">
+    <createProcedure dbms="postgresql">
+        CREATE OR REPLACE FUNCTION f() RETURNS trigger AS $$ BEGIN RETURN NEW; END; $$ LANGUAGE plpgsql;
+    </createProcedure>
+    <rollback>
+        DROP FUNCTION f();
+    </rollback>
+</changeSet>
+<!-- <changeSet author="admin" id="solomon.public.functions:4"></changeSet> -->
+<changeSet author="admin" id="solomon.auth.functions:2">
+    <sql><![CDATA[SELECT 1 WHERE 1 < 2;]]></sql>
+</changeSet>
+</databaseChangeLog>`
+
+	changeSets, err := Parse("changelog-procedures-auth.xml", []byte(changelog))
+	require.NoError(t, err)
+	require.Len(t, changeSets, 2)
+
+	require.Equal(t, "solomon.auth.functions:4", changeSets[0].ID)
+	require.Equal(t, "admin", changeSets[0].Author)
+	require.Equal(t, "createProcedure", changeSets[0].Description)
+	require.True(t, strings.HasPrefix(changeSets[0].SQL, "CREATE OR REPLACE FUNCTION f()"))
+	require.NotContains(t, changeSets[0].SQL, "DROP FUNCTION")
+
+	require.Equal(t, "solomon.auth.functions:2", changeSets[1].ID)
+	require.Equal(t, "SELECT 1 WHERE 1 < 2;", changeSets[1].SQL)
+}
+
+func TestParseXMLRejectsUnsupportedChanges(t *testing.T) {
+	changelog := `<databaseChangeLog>
+<changeSet author="admin" id="1">
+    <createTable tableName="t"></createTable>
+</changeSet>
+</databaseChangeLog>`
+
+	_, err := Parse("changelog.xml", []byte(changelog))
+	require.Error(t, err)
+}
+
+func TestLoadChangelogs(t *testing.T) {
+	changeSets, err := Load(os.DirFS("../../../liquibase_migrations"))
+	require.NoError(t, err)
+	require.NotEmpty(t, changeSets)
+
+	seen := map[changeSetKey]bool{}
+	for _, changeSet := range changeSets {
+		key := changeSetKey{changeSet.ID, changeSet.Author}
+		require.False(t, seen[key], "change set %s:%s is defined twice", changeSet.Author, changeSet.ID)
+		seen[key] = true
+
+		require.NotEmpty(t, changeSet.SQL, "change set %s:%s has no SQL", changeSet.Author, changeSet.ID)
+	}
+
+	// The public enums are used by the auth tables and must come first
+	require.Equal(t, "changelog-public.sql", changeSets[0].Filename)
+	require.Equal(t, "changelog-procedures-auth.xml", changeSets[len(changeSets)-1].Filename)
+}
@@ -0,0 +1,97 @@
+package liquibase
+
+import (
+	"github.com/gobuffalo/pop/v6"
+	"github.com/pkg/errors"
+)
+
+// changeLogTable has the layout of the table Liquibase creates, in the
+// schema Liquibase uses by default.
+const changeLogTable = `CREATE TABLE IF NOT EXISTS public.databasechangelog (
+	id varchar(255) NOT NULL,
+	author varchar(255) NOT NULL,
+	filename varchar(255) NOT NULL,
+	dateexecuted timestamp NOT NULL,
+	orderexecuted integer NOT NULL,
+	exectype varchar(10) NOT NULL,
+	md5sum varchar(35),
+	description varchar(255),
+	comments varchar(255),
+	tag varchar(255),
+	liquibase varchar(20),
+	contexts varchar(255),
+	labels varchar(255),
+	deployment_id varchar(10)
+)`
+
+type changeLogRow struct {
+	ID            string `db:"id"`
+	Author        string `db:"author"`
+	OrderExecuted int    `db:"orderexecuted"`
+}
+
+type changeSetKey struct {
+	id, author string
+}
+
+// Apply runs the change sets that are not yet in the databasechangelog
+// table and returns how many were applied. Each change set is recorded in
+// the same transaction it runs in. The checksum is left empty, which makes
+// the Liquibase CLI compute it instead of running the change set again.
+func Apply(conn *pop.Connection, changeSets []*ChangeSet) (int, error) {
+	if err := conn.RawQuery(changeLogTable).Exec(); err != nil {
+		return 0, errors.Wrap(err, "creating databasechangelog table")
+	}
+
+	// Change sets are matched on ID and author only, the file names differ
+	// with the search path the CLI was run with
+	rows := []changeLogRow{}
+	if err := conn.RawQuery("SELECT id, author, orderexecuted FROM public.databasechangelog").All(&rows); err != nil {
+		return 0, errors.Wrap(err, "reading databasechangelog table")
+	}
+	applied := make(map[changeSetKey]bool, len(rows))
+	order := 0
+	for _, row := range rows {
+		applied[changeSetKey{row.ID, row.Author}] = true
+		order = max(order, row.OrderExecuted)
+	}
+
+	count := 0
+	for _, changeSet := range changeSets {
+		if applied[changeSetKey{changeSet.ID, changeSet.Author}] {
+			continue
+		}
+		order++
+
+		run := func(tx *pop.Connection) error {
+			if changeSet.SQL != "" {
+				if err := tx.RawQuery(changeSet.SQL).Exec(); err != nil {
+					return err
+				}
+			}
+			return tx.RawQuery(
+				"INSERT INTO public.databasechangelog (id, author, filename, dateexecuted, orderexecuted, exectype, description, comments) VALUES (?, ?, ?, now(), ?, 'EXECUTED', ?, ?)",
+				changeSet.ID, changeSet.Author, changeSet.Filename, order, changeSet.Description, truncate(changeSet.Comment, 255),
+			).Exec()
+		}
+
+		var err error
+		if changeSet.RunInTransaction {
+			err = conn.Transaction(run)
+		} else {
+			err = run(conn)
+		}
+		if err != nil {
+			return count, errors.Wrapf(err, "applying change set %s:%s of %s", changeSet.Author, changeSet.ID, changeSet.Filename)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
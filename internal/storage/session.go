@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// SessionVariables are the tenant settings read by the row level security
+// policies of the auth schema. They are set with set_config(..., true) at the
+// start of every transaction whose context carries them, so they never
+// outlive the transaction or leak to the next user of the pooled connection.
+type SessionVariables struct {
+	OrganizationID   uuid.UUID
+	ProjectID        uuid.UUID
+	OrganizationRole string
+	Owner            uuid.UUID
+
+	// BypassRLS marks the transactions of service role and admin callers,
+	// which are not limited to a tenant.
+	BypassRLS bool
+}
+
+// BypassRLS are the session variables of callers with global access.
+var BypassRLS = &SessionVariables{BypassRLS: true}
+
+type sessionVariablesKey struct{}
+
+// WithSessionVariables adds the session variables of the caller to the
+// context. Transactions started from a connection with this context apply
+// them.
+func WithSessionVariables(ctx context.Context, vars *SessionVariables) context.Context {
+	return context.WithValue(ctx, sessionVariablesKey{}, vars)
+}
+
+// GetSessionVariables reads the session variables from the context, it
+// returns nil if the caller has none.
+func GetSessionVariables(ctx context.Context) *SessionVariables {
+	if ctx == nil {
+		return nil
+	}
+	vars, _ := ctx.Value(sessionVariablesKey{}).(*SessionVariables)
+	return vars
+}
+
+// apply sets the variables for the rest of the transaction. Nil UUIDs are
+// set to an empty string, which the policies read as no tenant.
+func (v *SessionVariables) apply(tx *pop.Connection) error {
+	bypass := "off"
+	if v.BypassRLS {
+		bypass = "on"
+	}
+
+	if err := tx.RawQuery(
+		`select
+			set_config('app.current_organization', ?, true),
+			set_config('app.current_project', ?, true),
+			set_config('app.organization_role', ?, true),
+			set_config('app.current_owner', ?, true),
+			set_config('app.bypass_rls', ?, true)`,
+		uuidSetting(v.OrganizationID),
+		uuidSetting(v.ProjectID),
+		v.OrganizationRole,
+		uuidSetting(v.Owner),
+		bypass,
+	).Exec(); err != nil {
+		return errors.Wrap(err, "error setting session variables")
+	}
+	return nil
+}
+
+func uuidSetting(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
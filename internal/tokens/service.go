@@ -42,6 +42,11 @@ type AccessTokenClaims struct {
 	Scope                         string                 `json:"scope,omitempty"`
 	OrganizationID                uuid.UUID              `json:"organization_id"`
 	ProjectID                     uuid.UUID              `json:"project_id"`
+	OrganizationRole              string                 `json:"organization_role,omitempty"`
+	TierModel                     string                 `json:"tier_model,omitempty"`
+	TierTime                      string                 `json:"tier_time,omitempty"`
+	TierUsage                     string                 `json:"tier_usage,omitempty"`
+	Permissions                   []string               `json:"permissions,omitempty"`
 }
 
 // IDTokenClaims represents OpenID Connect ID Token claims
@@ -71,7 +76,8 @@ type AccessTokenResponse struct {
 	ProviderAccessToken  string       `json:"provider_token,omitempty"`
 	ProviderRefreshToken string       `json:"provider_refresh_token,omitempty"`
 	WeakPassword         interface{}  `json:"weak_password,omitempty"`
-	IDToken              string       `json:"id_token,omitempty"` // OIDC ID Token
+	IDToken              string       `json:"id_token,omitempty"`       // OIDC ID Token
+	RecoveryCodes        []string     `json:"recovery_codes,omitempty"` // Issued with the first verified MFA factor
 }
 
 // GenerateAccessTokenParams contains parameters for generating access tokens
@@ -217,6 +223,22 @@ func (s *Service) RefreshTokenGrant(ctx context.Context, db *storage.Connection,
 			return nil, apierrors.NewBadRequestError(apierrors.ErrorCodeSessionExpired, "Invalid Refresh Token: Session Expired")
 		}
 
+		// The MFA policy of the organization the session acts on can require
+		// a higher AAL than the session has
+		organizationID, _, err := session.ActiveOrganization(db, user)
+		if err != nil {
+			return nil, apierrors.NewInternalServerError("%s", err.Error())
+		}
+		if organizationID != uuid.Nil {
+			policy, err := models.FindOrganizationMFAPolicy(db, organizationID)
+			if err != nil && !models.IsNotFoundError(err) {
+				return nil, apierrors.NewInternalServerError("%s", err.Error())
+			}
+			if policy != nil && !policy.RefreshAllowed(user, models.ParseAAL(session.AAL)) {
+				return nil, apierrors.NewBadRequestError(apierrors.ErrorCodeSessionExpired, "Invalid Refresh Token: Session Expired (Low AAL: Organization Requires MFA Verification)")
+			}
+		}
+
 		// Basic checks above passed, now we need to serialize access
 		// to the session in a transaction so that there's no
 		// concurrent modification. In the event that the refresh
@@ -577,11 +599,18 @@ func (s *Service) GenerateAccessToken(r *http.Request, tx *storage.Connection, p
 		return "", 0, terr
 	}
 
-	organization_id := params.User.OrganizationID.UUID
-	organization_role := params.User.OrganizationRole
+	organization_id, organization_role, terr := session.ActiveOrganization(tx, params.User)
+	if terr != nil {
+		return "", 0, terr
+	}
 	project_id := params.User.ProjectID
 
-	tier_model, tier_time, tier_usage, terr := models.FindTiersByOrganizationIDAndOrganizationRole(tx, organization_id, organization_role)
+	tier, terr := models.FindEffectiveTier(tx, organization_id, project_id, organization_role)
+	if terr != nil {
+		return "", 0, terr
+	}
+
+	permissions, terr := models.FindPermissionsByOrganizationRole(tx, organization_role)
 	if terr != nil {
 		return "", 0, terr
 	}
@@ -621,9 +650,10 @@ func (s *Service) GenerateAccessToken(r *http.Request, tx *storage.Connection, p
 		OrganizationID:                organization_id,
 		ProjectID:                     project_id,
 		OrganizationRole:              organization_role,
-		TierModel:                     tier_model,
-		TierTime:                      tier_time,
-		TierUsage:                     tier_usage,
+		TierModel:                     tier.TierModel,
+		TierTime:                      tier.TierTime,
+		TierUsage:                     tier.TierUsage,
+		Permissions:                   permissions,
 	}
 
 	var gotrueClaims jwt.Claims = claims
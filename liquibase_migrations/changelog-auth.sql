@@ -274,3 +274,350 @@ ALTER TABLE "auth".identities DROP CONSTRAINT IF EXISTS identities_provider_id_p
 ALTER TABLE "auth".identities ADD CONSTRAINT identities_provider_id_provider_project_id_unique UNIQUE (provider_id, provider, project_id);
 --rollback ALTER TABLE "auth".identities DROP CONSTRAINT IF EXISTS identities_provider_id_provider_project_id_unique;
 --rollback ALTER TABLE "auth".identities ADD CONSTRAINT identities_provider_id_provider_unique UNIQUE (provider_id, provider);
+
+--changeset solomon.auth:22 labels:auth context:auth
+--comment: create organization_members table so that a user can belong to several organizations
+CREATE TABLE IF NOT EXISTS "auth".organization_members (
+	id uuid UNIQUE NOT NULL,
+	organization_id uuid NOT NULL,
+	user_id uuid NOT NULL,
+	role "auth".organization_roles NOT NULL DEFAULT 'client',
+	created_at timestamptz DEFAULT current_timestamp,
+	updated_at timestamptz DEFAULT current_timestamp,
+	CONSTRAINT organization_members_pkey PRIMARY KEY (id),
+	CONSTRAINT organization_members_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES "auth".organizations(id) ON DELETE CASCADE,
+	CONSTRAINT organization_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES "auth".users(id) ON DELETE CASCADE,
+	CONSTRAINT organization_members_organization_id_user_id_unique UNIQUE (organization_id, user_id)
+);
+--rollback DROP TABLE "auth".organization_members;
+
+--changeset solomon.auth:22.1 labels:auth context:auth
+--comment: backfill organization_members with the organization of every user
+INSERT INTO "auth".organization_members (id, organization_id, user_id, role)
+SELECT gen_random_uuid(), organization_id, id, organization_role
+FROM "auth".users
+WHERE organization_id IS NOT NULL
+ON CONFLICT (organization_id, user_id) DO NOTHING;
+--rollback DELETE FROM "auth".organization_members;
+
+--changeset solomon.auth:23 labels:auth context:auth
+--comment: store the active organization of a session
+ALTER TABLE "auth".sessions ADD COLUMN IF NOT EXISTS active_organization_id uuid NULL;
+ALTER TABLE "auth".sessions DROP CONSTRAINT IF EXISTS sessions_active_organization_id_fkey;
+ALTER TABLE "auth".sessions ADD CONSTRAINT sessions_active_organization_id_fkey FOREIGN KEY (active_organization_id) REFERENCES "auth".organizations(id) ON DELETE SET NULL;
+--rollback ALTER TABLE "auth".sessions DROP CONSTRAINT IF EXISTS sessions_active_organization_id_fkey;
+--rollback ALTER TABLE "auth".sessions DROP COLUMN IF EXISTS active_organization_id;
+
+--changeset solomon.auth:24 labels:auth context:auth
+--comment: create organization_invitations table for inviting users by email into an organization
+CREATE TABLE IF NOT EXISTS "auth".organization_invitations (
+	id uuid UNIQUE NOT NULL,
+	organization_id uuid NOT NULL,
+	email varchar(255) NOT NULL,
+	role "auth".organization_roles NOT NULL DEFAULT 'client',
+	token_hash text NOT NULL,
+	invited_by uuid NULL,
+	expires_at timestamptz NOT NULL,
+	sent_at timestamptz NULL,
+	accepted_at timestamptz NULL,
+	created_at timestamptz DEFAULT current_timestamp,
+	updated_at timestamptz DEFAULT current_timestamp,
+	CONSTRAINT organization_invitations_pkey PRIMARY KEY (id),
+	CONSTRAINT organization_invitations_token_hash_unique UNIQUE (token_hash),
+	CONSTRAINT organization_invitations_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES "auth".organizations(id) ON DELETE CASCADE,
+	CONSTRAINT organization_invitations_invited_by_fkey FOREIGN KEY (invited_by) REFERENCES "auth".users(id) ON DELETE SET NULL
+);
+--rollback DROP TABLE "auth".organization_invitations;
+
+--changeset solomon.auth:25 labels:auth context:auth splitStatements:false
+--comment: SSO providers are owned by an organization, their domains and resource ids are unique per project
+ALTER TABLE "auth".sso_providers ADD COLUMN IF NOT EXISTS organization_id uuid NULL;
+ALTER TABLE "auth".sso_providers ADD COLUMN IF NOT EXISTS project_id uuid NULL;
+ALTER TABLE "auth".sso_domains ADD COLUMN IF NOT EXISTS project_id uuid NULL;
+DO $$
+BEGIN
+    -- Providers created before organizations existed can only be assigned
+    -- when there is a single organization, otherwise they must be mapped by
+    -- hand before running this changeset
+    PERFORM set_config('app.bypass_rls', 'on', true);
+    IF EXISTS (SELECT 1 FROM "auth".sso_providers WHERE organization_id IS NULL) THEN
+        IF (SELECT count(*) FROM "auth".organizations) <> 1 THEN
+            RAISE EXCEPTION 'auth.sso_providers has rows without an organization_id and there is not exactly one organization to assign them to. Add the uuid column organization_id to auth.sso_providers, set it for every provider, then run the migration again.';
+        END IF;
+        UPDATE "auth".sso_providers SET organization_id = (SELECT id FROM "auth".organizations)
+        WHERE organization_id IS NULL;
+    END IF;
+    UPDATE "auth".sso_providers p SET project_id = o.project_id
+    FROM "auth".organizations o
+    WHERE p.organization_id = o.id AND p.project_id IS NULL;
+    UPDATE "auth".sso_domains d SET project_id = p.project_id
+    FROM "auth".sso_providers p
+    WHERE d.sso_provider_id = p.id AND d.project_id IS NULL;
+    ALTER TABLE "auth".sso_providers ALTER COLUMN organization_id SET NOT NULL;
+    ALTER TABLE "auth".sso_providers ALTER COLUMN project_id SET NOT NULL;
+    ALTER TABLE "auth".sso_domains ALTER COLUMN project_id SET NOT NULL;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'sso_providers_organization_id_fkey') THEN
+        ALTER TABLE "auth".sso_providers ADD CONSTRAINT sso_providers_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES "auth".organizations (id) ON DELETE CASCADE;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'sso_providers_project_id_fkey') THEN
+        ALTER TABLE "auth".sso_providers ADD CONSTRAINT sso_providers_project_id_fkey FOREIGN KEY (project_id) REFERENCES "auth".projects (id) ON DELETE CASCADE;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'sso_domains_project_id_fkey') THEN
+        ALTER TABLE "auth".sso_domains ADD CONSTRAINT sso_domains_project_id_fkey FOREIGN KEY (project_id) REFERENCES "auth".projects (id) ON DELETE CASCADE;
+    END IF;
+END $$;
+DROP INDEX IF EXISTS "auth".sso_domains_domain_idx;
+DROP INDEX IF EXISTS "auth".sso_providers_resource_id_idx;
+--rollback CREATE UNIQUE INDEX IF NOT EXISTS sso_domains_domain_idx ON "auth".sso_domains (lower(domain));
+--rollback CREATE UNIQUE INDEX IF NOT EXISTS sso_providers_resource_id_idx ON "auth".sso_providers (lower(resource_id));
+--rollback ALTER TABLE "auth".sso_domains DROP CONSTRAINT IF EXISTS sso_domains_project_id_fkey;
+--rollback ALTER TABLE "auth".sso_providers DROP CONSTRAINT IF EXISTS sso_providers_project_id_fkey;
+--rollback ALTER TABLE "auth".sso_providers DROP CONSTRAINT IF EXISTS sso_providers_organization_id_fkey;
+--rollback ALTER TABLE "auth".sso_domains DROP COLUMN IF EXISTS project_id;
+--rollback ALTER TABLE "auth".sso_providers DROP COLUMN IF EXISTS project_id;
+--rollback ALTER TABLE "auth".sso_providers DROP COLUMN IF EXISTS organization_id;
+
+--changeset solomon.auth:26 labels:auth context:auth splitStatements:false
+--comment: OAuth server clients belong to a project and optionally to an organization
+ALTER TABLE "auth".oauth_clients ADD COLUMN IF NOT EXISTS organization_id uuid NULL;
+ALTER TABLE "auth".oauth_clients ADD COLUMN IF NOT EXISTS project_id uuid NULL;
+DO $$
+BEGIN
+    -- Clients registered before projects existed can only be assigned when
+    -- there is a single project, otherwise they must be mapped by hand before
+    -- running this changeset
+    PERFORM set_config('app.bypass_rls', 'on', true);
+    UPDATE "auth".oauth_clients c SET project_id = o.project_id
+    FROM "auth".organizations o
+    WHERE c.organization_id = o.id AND c.project_id IS NULL;
+    IF EXISTS (SELECT 1 FROM "auth".oauth_clients WHERE project_id IS NULL) THEN
+        IF (SELECT count(*) FROM "auth".projects) <> 1 THEN
+            RAISE EXCEPTION 'auth.oauth_clients has rows without a project_id and there is not exactly one project to assign them to. Add the uuid column project_id to auth.oauth_clients, set it for every client, then run the migration again.';
+        END IF;
+        UPDATE "auth".oauth_clients SET project_id = (SELECT id FROM "auth".projects)
+        WHERE project_id IS NULL;
+    END IF;
+    ALTER TABLE "auth".oauth_clients ALTER COLUMN project_id SET NOT NULL;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'oauth_clients_organization_id_fkey') THEN
+        ALTER TABLE "auth".oauth_clients ADD CONSTRAINT oauth_clients_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES "auth".organizations (id) ON DELETE CASCADE;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'oauth_clients_project_id_fkey') THEN
+        ALTER TABLE "auth".oauth_clients ADD CONSTRAINT oauth_clients_project_id_fkey FOREIGN KEY (project_id) REFERENCES "auth".projects (id) ON DELETE CASCADE;
+    END IF;
+END $$;
+--rollback ALTER TABLE "auth".oauth_clients DROP CONSTRAINT IF EXISTS oauth_clients_project_id_fkey;
+--rollback ALTER TABLE "auth".oauth_clients DROP CONSTRAINT IF EXISTS oauth_clients_organization_id_fkey;
+--rollback ALTER TABLE "auth".oauth_clients DROP COLUMN IF EXISTS project_id;
+--rollback ALTER TABLE "auth".oauth_clients DROP COLUMN IF EXISTS organization_id;
+
+--changeset solomon.auth:27 labels:auth context:auth
+--comment: audit log entries record the organization and project they belong to
+ALTER TABLE "auth".audit_log_entries ADD COLUMN IF NOT EXISTS organization_id uuid NULL;
+ALTER TABLE "auth".audit_log_entries ADD COLUMN IF NOT EXISTS project_id uuid NULL;
+--rollback ALTER TABLE "auth".audit_log_entries DROP COLUMN IF EXISTS project_id;
+--rollback ALTER TABLE "auth".audit_log_entries DROP COLUMN IF EXISTS organization_id;
+
+--changeset solomon.auth:28 labels:auth context:auth splitStatements:false
+--comment: api keys belong to a project, the key column stores the sha256 hash of the key
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'api_keys_project_id_fkey') THEN
+        ALTER TABLE "auth".api_keys ADD CONSTRAINT api_keys_project_id_fkey FOREIGN KEY (project_id) REFERENCES "auth".projects (id) ON DELETE CASCADE;
+    END IF;
+END $$;
+--rollback ALTER TABLE "auth".api_keys DROP CONSTRAINT IF EXISTS api_keys_project_id_fkey;
+
+--changeset solomon.auth:29 labels:auth context:auth splitStatements:false
+--comment: smtp configs store the smtp server of an organization or project, one per organization
+ALTER TABLE "auth".smtp_configs_organizations ADD COLUMN IF NOT EXISTS host text NOT NULL;
+ALTER TABLE "auth".smtp_configs_organizations ADD COLUMN IF NOT EXISTS port integer NOT NULL DEFAULT 587;
+ALTER TABLE "auth".smtp_configs_organizations ADD COLUMN IF NOT EXISTS username text NULL;
+ALTER TABLE "auth".smtp_configs_organizations ADD COLUMN IF NOT EXISTS password text NULL;
+ALTER TABLE "auth".smtp_configs_organizations ADD COLUMN IF NOT EXISTS admin_email text NOT NULL;
+ALTER TABLE "auth".smtp_configs_organizations ADD COLUMN IF NOT EXISTS sender_name text NULL;
+ALTER TABLE "auth".smtp_configs_projects ADD COLUMN IF NOT EXISTS host text NOT NULL;
+ALTER TABLE "auth".smtp_configs_projects ADD COLUMN IF NOT EXISTS port integer NOT NULL DEFAULT 587;
+ALTER TABLE "auth".smtp_configs_projects ADD COLUMN IF NOT EXISTS username text NULL;
+ALTER TABLE "auth".smtp_configs_projects ADD COLUMN IF NOT EXISTS password text NULL;
+ALTER TABLE "auth".smtp_configs_projects ADD COLUMN IF NOT EXISTS admin_email text NOT NULL;
+ALTER TABLE "auth".smtp_configs_projects ADD COLUMN IF NOT EXISTS sender_name text NULL;
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'smtp_configs_organizations_organization_id_unique') THEN
+        ALTER TABLE "auth".smtp_configs_organizations ADD CONSTRAINT smtp_configs_organizations_organization_id_unique UNIQUE (organization_id);
+    END IF;
+END $$;
+--rollback ALTER TABLE "auth".smtp_configs_organizations DROP CONSTRAINT IF EXISTS smtp_configs_organizations_organization_id_unique;
+--rollback ALTER TABLE "auth".smtp_configs_projects DROP COLUMN IF EXISTS sender_name;
+--rollback ALTER TABLE "auth".smtp_configs_projects DROP COLUMN IF EXISTS admin_email;
+--rollback ALTER TABLE "auth".smtp_configs_projects DROP COLUMN IF EXISTS password;
+--rollback ALTER TABLE "auth".smtp_configs_projects DROP COLUMN IF EXISTS username;
+--rollback ALTER TABLE "auth".smtp_configs_projects DROP COLUMN IF EXISTS port;
+--rollback ALTER TABLE "auth".smtp_configs_projects DROP COLUMN IF EXISTS host;
+--rollback ALTER TABLE "auth".smtp_configs_organizations DROP COLUMN IF EXISTS sender_name;
+--rollback ALTER TABLE "auth".smtp_configs_organizations DROP COLUMN IF EXISTS admin_email;
+--rollback ALTER TABLE "auth".smtp_configs_organizations DROP COLUMN IF EXISTS password;
+--rollback ALTER TABLE "auth".smtp_configs_organizations DROP COLUMN IF EXISTS username;
+--rollback ALTER TABLE "auth".smtp_configs_organizations DROP COLUMN IF EXISTS port;
+--rollback ALTER TABLE "auth".smtp_configs_organizations DROP COLUMN IF EXISTS host;
+
+--changeset solomon.auth:30 labels:auth context:auth runInTransaction:false
+--comment: add the permissions of the go permission registry to the role_permissions enum
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'users:read';
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'users:write';
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'invitations:manage';
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'sso:manage';
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'keys:manage';
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'smtp:manage';
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'oauth:manage';
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'audit:read';
+--rollback SELECT 1;
+
+--changeset solomon.auth:31 labels:auth context:auth
+--comment: seed the default permissions of the organization roles
+INSERT INTO "auth".organization_roles_permissions (organization_role, permissions)
+SELECT r.role::"auth".organization_roles, p.permission::"auth".role_permissions
+FROM (VALUES ('admin'), ('project_admin')) AS r(role)
+CROSS JOIN (VALUES ('users:read'), ('users:write'), ('invitations:manage'), ('sso:manage'), ('keys:manage'), ('smtp:manage'), ('oauth:manage'), ('audit:read')) AS p(permission)
+ON CONFLICT (organization_role, permissions) DO NOTHING;
+INSERT INTO "auth".organization_roles_permissions (organization_role, permissions)
+VALUES
+	('api_key', 'users:read'),
+	('api_key', 'users:write'),
+	('api_key', 'invitations:manage'),
+	('api_key', 'audit:read')
+ON CONFLICT (organization_role, permissions) DO NOTHING;
+--rollback DELETE FROM "auth".organization_roles_permissions;
+
+--changeset solomon.auth:32 labels:auth context:auth
+--comment: mark the default tier of every project, used for users whose organization has no tier
+ALTER TABLE "auth".projects_tiers ADD COLUMN IF NOT EXISTS is_default boolean NOT NULL DEFAULT false;
+UPDATE "auth".projects_tiers pt SET is_default = true
+WHERE pt.tier = 'free'
+AND NOT EXISTS (SELECT 1 FROM "auth".projects_tiers d WHERE d.project_id = pt.project_id AND d.is_default);
+--rollback ALTER TABLE "auth".projects_tiers DROP COLUMN IF EXISTS is_default;
+
+--changeset solomon.auth:33 labels:auth context:auth
+--comment: create organization_mfa_policies table, the MFA requirements of the members of an organization
+CREATE TABLE IF NOT EXISTS "auth".organization_mfa_policies (
+	organization_id uuid PRIMARY KEY,
+	required_factor_types text NOT NULL,
+	enrollment_grace_period integer NOT NULL DEFAULT 0,
+	minimum_aal "auth".aal_level NOT NULL DEFAULT 'aal1',
+	created_at timestamptz NOT NULL DEFAULT current_timestamp,
+	updated_at timestamptz NOT NULL DEFAULT current_timestamp,
+	CONSTRAINT organization_mfa_policies_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES "auth".organizations(id) ON DELETE CASCADE,
+	CONSTRAINT organization_mfa_policies_enrollment_grace_period_check CHECK (enrollment_grace_period >= 0)
+);
+--rollback DROP TABLE "auth".organization_mfa_policies;
+
+--changeset solomon.auth:34 labels:auth context:auth runInTransaction:false
+--comment: add the mfa:manage permission to the role_permissions enum
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'mfa:manage';
+--rollback SELECT 1;
+
+--changeset solomon.auth:35 labels:auth context:auth
+--comment: grant the mfa:manage permission to the organization and project admins
+INSERT INTO "auth".organization_roles_permissions (organization_role, permissions)
+VALUES
+	('admin', 'mfa:manage'),
+	('project_admin', 'mfa:manage')
+ON CONFLICT (organization_role, permissions) DO NOTHING;
+--rollback DELETE FROM "auth".organization_roles_permissions WHERE permissions = 'mfa:manage';
+
+--changeset solomon.auth:36 labels:auth context:auth
+--comment: create project_settings table, the signup settings of a project overriding the global configuration
+CREATE TABLE IF NOT EXISTS "auth".project_settings (
+	project_id uuid PRIMARY KEY,
+	enabled_providers text NULL,
+	disable_signup boolean NULL,
+	mailer_autoconfirm boolean NULL,
+	phone_autoconfirm boolean NULL,
+	anonymous_users boolean NULL,
+	uri_allow_list text NULL,
+	created_at timestamptz NOT NULL DEFAULT current_timestamp,
+	updated_at timestamptz NOT NULL DEFAULT current_timestamp,
+	CONSTRAINT project_settings_project_id_fkey FOREIGN KEY (project_id) REFERENCES "auth".projects(id) ON DELETE CASCADE
+);
+--rollback DROP TABLE "auth".project_settings;
+
+--changeset solomon.auth:37 labels:auth context:auth runInTransaction:false
+--comment: add the settings:manage permission to the role_permissions enum
+ALTER TYPE "auth"."role_permissions" ADD VALUE IF NOT EXISTS 'settings:manage';
+--rollback SELECT 1;
+
+--changeset solomon.auth:38 labels:auth context:auth
+--comment: grant the settings:manage permission to the organization and project admins
+INSERT INTO "auth".organization_roles_permissions (organization_role, permissions)
+VALUES
+	('admin', 'settings:manage'),
+	('project_admin', 'settings:manage')
+ON CONFLICT (organization_role, permissions) DO NOTHING;
+--rollback DELETE FROM "auth".organization_roles_permissions WHERE permissions = 'settings:manage';
+
+--changeset solomon.auth:39 labels:auth context:auth
+--comment: create custom_oidc_providers table, the OIDC providers registered by a project and used as custom:<slug>
+CREATE TABLE IF NOT EXISTS "auth".custom_oidc_providers (
+	id uuid PRIMARY KEY,
+	project_id uuid NOT NULL,
+	slug text NOT NULL,
+	issuer text NOT NULL,
+	discovery_url text NULL,
+	client_id text NOT NULL,
+	client_secret text NOT NULL,
+	scopes text NOT NULL DEFAULT '',
+	claim_mappings jsonb NOT NULL DEFAULT '{}'::jsonb,
+	enabled boolean NOT NULL DEFAULT true,
+	created_at timestamptz NOT NULL DEFAULT current_timestamp,
+	updated_at timestamptz NOT NULL DEFAULT current_timestamp,
+	CONSTRAINT custom_oidc_providers_project_id_slug_key UNIQUE (project_id, slug),
+	CONSTRAINT custom_oidc_providers_project_id_fkey FOREIGN KEY (project_id) REFERENCES "auth".projects(id) ON DELETE CASCADE
+);
+--rollback DROP TABLE "auth".custom_oidc_providers;
+
+--changeset solomon.auth:40 labels:auth context:auth
+--comment: create passkeys table, the discoverable WebAuthn credentials users sign in with
+CREATE TABLE IF NOT EXISTS "auth".passkeys (
+	id uuid PRIMARY KEY,
+	user_id uuid NOT NULL,
+	friendly_name text NOT NULL DEFAULT '',
+	credential_id text NOT NULL,
+	credential jsonb NOT NULL,
+	aaguid uuid NULL,
+	last_used_at timestamptz NULL,
+	created_at timestamptz NOT NULL DEFAULT current_timestamp,
+	updated_at timestamptz NOT NULL DEFAULT current_timestamp,
+	CONSTRAINT passkeys_credential_id_key UNIQUE (credential_id),
+	CONSTRAINT passkeys_user_id_fkey FOREIGN KEY (user_id) REFERENCES "auth".users(id) ON DELETE CASCADE
+);
+--rollback DROP TABLE "auth".passkeys;
+
+--changeset solomon.auth:41 labels:auth context:auth
+--comment: create passkey_challenges table, the WebAuthn sessions of passkey registrations and sign-ins
+CREATE TABLE IF NOT EXISTS "auth".passkey_challenges (
+	id uuid PRIMARY KEY,
+	user_id uuid NULL,
+	ip_address inet NOT NULL,
+	web_authn_session_data jsonb NULL,
+	created_at timestamptz NOT NULL DEFAULT current_timestamp,
+	CONSTRAINT passkey_challenges_user_id_fkey FOREIGN KEY (user_id) REFERENCES "auth".users(id) ON DELETE CASCADE
+);
+--rollback DROP TABLE "auth".passkey_challenges;
+
+--changeset solomon.auth:42 labels:auth context:auth runInTransaction:false
+--comment: add the recovery_code factor type, the factor holding the MFA recovery codes of a user
+ALTER TYPE "auth"."factor_type" ADD VALUE IF NOT EXISTS 'recovery_code';
+--rollback SELECT 1;
+
+--changeset solomon.auth:43 labels:auth context:auth
+--comment: create mfa_recovery_codes table, the hashed single use codes of a recovery code factor
+CREATE TABLE IF NOT EXISTS "auth".mfa_recovery_codes (
+	id uuid PRIMARY KEY,
+	factor_id uuid NOT NULL,
+	code_hash text NOT NULL,
+	used_at timestamptz NULL,
+	created_at timestamptz NOT NULL DEFAULT current_timestamp,
+	CONSTRAINT mfa_recovery_codes_factor_id_code_hash_key UNIQUE (factor_id, code_hash),
+	CONSTRAINT mfa_recovery_codes_factor_id_fkey FOREIGN KEY (factor_id) REFERENCES "auth".mfa_factors(id) ON DELETE CASCADE
+);
+--rollback DROP TABLE "auth".mfa_recovery_codes;
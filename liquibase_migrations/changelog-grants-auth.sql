@@ -49,3 +49,43 @@ GRANT SELECT ON "auth".projects TO rl_auth_user_role;
 --comment: grant SELECT, INSERT, UPDATE, DELETE on project_rate_limits to rl_auth_user
 GRANT SELECT, INSERT, UPDATE, DELETE ON "auth".project_rate_limits TO rl_auth_user_role;
 --rollback REVOKE SELECT, INSERT, UPDATE, DELETE ON "auth".project_rate_limits
+
+--changeset solomon.auth:grant:10 labels:auth context:auth
+--comment: grant select, insert, update, delete on organization_members to solomon_auth_user_role
+GRANT SELECT, INSERT, UPDATE, DELETE ON "auth".organization_members TO solomon_auth_user_role;
+--rollback REVOKE SELECT, INSERT, UPDATE, DELETE ON "auth".organization_members FROM solomon_auth_user_role;
+
+--changeset solomon.auth:grant:11 labels:auth context:auth
+--comment: grant select, insert, update, delete on organization_invitations to solomon_auth_user_role
+GRANT SELECT, INSERT, UPDATE, DELETE ON "auth".organization_invitations TO solomon_auth_user_role;
+--rollback REVOKE SELECT, INSERT, UPDATE, DELETE ON "auth".organization_invitations FROM solomon_auth_user_role;
+
+--changeset solomon.auth:grant:12 labels:auth context:auth
+--comment: grant select on organization_roles_permissions to solomon_auth_user_role
+GRANT SELECT ON "auth".organization_roles_permissions TO solomon_auth_user_role;
+--rollback REVOKE SELECT ON "auth".organization_roles_permissions FROM solomon_auth_user_role;
+
+--changeset solomon.auth:grant:13 labels:auth context:auth
+--comment: grant select, insert, update, delete on organization_mfa_policies to solomon_auth_user_role
+GRANT SELECT, INSERT, UPDATE, DELETE ON "auth".organization_mfa_policies TO solomon_auth_user_role;
+--rollback REVOKE SELECT, INSERT, UPDATE, DELETE ON "auth".organization_mfa_policies FROM solomon_auth_user_role;
+
+--changeset solomon.auth:grant:14 labels:auth context:auth
+--comment: grant select, insert, update, delete on project_settings to solomon_auth_user_role
+GRANT SELECT, INSERT, UPDATE, DELETE ON "auth".project_settings TO solomon_auth_user_role;
+--rollback REVOKE SELECT, INSERT, UPDATE, DELETE ON "auth".project_settings FROM solomon_auth_user_role;
+
+--changeset solomon.auth:grant:15 labels:auth context:auth
+--comment: grant select, insert, update, delete on custom_oidc_providers to solomon_auth_user_role
+GRANT SELECT, INSERT, UPDATE, DELETE ON "auth".custom_oidc_providers TO solomon_auth_user_role;
+--rollback REVOKE SELECT, INSERT, UPDATE, DELETE ON "auth".custom_oidc_providers FROM solomon_auth_user_role;
+
+--changeset solomon.auth:grant:16 labels:auth context:auth
+--comment: grant select, insert, update, delete on passkeys and passkey_challenges to solomon_auth_user_role
+GRANT SELECT, INSERT, UPDATE, DELETE ON "auth".passkeys, "auth".passkey_challenges TO solomon_auth_user_role;
+--rollback REVOKE SELECT, INSERT, UPDATE, DELETE ON "auth".passkeys, "auth".passkey_challenges FROM solomon_auth_user_role;
+
+--changeset solomon.auth:grant:17 labels:auth context:auth
+--comment: grant select, insert, update, delete on mfa_recovery_codes to solomon_auth_user_role
+GRANT SELECT, INSERT, UPDATE, DELETE ON "auth".mfa_recovery_codes TO solomon_auth_user_role;
+--rollback REVOKE SELECT, INSERT, UPDATE, DELETE ON "auth".mfa_recovery_codes FROM solomon_auth_user_role;
@@ -70,3 +70,63 @@ CREATE INDEX IF NOT EXISTS identities_project_id_index ON "auth".identities (pro
 CREATE UNIQUE INDEX IF NOT EXISTS users_email_partial_key ON "auth".users (email, organization_id, project_id) WHERE (is_sso_user = false);
 COMMENT ON INDEX "auth".users_email_partial_key IS 'auth: a partial unique index that applies only when is_sso_user is false';
 --rollback DROP INDEX "auth".users_email_partial_key;
+
+--changeset solomon.auth-index:15 labels:auth context:auth
+--comment: create index on organization_members user_id
+CREATE INDEX IF NOT EXISTS organization_members_user_id_index ON "auth".organization_members (user_id);
+--rollback DROP INDEX "auth".organization_members_user_id_index;
+
+--changeset solomon.auth-index:16 labels:auth context:auth
+--comment: allow a single pending invitation per email in an organization
+CREATE UNIQUE INDEX IF NOT EXISTS organization_invitations_pending_email_key ON "auth".organization_invitations (organization_id, lower(email)) WHERE (accepted_at IS NULL);
+--rollback DROP INDEX "auth".organization_invitations_pending_email_key;
+
+--changeset solomon.auth-index:17 labels:auth context:auth
+--comment: create index on sso_providers organization_id
+CREATE INDEX IF NOT EXISTS sso_providers_organization_id_index ON "auth".sso_providers (organization_id);
+--rollback DROP INDEX "auth".sso_providers_organization_id_index;
+
+--changeset solomon.auth-index:18 labels:auth context:auth
+--comment: create unique index on sso_domains project_id and domain so that tenants of different projects can claim the same domain
+CREATE UNIQUE INDEX IF NOT EXISTS sso_domains_project_id_domain_key ON "auth".sso_domains (project_id, lower(domain));
+--rollback DROP INDEX "auth".sso_domains_project_id_domain_key;
+
+--changeset solomon.auth-index:19 labels:auth context:auth
+--comment: create unique index on sso_providers project_id and resource_id
+CREATE UNIQUE INDEX IF NOT EXISTS sso_providers_project_id_resource_id_key ON "auth".sso_providers (project_id, lower(resource_id));
+--rollback DROP INDEX "auth".sso_providers_project_id_resource_id_key;
+
+--changeset solomon.auth-index:20 labels:auth context:auth
+--comment: create index on oauth_clients project_id and organization_id
+CREATE INDEX IF NOT EXISTS oauth_clients_project_id_organization_id_index ON "auth".oauth_clients (project_id, organization_id);
+--rollback DROP INDEX "auth".oauth_clients_project_id_organization_id_index;
+
+--changeset solomon.auth-index:21 labels:auth context:auth
+--comment: create index on audit_log_entries project_id and created_at
+CREATE INDEX IF NOT EXISTS audit_log_entries_project_id_created_at_index ON "auth".audit_log_entries (project_id, created_at DESC);
+--rollback DROP INDEX "auth".audit_log_entries_project_id_created_at_index;
+
+--changeset solomon.auth-index:22 labels:auth context:auth
+--comment: create index on audit_log_entries organization_id and created_at
+CREATE INDEX IF NOT EXISTS audit_log_entries_organization_id_created_at_index ON "auth".audit_log_entries (organization_id, created_at DESC);
+--rollback DROP INDEX "auth".audit_log_entries_organization_id_created_at_index;
+
+--changeset solomon.auth-index:23 labels:auth context:auth
+--comment: create index on api_keys project_id and organization_id
+CREATE INDEX IF NOT EXISTS api_keys_project_id_organization_id_index ON "auth".api_keys (project_id, organization_id);
+--rollback DROP INDEX "auth".api_keys_project_id_organization_id_index;
+
+--changeset solomon.auth-index:24 labels:auth context:auth
+--comment: a project has at most one default tier
+CREATE UNIQUE INDEX IF NOT EXISTS projects_tiers_project_id_default_index ON "auth".projects_tiers (project_id) WHERE is_default;
+--rollback DROP INDEX "auth".projects_tiers_project_id_default_index;
+
+--changeset solomon.auth-index:25 labels:auth context:auth
+--comment: create index on project_rate_limits for the database rate limiter
+CREATE INDEX IF NOT EXISTS project_rate_limits_project_id_user_id_request_time_index ON "auth".project_rate_limits (project_id, user_id, request_time);
+--rollback DROP INDEX "auth".project_rate_limits_project_id_user_id_request_time_index;
+
+--changeset solomon.auth-index:26 labels:auth context:auth
+--comment: create index on passkeys for listing the passkeys of a user
+CREATE INDEX IF NOT EXISTS passkeys_user_id_index ON "auth".passkeys (user_id);
+--rollback DROP INDEX "auth".passkeys_user_id_index;
@@ -110,3 +110,153 @@ DO $$ BEGIN
 EXCEPTION WHEN duplicate_object THEN NULL;
 END $$;
 --rollback DROP POLICY IF EXISTS organizations_delete_policy ON "auth".organizations;
+
+--changeset solomon.auth-rls:7 labels:auth context:auth
+--comment: Read empty tenant settings as NULL in the api_keys policy, set_config leaves them empty after the transaction that set them
+ALTER POLICY api_keys_policy ON "auth".api_keys
+    USING (
+        (
+            organization_id = nullif(current_setting('app.current_organization', true), '')::uuid
+            AND
+            current_setting('app.organization_role', true)::text = 'admin'
+        )
+        OR
+        (
+            current_setting('app.organization_role', true)::text = 'project_admin'
+            AND
+            nullif(current_setting('app.current_project', true), '')::uuid = project_id
+        )
+    )
+    WITH CHECK (
+        (
+            organization_id = nullif(current_setting('app.current_organization', true), '')::uuid
+            AND
+            current_setting('app.organization_role', true)::text = 'admin'
+        )
+        OR
+        (
+            current_setting('app.organization_role', true)::text = 'project_admin'
+            AND
+            nullif(current_setting('app.current_project', true), '')::uuid = project_id
+        )
+    );
+--rollback ALTER POLICY api_keys_policy ON "auth".api_keys USING ((organization_id = current_setting('app.current_organization', true)::uuid AND current_setting('app.organization_role', true)::text = 'admin') OR (current_setting('app.organization_role', true)::text = 'project_admin' AND current_setting('app.current_project', true)::uuid = project_id)) WITH CHECK ((organization_id = current_setting('app.current_organization', true)::uuid AND current_setting('app.organization_role', true)::text = 'admin') OR (current_setting('app.organization_role', true)::text = 'project_admin' AND current_setting('app.current_project', true)::uuid = project_id));
+
+--changeset solomon.auth-rls:8 labels:auth context:auth
+--comment: Read empty tenant settings as NULL in the auth.organizations policies
+ALTER POLICY organizations_select_policy ON "auth".organizations
+    USING (
+        nullif(current_setting('app.current_owner', true), '')::uuid = admin_id
+        OR
+        (
+            current_setting('app.organization_role', true)::text = 'project_admin'
+            AND
+            nullif(current_setting('app.current_project', true), '')::uuid = project_id
+        )
+    );
+ALTER POLICY organizations_update_policy ON "auth".organizations
+    USING (
+        nullif(current_setting('app.current_owner', true), '')::uuid = admin_id
+        OR
+        (
+            current_setting('app.organization_role', true)::text = 'project_admin'
+            AND
+            nullif(current_setting('app.current_project', true), '')::uuid = project_id
+        )
+    )
+    WITH CHECK (
+        nullif(current_setting('app.current_owner', true), '')::uuid = admin_id
+        OR
+        (
+            current_setting('app.organization_role', true)::text = 'project_admin'
+            AND
+            nullif(current_setting('app.current_project', true), '')::uuid = project_id
+        )
+    );
+ALTER POLICY organizations_insert_policy ON "auth".organizations
+    WITH CHECK (
+        nullif(current_setting('app.current_owner', true), '')::uuid = admin_id
+    );
+ALTER POLICY organizations_delete_policy ON "auth".organizations
+    USING (
+        current_setting('app.organization_role', true)::text = 'project_admin'
+        AND nullif(current_setting('app.current_project', true), '')::uuid = project_id
+    );
+--rollback ALTER POLICY organizations_select_policy ON "auth".organizations USING (current_setting('app.current_owner', true)::uuid = admin_id OR (current_setting('app.organization_role', true)::text = 'project_admin' AND current_setting('app.current_project', true)::uuid = project_id));
+--rollback ALTER POLICY organizations_update_policy ON "auth".organizations USING (current_setting('app.current_owner', true)::uuid = admin_id OR (current_setting('app.organization_role', true)::text = 'project_admin' AND current_setting('app.current_project', true)::uuid = project_id)) WITH CHECK (current_setting('app.current_owner', true)::uuid = admin_id OR (current_setting('app.organization_role', true)::text = 'project_admin' AND current_setting('app.current_project', true)::uuid = project_id));
+--rollback ALTER POLICY organizations_insert_policy ON "auth".organizations WITH CHECK (current_setting('app.current_owner', true)::uuid = admin_id);
+--rollback ALTER POLICY organizations_delete_policy ON "auth".organizations USING (current_setting('app.organization_role', true)::text = 'project_admin' AND current_setting('app.current_project', true)::uuid = project_id);
+
+--changeset solomon.auth-rls:9 labels:auth context:auth
+--comment: Create bypass policy for api_keys, used by the transactions of service role and admin callers
+DO $$ BEGIN
+    CREATE POLICY api_keys_bypass_policy ON "auth".api_keys
+    FOR ALL
+    USING (current_setting('app.bypass_rls', true) = 'on')
+    WITH CHECK (current_setting('app.bypass_rls', true) = 'on');
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+--rollback DROP POLICY IF EXISTS api_keys_bypass_policy ON "auth".api_keys;
+
+--changeset solomon.auth-rls:10 labels:auth context:auth
+--comment: Create bypass policy for auth.organizations, used by the transactions of service role and admin callers
+DO $$ BEGIN
+    CREATE POLICY organizations_bypass_policy ON "auth".organizations
+    FOR ALL
+    USING (current_setting('app.bypass_rls', true) = 'on')
+    WITH CHECK (current_setting('app.bypass_rls', true) = 'on');
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+--rollback DROP POLICY IF EXISTS organizations_bypass_policy ON "auth".organizations;
+
+--changeset solomon.auth-rls:12 labels:auth context:auth
+--comment: Let organization admins who do not own the organization, and API keys, read the organization of their tenant
+ALTER POLICY organizations_select_policy ON "auth".organizations
+    USING (
+        nullif(current_setting('app.current_owner', true), '')::uuid = admin_id
+        OR
+        (
+            id = nullif(current_setting('app.current_organization', true), '')::uuid
+            AND
+            current_setting('app.organization_role', true)::text IN ('admin', 'api_key')
+        )
+        OR
+        (
+            current_setting('app.organization_role', true)::text = 'project_admin'
+            AND
+            nullif(current_setting('app.current_project', true), '')::uuid = project_id
+        )
+    );
+ALTER POLICY organizations_update_policy ON "auth".organizations
+    USING (
+        nullif(current_setting('app.current_owner', true), '')::uuid = admin_id
+        OR
+        (
+            id = nullif(current_setting('app.current_organization', true), '')::uuid
+            AND
+            current_setting('app.organization_role', true)::text = 'admin'
+        )
+        OR
+        (
+            current_setting('app.organization_role', true)::text = 'project_admin'
+            AND
+            nullif(current_setting('app.current_project', true), '')::uuid = project_id
+        )
+    )
+    WITH CHECK (
+        nullif(current_setting('app.current_owner', true), '')::uuid = admin_id
+        OR
+        (
+            id = nullif(current_setting('app.current_organization', true), '')::uuid
+            AND
+            current_setting('app.organization_role', true)::text = 'admin'
+        )
+        OR
+        (
+            current_setting('app.organization_role', true)::text = 'project_admin'
+            AND
+            nullif(current_setting('app.current_project', true), '')::uuid = project_id
+        )
+    );
+--rollback ALTER POLICY organizations_select_policy ON "auth".organizations USING (nullif(current_setting('app.current_owner', true), '')::uuid = admin_id OR (current_setting('app.organization_role', true)::text = 'project_admin' AND nullif(current_setting('app.current_project', true), '')::uuid = project_id));
+--rollback ALTER POLICY organizations_update_policy ON "auth".organizations USING (nullif(current_setting('app.current_owner', true), '')::uuid = admin_id OR (current_setting('app.organization_role', true)::text = 'project_admin' AND nullif(current_setting('app.current_project', true), '')::uuid = project_id)) WITH CHECK (nullif(current_setting('app.current_owner', true), '')::uuid = admin_id OR (current_setting('app.organization_role', true)::text = 'project_admin' AND nullif(current_setting('app.current_project', true), '')::uuid = project_id));
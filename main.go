@@ -17,12 +17,16 @@ import (
 //go:embed migrations/*
 var embeddedMigrations embed.FS
 
+//go:embed liquibase_migrations/*.sql liquibase_migrations/*.xml
+var embeddedChangelogs embed.FS
+
 func init() {
 	logrus.SetFormatter(&logrus.JSONFormatter{})
 }
 
 func main() {
 	cmd.EmbeddedMigrations = embeddedMigrations
+	cmd.EmbeddedChangelogs = embeddedChangelogs
 
 	execCtx, execCancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGHUP, syscall.SIGINT)
 	defer execCancel()